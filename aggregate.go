@@ -0,0 +1,127 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"unicode"
+)
+
+// aggregates maps the values of -by to the function giving the group of
+// a character.
+var aggregates = map[string]func(rune) string{
+	"category": categoryOf,
+	"block":    blockName,
+	"script":   scriptName,
+}
+
+// categoryOf returns the two-letter general category of r, such as Lu,
+// according to the Unicode data if it was loaded, otherwise the tables
+// built into Go.
+func categoryOf(r rune) string {
+	if ucd != nil {
+		return ucd.Category(r)
+	}
+	for name, table := range unicode.Categories {
+		if len(name) == 2 && name != "LC" && unicode.Is(table, r) { // LC is Lu, Ll and Lt together.
+			return name
+		}
+	}
+	return "Cn"
+}
+
+// blockName returns the name of the block of r, or noBlock.
+func blockName(r rune) string {
+	if b, ok := blockOf(r); ok {
+		return b.name
+	}
+	return noBlock
+}
+
+// scriptName returns the script of r, or Unknown, as Unicode calls the
+// script of unassigned code points.
+func scriptName(r rune) string {
+	if s := scriptOf(r); s != "" {
+		return s
+	}
+	return "Unknown"
+}
+
+// aggregate is the total count of a group of characters and the bytes
+// they occupy.
+type aggregate struct {
+	name         string
+	count, bytes uint64
+}
+
+// printAggregates prints the counts rolled up into the groups of -by,
+// sorted by -sortby, where char sorts by the name of the group.
+func printAggregates() {
+	groupOf := aggregates[aggregateBy]
+	totals := make(map[string]*aggregate)
+	var total uint64
+	counts.Do(func(r rune, count uint64) {
+		name := groupOf(r)
+		a := totals[name]
+		if a == nil {
+			a = &aggregate{name: name}
+			totals[name] = a
+		}
+		a.count += count
+		a.bytes += count * width(r)
+		total += count
+	})
+	if errorsInTotal {
+		total += errors
+	}
+	var list []*aggregate
+	for _, a := range totals {
+		if shown(a.count, total) {
+			list = append(list, a)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].name < list[j].name })
+	less := func(i, j int) bool { return list[i].name < list[j].name }
+	switch sortKey {
+	case "count", "percent":
+		less = func(i, j int) bool { return list[i].count < list[j].count }
+	case "bytes":
+		less = func(i, j int) bool { return list[i].bytes < list[j].bytes }
+	}
+	if sortDesc {
+		asc := less
+		less = func(i, j int) bool { return asc(j, i) }
+	}
+	sort.SliceStable(list, less)
+	switch outputFormat {
+	case "json", "csv":
+		var records []record
+		for _, a := range list {
+			records = append(records, record{aggregateBy, "", a.name, a.count})
+		}
+		if outputFormat == "json" {
+			printJSON(records)
+		} else {
+			printCSV(records)
+		}
+		return
+	}
+	share := shares{total: total}
+	var bars bars
+	for _, a := range list {
+		bars.fit(a.count)
+	}
+	for _, a := range list {
+		fmt.Fprintf(stdout, "%s\t%*s", a.name, pad, formatTally(a.count, total))
+		share.print(a.count)
+		if byBytes {
+			fmt.Fprintf(stdout, "\t%s", formatCount(a.bytes))
+		}
+		bars.print(a.count)
+		fmt.Fprintln(stdout)
+	}
+	printErrors()
+}
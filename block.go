@@ -0,0 +1,100 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// block is a named range of code points, as listed in Blocks.txt.
+type block struct {
+	lo, hi rune
+	name   string
+}
+
+// noBlock is the name of the pseudo-block of code points in no block.
+const noBlock = "No_Block"
+
+// blockOf returns the block containing r, if any.
+func blockOf(r rune) (block, bool) {
+	i := sort.Search(len(blocks), func(i int) bool { return blocks[i].hi >= r })
+	if i < len(blocks) && blocks[i].lo <= r {
+		return blocks[i], true
+	}
+	return block{}, false
+}
+
+// printCoverage prints, for each block in which characters were counted,
+// how many of its assigned characters appear in the input.
+func printCoverage() {
+	var b block
+	var used int
+	have := false // Whether b is the block of the code points so far.
+	report := func() {
+		if !have || used == 0 {
+			return
+		}
+		assigned := 0
+		for r := b.lo; r <= b.hi; r++ {
+			if isAssigned(r) {
+				assigned++
+			}
+		}
+		fmt.Fprintf(stdout, "%s: %d/%d assigned code points used (%.0f%%)\n", b.name, used, assigned, 100*float64(used)/float64(assigned))
+	}
+	counts.Do(func(r rune, count uint64) {
+		if !have || r > b.hi || r < b.lo {
+			report()
+			used = 0
+			if b, have = blockOf(r); !have {
+				return
+			}
+		}
+		if isAssigned(r) {
+			used++
+		}
+	})
+	report()
+}
+
+// planeNames holds the names of the Unicode planes that have them.
+var planeNames = map[int]string{
+	0:  "Basic Multilingual Plane",
+	1:  "Supplementary Multilingual Plane",
+	2:  "Supplementary Ideographic Plane",
+	3:  "Tertiary Ideographic Plane",
+	14: "Supplementary Special-purpose Plane",
+	15: "Supplementary Private Use Area-A",
+	16: "Supplementary Private Use Area-B",
+}
+
+// printPlanes prints the total count of each plane in use. A plane is
+// the top level of Counts.
+func printPlanes() {
+	for plane, c2 := range *counts {
+		if c2 == nil {
+			continue
+		}
+		var total uint64
+		for _, c1 := range c2 {
+			if c1 == nil {
+				continue
+			}
+			for _, count := range c1 {
+				total += count
+			}
+		}
+		if total == 0 {
+			continue
+		}
+		name := planeNames[plane]
+		if name == "" {
+			name = "-"
+		}
+		fmt.Fprintf(stdout, "%d %s\t%*s\n", plane, name, pad, formatCount(total))
+	}
+	printErrors()
+}
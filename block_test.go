@@ -0,0 +1,21 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCoverageSkipsGaps(t *testing.T) {
+	// U+2FE0 lies between the Ideographic Description Characters and
+	// CJK Symbols and Punctuation blocks, in no block.
+	countText("a⿠一")
+	got := capture(printCoverage)
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "Basic Latin: 1/") || !strings.HasPrefix(lines[1], "CJK Unified Ideographs: 1/") {
+		t.Errorf("coverage with a gap:\n%s", got)
+	}
+}
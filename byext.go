@@ -0,0 +1,103 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+)
+
+// noExt names the -by-ext group of files without an extension.
+const noExt = "(none)"
+
+// group holds the counts of a -by-ext or -per-file group while another
+// is current.
+type group struct {
+	counts     *Counts
+	errors     uint64
+	badBytes   [256]uint64
+	surrogates map[rune]uint64
+}
+
+var (
+	groups     = make(map[string]*group)
+	groupNames []string // In the order the groups were first used.
+	curGroup   *group
+)
+
+// grouping reports whether the counts are kept in groups.
+func grouping() bool {
+	return byExt || perFile
+}
+
+// groupName returns the name of the group of file: the file itself for
+// -per-file, otherwise its extension.
+func groupName(file string) string {
+	if perFile {
+		return file
+	}
+	if ext := filepath.Ext(file); ext != "" {
+		return ext
+	}
+	return noExt
+}
+
+// useGroup makes the counts of the group of file current, saving those
+// of the previous group.
+func useGroup(file string) {
+	name := groupName(file)
+	g := groups[name]
+	if g == curGroup && g != nil {
+		return
+	}
+	saveGroup()
+	if g == nil {
+		g = &group{counts: new(Counts), surrogates: make(map[rune]uint64)}
+		groups[name] = g
+		groupNames = append(groupNames, name)
+	}
+	curGroup = g
+	counts, errors, badBytes, surrogates = g.counts, g.errors, g.badBytes, g.surrogates
+}
+
+// saveGroup records the current counts in the current group.
+func saveGroup() {
+	if curGroup != nil {
+		curGroup.errors, curGroup.badBytes = errors, badBytes
+	}
+}
+
+// printGroups prints the table of each group, in order of extension for
+// -by-ext and of input for -per-file, then the table of all of them
+// together if -total is set.
+func printGroups() {
+	saveGroup()
+	names := groupNames
+	if byExt {
+		names = append([]string(nil), groupNames...)
+		sort.Strings(names)
+	}
+	all := &group{counts: new(Counts), surrogates: make(map[rune]uint64)}
+	for _, name := range names {
+		g := groups[name]
+		counts, errors, badBytes, surrogates = g.counts, g.errors, g.badBytes, g.surrogates
+		fmt.Fprintf(stdout, "# %s\n", name)
+		print()
+		all.counts.Merge(g.counts)
+		all.errors += g.errors
+		for b, n := range g.badBytes {
+			all.badBytes[b] += n
+		}
+		for r, n := range g.surrogates {
+			all.surrogates[r] += n
+		}
+	}
+	counts, errors, badBytes, surrogates = all.counts, all.errors, all.badBytes, all.surrogates
+	if showTotal {
+		fmt.Fprintln(stdout, "# total")
+		print()
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+)
+
+// printChiSquare prints the chi-square statistic of the byte counts
+// against the uniform distribution, with 255 degrees of freedom, and
+// the probability of a statistic at least as large from uniformly
+// random bytes.
+func printChiSquare() {
+	var observed [256]float64
+	var n float64
+	counts.Do(func(r rune, count uint64) {
+		observed[r] = float64(count)
+		n += float64(count)
+	})
+	if n == 0 {
+		fmt.Fprintln(stdout, "chi-square\t-")
+		fmt.Fprintln(stdout, "p-value\t-")
+		return
+	}
+	expected := n / 256
+	var x float64
+	for _, o := range observed {
+		d := o - expected
+		x += d * d / expected
+	}
+	if expected < 5 {
+		fmt.Fprintf(os.Stderr, "freq: -chi2: only %.0f bytes; the p-value is unreliable below 1280\n", n)
+	}
+	fmt.Fprintf(stdout, "chi-square\t%.2f\n", x)
+	fmt.Fprintf(stdout, "p-value\t%.4f\n", gammaQ(255/2.0, x/2))
+}
+
+// gammaQ returns the regularized upper incomplete gamma function Q(a, x),
+// using the series for P when x < a+1 and the continued fraction for Q
+// otherwise, as in Numerical Recipes §6.2.
+func gammaQ(a, x float64) float64 {
+	const (
+		eps     = 1e-14
+		maxIter = 1000
+	)
+	if x <= 0 {
+		return 1
+	}
+	lg, _ := math.Lgamma(a)
+	scale := math.Exp(-x + a*math.Log(x) - lg)
+	if x < a+1 {
+		sum, term := 1/a, 1/a
+		for n := 1; n < maxIter && math.Abs(term) > math.Abs(sum)*eps; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+		}
+		return 1 - sum*scale
+	}
+	// Lentz's method.
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1; i < maxIter; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < eps {
+			break
+		}
+	}
+	return h * scale
+}
@@ -0,0 +1,128 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tableColumns maps the names accepted by -columns to functions that
+// format the column for an entry, given the key format and the total.
+var tableColumns = map[string]func(e entry, keyFormat string, total uint64) string{
+	"code": func(e entry, keyFormat string, total uint64) string {
+		return key(e.r, keyFormat)
+	},
+	"char": func(e entry, keyFormat string, total uint64) string {
+		return glyph(e.r)
+	},
+	"count": func(e entry, keyFormat string, total uint64) string {
+		return formatTally(e.count, total)
+	},
+	"percent": func(e entry, keyFormat string, total uint64) string {
+		return fmt.Sprintf("%.2f%%", 100*float64(e.count)/float64(total))
+	},
+	"bytes": func(e entry, keyFormat string, total uint64) string {
+		return formatCount(e.count * width(e.r))
+	},
+	"name": func(e entry, keyFormat string, total uint64) string {
+		return nameColumn(e.r)
+	},
+}
+
+// columnNames lists the columns in the order documented.
+var columnNames = []string{"code", "char", "count", "percent", "bytes", "name"}
+
+// parseColumns parses the -columns list.
+func parseColumns(s string) ([]string, error) {
+	cols := strings.Split(s, ",")
+	for _, c := range cols {
+		if tableColumns[c] == nil {
+			return nil, fmt.Errorf("unknown column %q; want one of %s", c, strings.Join(columnNames, ", "))
+		}
+	}
+	return cols, nil
+}
+
+// printColumns prints the table with the -columns selected, separated
+// by tabs. The error and surrogate lines have the same columns: code
+// holds "error" or "surrogate", char the bad byte or surrogate, or "-"
+// for the total of errors, and bytes and name a dash.
+func printColumns(keyFormat string) {
+	total := total()
+	texts := make([]string, len(columns))
+	for _, e := range entries() {
+		for i, c := range columns {
+			texts[i] = tableColumns[c](e, keyFormat, total)
+		}
+		fmt.Fprintln(stdout, strings.Join(texts, "\t"))
+	}
+	for _, rec := range errorRecords() {
+		for i, c := range columns {
+			switch c {
+			case "code":
+				texts[i] = rec.Kind
+			case "char":
+				texts[i] = rec.Code
+				if texts[i] == "" {
+					texts[i] = "-"
+				}
+			case "count":
+				texts[i] = formatCount(rec.Count)
+			case "percent":
+				texts[i] = fmt.Sprintf("%.2f%%", percent(rec.Count, total))
+			default:
+				texts[i] = "-"
+			}
+		}
+		fmt.Fprintln(stdout, strings.Join(texts, "\t"))
+	}
+}
+
+// printColumnsCSV prints the table with the -columns selected as
+// comma-separated values, headed by the column names. The characters are
+// unescaped and the counts are not abbreviated by -human, as in the
+// records of -format=csv, and the error lines have their kind and bad
+// byte in the code column and leave the char, bytes and name columns
+// empty.
+func printColumnsCSV(keyFormat string) {
+	total := total()
+	w := csv.NewWriter(stdout)
+	w.Write(versioned(columns, "format"))
+	texts := make([]string, len(columns))
+	for _, e := range entries() {
+		for i, c := range columns {
+			switch c {
+			case "char":
+				texts[i] = rawChar(e.r)
+			case "count":
+				texts[i] = strconv.FormatUint(e.count, 10)
+			case "bytes":
+				texts[i] = strconv.FormatUint(e.count*width(e.r), 10)
+			default:
+				texts[i] = tableColumns[c](e, keyFormat, total)
+			}
+		}
+		w.Write(versioned(texts, strconv.Itoa(tableFormat)))
+	}
+	for _, rec := range errorRecords() {
+		for i, c := range columns {
+			switch c {
+			case "code":
+				texts[i] = strings.TrimSpace(rec.Kind + " " + rec.Code)
+			case "count":
+				texts[i] = strconv.FormatUint(rec.Count, 10)
+			case "percent":
+				texts[i] = fmt.Sprintf("%.2f%%", percent(rec.Count, total))
+			default:
+				texts[i] = ""
+			}
+		}
+		w.Write(versioned(texts, strconv.Itoa(tableFormat)))
+	}
+	w.Flush()
+}
@@ -0,0 +1,40 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+
+	"robpike.io/cmd/freq/internal/zstd"
+)
+
+// Magic numbers of compressed streams. That of bzip2 is followed by the
+// block size, a digit from 1 to 9.
+var (
+	gzipMagic  = []byte{0x1f, 0x8b}
+	bzip2Magic = []byte("BZh")
+	zstdMagic  = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// decompressor returns a reader of the decompressed contents of r if it
+// begins with the magic number of a gzip, bzip2 or zstd stream, and
+// otherwise a reader of r itself.
+func decompressor(r io.Reader) (io.Reader, error) {
+	buf := bufio.NewReaderSize(r, bufSize)
+	magic, _ := buf.Peek(4)
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return gzip.NewReader(buf)
+	case bytes.HasPrefix(magic, bzip2Magic) && len(magic) == 4 && '1' <= magic[3] && magic[3] <= '9':
+		return bzip2.NewReader(buf), nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		return zstd.NewReader(buf), nil
+	}
+	return buf, nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+)
+
+func TestDecompressor(t *testing.T) {
+	const text = "hello, world\n"
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	io.WriteString(w, text)
+	w.Close()
+	inputs := map[string][]byte{
+		"plain": []byte(text),
+		"gzip":  gz.Bytes(),
+		// zstd -c of text, with a checksum.
+		"zstd": []byte("\x28\xb5\x2f\xfd\x24\x0d\x69\x00\x00hello, world\n\x4c\x1f\xf9\xf1"),
+	}
+	for name, data := range inputs {
+		r, err := decompressor(bytes.NewReader(data))
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		got, err := io.ReadAll(r)
+		if err != nil || string(got) != text {
+			t.Errorf("%s: read %q, %v; want %q", name, got, err, text)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// baseline holds the counts of the -baseline input, or is nil.
+var baseline *tableCounts
+
+// countBaseline counts the named input as the baseline and clears the
+// counts for the inputs to be compared with it. Only the characters and
+// the number of decode errors are kept.
+func countBaseline(file string) {
+	readFile(file)
+	baseline = &tableCounts{counts: make(map[rune]uint64), errors: errors}
+	counts.Do(func(r rune, count uint64) {
+		baseline.counts[r] = count
+	})
+	counts = new(Counts)
+	errors, badBytes = 0, [256]uint64{}
+	clear(surrogates)
+	bytesRead = 0
+}
+
+// printDiff prints, for each character counted in the baseline or the
+// input, its count in each, the difference and the ratio of the input's
+// count to the baseline's, or "new" or "gone" if it is in only one.
+func printDiff(keyFormat string) {
+	seen := make(map[rune]uint64)
+	counts.Do(func(r rune, count uint64) {
+		seen[r] = count
+	})
+	for r := range baseline.counts {
+		if _, ok := seen[r]; !ok {
+			seen[r] = 0
+		}
+	}
+	runes := make([]rune, 0, len(seen))
+	for r := range seen {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	for _, r := range runes {
+		fmt.Fprintf(stdout, "%s%s%s\t", key(r, keyFormat), sep(), glyph(r))
+		printDelta(baseline.counts[r], seen[r])
+	}
+	if baseline.errors > 0 || errors > 0 {
+		fmt.Fprintf(stdout, "error%s-\t", sep())
+		printDelta(baseline.errors, errors)
+	}
+}
+
+// printDelta prints the columns of printDiff for the counts old and new.
+func printDelta(old, new uint64) {
+	sign, delta := "+", new-old
+	if new < old {
+		sign, delta = "-", old-new
+	}
+	ratio := "new"
+	switch {
+	case new == 0:
+		ratio = "gone"
+	case old > 0:
+		ratio = fmt.Sprintf("%.2f", float64(new)/float64(old))
+	}
+	fmt.Fprintf(stdout, "%*s\t%*s\t%s%s\t%s\n", pad, formatCount(old), pad, formatCount(new), sign, formatCount(delta), ratio)
+}
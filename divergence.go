@@ -0,0 +1,82 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+)
+
+// reference holds the counts of the -reference table.
+var reference *tableCounts
+
+// loadReference reads the -reference table.
+func loadReference(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	reference, err = parseTable(file, f)
+	return err
+}
+
+// divergences lists the metrics accepted by -divergence.
+var divergences = []string{"tv", "kl"}
+
+// divergence returns the distance between the distribution of the counts
+// and that of the reference, by the -divergence metric: the total
+// variation distance, half the sum of the absolute differences of the
+// probabilities, or the Kullback-Leibler divergence of the input from
+// the reference, in bits. For the latter, half a count is added to the
+// reference for each character of either, so that characters absent
+// from the reference do not make it infinite.
+func divergence() float64 {
+	seen := make(map[rune]bool)
+	input := make(map[rune]float64)
+	var n, m float64
+	counts.Do(func(r rune, count uint64) {
+		input[r] = float64(count)
+		n += float64(count)
+		seen[r] = true
+	})
+	for r, count := range reference.counts {
+		m += float64(count)
+		seen[r] = true
+	}
+	if n == 0 {
+		return 0
+	}
+	d := 0.0
+	switch divergenceMetric {
+	case "tv":
+		for r := range seen {
+			q := 0.0
+			if m > 0 {
+				q = float64(reference.counts[r]) / m
+			}
+			d += math.Abs(input[r]/n - q)
+		}
+		d /= 2
+	case "kl":
+		const smooth = 0.5
+		m += smooth * float64(len(seen))
+		for r := range seen {
+			p := input[r] / n
+			if p == 0 {
+				continue
+			}
+			q := (float64(reference.counts[r]) + smooth) / m
+			d += p * math.Log2(p/q)
+		}
+	}
+	return d
+}
+
+// printDivergence prints the -divergence of the counts from the reference.
+func printDivergence() {
+	fmt.Fprintf(stdout, "divergence %s\t%.4f\n", divergenceMetric, divergence())
+}
@@ -0,0 +1,148 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// runeRange is a range of code points, inclusive.
+type runeRange struct {
+	lo, hi rune
+}
+
+// isEmoji reports whether r has the Emoji property.
+func isEmoji(r rune) bool {
+	i := sort.Search(len(emojiRanges), func(i int) bool { return emojiRanges[i].hi >= r })
+	return i < len(emojiRanges) && emojiRanges[i].lo <= r
+}
+
+// The state of -emoji, which counts grapheme clusters that are emoji
+// rather than individual characters.
+var (
+	emojiSeg    segmenter
+	emojiRunes  []rune // The cluster being read.
+	emojiCounts = make(map[string]uint64)
+	emojiOther  uint64 // Sequences not kept because of -max-memory.
+)
+
+// addEmoji adds r to the current cluster, counting the previous one
+// if r begins a new cluster.
+func addEmoji(r rune) {
+	if emojiSeg.next(r) {
+		endEmoji()
+	}
+	emojiRunes = append(emojiRunes, r)
+}
+
+// endEmoji counts the current cluster if it is an emoji.
+func endEmoji() {
+	if isEmojiCluster(emojiRunes) {
+		seq := string(emojiRunes)
+		countKey(emojiCounts, seq, &emojiOther)
+	}
+	emojiRunes = emojiRunes[:0]
+}
+
+// isEmojiCluster reports whether the cluster c is an emoji: it begins
+// with a character with the Emoji property. The ASCII digits, '#' and
+// '*' have the property but are emoji only as the start of a sequence,
+// such as a keycap.
+func isEmojiCluster(c []rune) bool {
+	if len(c) == 0 || !isEmoji(c[0]) {
+		return false
+	}
+	return c[0] >= 0x80 || len(c) > 1
+}
+
+// printEmoji prints the emoji counts in the form chosen by -format, one
+// sequence per line, with the code points of the sequence, or under -hash
+// their identifiers, joined by '+'. The sequences beyond the -top are
+// counted in the other row.
+func printEmoji() {
+	type emojiEntry struct {
+		seq   string
+		count uint64
+	}
+	var list []emojiEntry
+	total := emojiOther
+	for _, count := range emojiCounts {
+		total += count
+	}
+	for seq, count := range emojiCounts {
+		if shown(count, total) {
+			list = append(list, emojiEntry{seq, count})
+		}
+	}
+	// Strings compare in code point order.
+	sort.Slice(list, func(i, j int) bool { return list[i].seq < list[j].seq })
+	other := emojiOther
+	if topN > 0 && len(list) > topN {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].count > list[j].count })
+		for _, e := range list[topN:] {
+			other += e.count
+		}
+		list = list[:topN]
+		sort.Slice(list, func(i, j int) bool { return list[i].seq < list[j].seq })
+	}
+	less := func(i, j int) bool { return list[i].seq < list[j].seq }
+	switch sortKey {
+	case "count", "percent":
+		less = func(i, j int) bool { return list[i].count < list[j].count }
+	}
+	if sortDesc {
+		asc := less
+		less = func(i, j int) bool { return asc(j, i) }
+	}
+	sort.SliceStable(list, less)
+	switch outputFormat {
+	case "json", "csv":
+		var records []record
+		for _, e := range list {
+			var keys []string
+			for _, r := range e.seq {
+				keys = append(keys, key(r, "%.4x"))
+			}
+			records = append(records, record{"emoji", strings.Join(keys, "+"), rawString(e.seq), e.count})
+		}
+		if other > 0 {
+			records = append(records, record{"other", "", "", other})
+		}
+		records = append(records, errorRecords()...)
+		if outputFormat == "json" {
+			printJSON(records)
+		} else {
+			printCSV(records)
+		}
+		return
+	}
+	share := shares{total: total}
+	var bars bars
+	for _, e := range list {
+		bars.fit(e.count)
+	}
+	bars.fit(other)
+	for _, e := range list {
+		var keys []string
+		for _, r := range e.seq {
+			keys = append(keys, key(r, "%.4x"))
+		}
+		seq := e.seq
+		switch {
+		case hashIDs:
+			seq = "-"
+		case outputCharmap != nil:
+			seq = outputCharmap.encodeString(seq)
+		}
+		fmt.Fprintf(stdout, "%s %s\t%*s", strings.Join(keys, "+"), seq, pad, formatCount(e.count))
+		share.print(e.count)
+		bars.print(e.count)
+		fmt.Fprintln(stdout)
+	}
+	printOther("other -", other, &share, &bars)
+	printErrors()
+}
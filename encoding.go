@@ -0,0 +1,244 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// charmap is a single-byte character encoding.
+type charmap struct {
+	decode [256]rune // The rune for each byte, or -1 if none.
+	encode map[rune]byte
+}
+
+// newCharmap returns the charmap in which bytes up to max stand for
+// the same code points, as in Latin-1, except as listed in high.
+func newCharmap(max int, high map[byte]rune) *charmap {
+	c := &charmap{encode: make(map[rune]byte)}
+	for b := range c.decode {
+		r := rune(b)
+		if b > max {
+			r = -1
+		}
+		if h, ok := high[byte(b)]; ok {
+			r = h
+		}
+		c.decode[b] = r
+		if r >= 0 {
+			c.encode[r] = byte(b)
+		}
+	}
+	return c
+}
+
+// windows1252 lists the bytes 0x80 to 0x9F of Windows-1252, which
+// holds printable characters where Latin-1 has C1 controls. Bytes
+// 0x81, 0x8D, 0x8F, 0x90 and 0x9D are unassigned.
+var windows1252 = map[byte]rune{
+	0x80: 0x20AC, 0x81: -1, 0x82: 0x201A, 0x83: 0x0192, 0x84: 0x201E, 0x85: 0x2026, 0x86: 0x2020, 0x87: 0x2021,
+	0x88: 0x02C6, 0x89: 0x2030, 0x8A: 0x0160, 0x8B: 0x2039, 0x8C: 0x0152, 0x8D: -1, 0x8E: 0x017D, 0x8F: -1,
+	0x90: -1, 0x91: 0x2018, 0x92: 0x2019, 0x93: 0x201C, 0x94: 0x201D, 0x95: 0x2022, 0x96: 0x2013, 0x97: 0x2014,
+	0x98: 0x02DC, 0x99: 0x2122, 0x9A: 0x0161, 0x9B: 0x203A, 0x9C: 0x0153, 0x9D: -1, 0x9E: 0x017E, 0x9F: 0x0178,
+}
+
+// charmaps holds the single-byte encodings, by name.
+var charmaps = map[string]*charmap{
+	"ascii":        newCharmap(0x7F, nil),
+	"latin1":       newCharmap(0xFF, nil),
+	"windows-1252": newCharmap(0xFF, windows1252),
+}
+
+// encodingAliases maps other names of the encodings to those in charmaps.
+var encodingAliases = map[string]string{
+	"us-ascii":   "ascii",
+	"latin-1":    "latin1",
+	"iso-8859-1": "latin1",
+	"cp1252":     "windows-1252",
+}
+
+// lookupCharmap returns the named single-byte encoding.
+func lookupCharmap(name string) (*charmap, error) {
+	name = strings.ToLower(name)
+	if alias, ok := encodingAliases[name]; ok {
+		name = alias
+	}
+	if c, ok := charmaps[name]; ok {
+		return c, nil
+	}
+	var names []string
+	for n := range charmaps {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("unknown encoding %q; want utf-8 or one of %s", name, strings.Join(names, ", "))
+}
+
+// replacement stands for characters an output encoding cannot represent.
+const replacement = '?'
+
+// encodeString returns s in the encoding, with replacement standing for
+// the characters it lacks.
+func (c *charmap) encodeString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if e, ok := c.encode[r]; ok {
+			b.WriteByte(e)
+		} else {
+			b.WriteByte(replacement)
+		}
+	}
+	return b.String()
+}
+
+// The input encodings that are not single-byte. Utf16 is UTF-16 in the
+// byte order given by its byte order mark, big-endian if it has none;
+// auto is UTF-8 or UTF-16 as its byte order mark says, UTF-8 if it has
+// none.
+const (
+	utf16LE  = "utf-16le"
+	utf16BE  = "utf-16be"
+	utf16BOM = "utf-16"
+	autoBOM  = "auto"
+)
+
+// inputDecoding is the -encoding of the input, empty for UTF-8, or the
+// name of a single-byte encoding, whose charmap is inputCharmap.
+var (
+	inputDecoding string
+	inputCharmap  *charmap
+)
+
+// setInputEncoding sets the encoding of the input to the named one.
+func setInputEncoding(name string) error {
+	switch name = strings.ToLower(name); name {
+	case "utf-8", "utf8":
+		return nil
+	case utf16LE, utf16BE, utf16BOM, autoBOM:
+		inputDecoding = name
+		return nil
+	}
+	c, err := lookupCharmap(name)
+	if err != nil {
+		return fmt.Errorf("unknown encoding %q; want utf-8, utf-16le, utf-16be, utf-16, auto or a single-byte encoding", name)
+	}
+	inputDecoding, inputCharmap = name, c
+	return nil
+}
+
+// decoder reads UTF-8 transcoded from the input encoding.
+type decoder struct {
+	r    *bufio.Reader
+	next func(*bufio.Reader) (rune, error) // Decodes a rune.
+	out  []byte                            // Transcoded but not yet read.
+	err  error
+}
+
+// newDecoder returns a reader of r transcoded from the -encoding to
+// UTF-8. Bytes that do not decode become U+FFFD.
+func newDecoder(r io.Reader) io.Reader {
+	if inputDecoding == "" {
+		return r
+	}
+	buf := bufio.NewReaderSize(r, bufSize)
+	d := &decoder{r: buf}
+	switch inputDecoding {
+	case utf16LE:
+		d.next = decodeUTF16LE
+	case utf16BE:
+		d.next = decodeUTF16BE
+	case utf16BOM, autoBOM:
+		bom, _ := buf.Peek(3)
+		switch {
+		case len(bom) >= 2 && bom[0] == 0xFF && bom[1] == 0xFE:
+			buf.Discard(2)
+			d.next = decodeUTF16LE
+		case len(bom) >= 2 && bom[0] == 0xFE && bom[1] == 0xFF:
+			buf.Discard(2)
+			d.next = decodeUTF16BE
+		case inputDecoding == utf16BOM:
+			d.next = decodeUTF16BE
+		default:
+			if len(bom) == 3 && bom[0] == 0xEF && bom[1] == 0xBB && bom[2] == 0xBF {
+				buf.Discard(3)
+			}
+			return buf
+		}
+	default:
+		d.next = inputCharmap.decodeRune
+	}
+	return d
+}
+
+func (d *decoder) Read(p []byte) (int, error) {
+	for len(d.out) < len(p) && d.err == nil {
+		var r rune
+		if r, d.err = d.next(d.r); d.err == nil {
+			d.out = utf8.AppendRune(d.out, r)
+		}
+	}
+	n := copy(p, d.out)
+	d.out = d.out[:copy(d.out, d.out[n:])]
+	if n == 0 {
+		return 0, d.err
+	}
+	return n, nil
+}
+
+// decodeRune decodes a byte of r in the encoding.
+func (c *charmap) decodeRune(r *bufio.Reader) (rune, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if c.decode[b] < 0 {
+		return utf8.RuneError, nil
+	}
+	return c.decode[b], nil
+}
+
+func decodeUTF16LE(r *bufio.Reader) (rune, error) {
+	return decodeUTF16(r, func(b []byte) rune { return rune(b[0]) | rune(b[1])<<8 })
+}
+
+func decodeUTF16BE(r *bufio.Reader) (rune, error) {
+	return decodeUTF16(r, func(b []byte) rune { return rune(b[0])<<8 | rune(b[1]) })
+}
+
+// decodeUTF16 decodes a character of r, whose code units are read by
+// unit. An unpaired surrogate, or a final odd byte, decodes as U+FFFD.
+func decodeUTF16(r *bufio.Reader, unit func([]byte) rune) (rune, error) {
+	b, err := r.Peek(2)
+	switch {
+	case len(b) == 1:
+		r.Discard(1)
+		return utf8.RuneError, nil
+	case err != nil:
+		return 0, err
+	}
+	c := unit(b)
+	r.Discard(2)
+	switch {
+	case 0xDC00 <= c && c < 0xE000:
+		return utf8.RuneError, nil
+	case c < 0xD800 || 0xDC00 <= c:
+		return c, nil
+	}
+	b, _ = r.Peek(2)
+	if len(b) < 2 {
+		return utf8.RuneError, nil
+	}
+	low := unit(b)
+	if low < 0xDC00 || 0xE000 <= low {
+		return utf8.RuneError, nil
+	}
+	r.Discard(2)
+	return 0x10000 + (c-0xD800)<<10 + (low - 0xDC00), nil
+}
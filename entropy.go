@@ -0,0 +1,99 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// The adjacent pairs of characters counted, for -conditional-entropy.
+var (
+	pairs         = make(map[[2]rune]uint64)
+	pairPrev rune = -1 // The previous character of this input, or -1.
+)
+
+// addPair counts the pair of the previous character and r.
+func addPair(r rune) {
+	if pairPrev >= 0 {
+		p := [2]rune{pairPrev, r}
+		if _, ok := pairs[p]; ok || admitKey(8) {
+			pairs[p]++
+		}
+	}
+	pairPrev = r
+}
+
+// conditionalEntropy returns H(next|current), the entropy in bits of a
+// character given the one before it, computed from the pair counts as
+// H(current, next) - H(current).
+func conditionalEntropy() float64 {
+	var n float64
+	first := make(map[rune]float64)
+	for p, count := range pairs {
+		n += float64(count)
+		first[p[0]] += float64(count)
+	}
+	if n == 0 {
+		return 0
+	}
+	h := 0.0
+	for _, count := range pairs {
+		p := float64(count) / n
+		h -= p * math.Log2(p)
+	}
+	for _, count := range first {
+		p := count / n
+		h += p * math.Log2(p)
+	}
+	return max(h, 0) // Rounding can leave a tiny negative for a determined sequence.
+}
+
+// printConditionalEntropy prints the -conditional-entropy.
+func printConditionalEntropy() {
+	fmt.Fprintf(stdout, "conditional entropy\t%.4f bits\n", conditionalEntropy())
+}
+
+// printStats prints the -stats summary of the counts: the number of
+// characters and of distinct ones, the Shannon entropy in bits per
+// character, and the most and least frequent characters, the lowest
+// code point winning a tie.
+func printStats() {
+	unit := "runes"
+	format := "%.4x"
+	if countBytes {
+		unit, format = "bytes", "%.2x"
+	}
+	var n, distinct uint64
+	most, least := entry{r: -1}, entry{r: -1}
+	counts.Do(func(r rune, count uint64) {
+		n += count
+		distinct++
+		if count > most.count {
+			most = entry{r, count}
+		}
+		if least.r < 0 || count < least.count {
+			least = entry{r, count}
+		}
+	})
+	h := 0.0
+	counts.Do(func(r rune, count uint64) {
+		p := float64(count) / float64(n)
+		h -= p * math.Log2(p)
+	})
+	fmt.Fprintf(stdout, "%s\t%d\n", unit, n)
+	fmt.Fprintf(stdout, "distinct\t%d\n", distinct)
+	fmt.Fprintf(stdout, "entropy\t%.4f bits\n", h)
+	for _, s := range []struct {
+		label string
+		e     entry
+	}{{"most", most}, {"least", least}} {
+		if s.e.r < 0 {
+			fmt.Fprintf(stdout, "%s\t-\n", s.label)
+			continue
+		}
+		fmt.Fprintf(stdout, "%s\t%s%s%s\t%d\n", s.label, key(s.e.r, format), sep(), glyph(s.e.r), s.e.count)
+	}
+}
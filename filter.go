@@ -0,0 +1,103 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// runeSet is a set of characters given to -only or -ignore: a range of
+// code points or a regular expression matching a single character.
+type runeSet struct {
+	lo, hi rune
+	re     *regexp.Regexp
+}
+
+// parseRuneSet parses a set: a range such as U+0080..U+10FFFF, a single
+// code point such as U+00A0, or otherwise a regular expression, such as
+// \p{L} or [aeiou], that the character alone must match.
+func parseRuneSet(s string) (runeSet, error) {
+	if lo, hi, ok := strings.Cut(s, ".."); ok {
+		l, err := parseCodePoint(lo)
+		if err != nil {
+			return runeSet{}, err
+		}
+		h, err := parseCodePoint(hi)
+		if err != nil {
+			return runeSet{}, err
+		}
+		if l > h {
+			return runeSet{}, fmt.Errorf("empty range %q", s)
+		}
+		return runeSet{lo: l, hi: h}, nil
+	}
+	if strings.HasPrefix(s, "U+") || strings.HasPrefix(s, "u+") {
+		r, err := parseCodePoint(s)
+		return runeSet{lo: r, hi: r}, err
+	}
+	re, err := regexp.Compile(`^(?:` + s + `)$`)
+	if err != nil {
+		return runeSet{}, err
+	}
+	return runeSet{re: re}, nil
+}
+
+func (s runeSet) has(r rune) bool {
+	if s.re != nil {
+		return s.re.MatchString(string(r))
+	}
+	return s.lo <= r && r <= s.hi
+}
+
+// The sets of -only and -ignore, and the verdicts on the characters seen
+// so far, as matching regular expressions is slow.
+var (
+	onlySets, ignoreSets []runeSet
+	selection            map[rune]bool
+)
+
+// parseRuneSets parses the sets of -only and -ignore.
+func parseRuneSets() error {
+	for _, list := range []struct {
+		specs []string
+		sets  *[]runeSet
+	}{{onlySpecs, &onlySets}, {ignoreSpecs, &ignoreSets}} {
+		for _, spec := range list.specs {
+			s, err := parseRuneSet(spec)
+			if err != nil {
+				return err
+			}
+			*list.sets = append(*list.sets, s)
+		}
+	}
+	if len(onlySets) > 0 || len(ignoreSets) > 0 {
+		selection = make(map[rune]bool)
+	}
+	return nil
+}
+
+// selected reports whether r is in one of the -only sets, if there are
+// any, and in none of the -ignore sets.
+func selected(r rune) bool {
+	if ok, seen := selection[r]; seen {
+		return ok
+	}
+	ok := len(onlySets) == 0
+	for _, s := range onlySets {
+		if s.has(r) {
+			ok = true
+			break
+		}
+	}
+	for _, s := range ignoreSets {
+		if ok && s.has(r) {
+			ok = false
+		}
+	}
+	selection[r] = ok
+	return ok
+}
@@ -0,0 +1,64 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// pollInterval is how often -follow looks for more data at the end of
+// a file.
+const pollInterval = 250 * time.Millisecond
+
+// clearScreen moves the cursor of a terminal home and clears the screen.
+const clearScreen = "\x1b[H\x1b[2J"
+
+// follower reads the input of -follow, printing the table every
+// -interval. At the end of a regular file it waits for more data rather
+// than reporting the end, which is reported only for other inputs, such
+// as a pipe whose writer has finished, or when -max-runtime expires.
+type follower struct {
+	r    io.Reader
+	wait bool      // Whether to wait at the end of the input.
+	next time.Time // When to print the table next.
+}
+
+// newFollower returns a follower reading in.
+func newFollower(in io.Reader) *follower {
+	f := &follower{r: in, next: time.Now().Add(followInterval)}
+	if file, ok := in.(*os.File); ok {
+		info, err := file.Stat()
+		f.wait = err == nil && info.Mode().IsRegular()
+	}
+	return f
+}
+
+func (f *follower) Read(p []byte) (int, error) {
+	for !timedOut.Load() {
+		if time.Now().After(f.next) {
+			refresh()
+			f.next = time.Now().Add(followInterval)
+		}
+		n, err := f.r.Read(p)
+		if n > 0 || err != io.EOF || !f.wait {
+			return n, err
+		}
+		time.Sleep(min(pollInterval, followInterval))
+	}
+	return 0, io.EOF
+}
+
+// refresh prints the table of the counts so far, replacing the previous
+// one if standard output is a terminal.
+func refresh() {
+	if isTerminal(os.Stdout) {
+		fmt.Fprint(stdout, clearScreen)
+	}
+	print()
+	stdout.Flush()
+}
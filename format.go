@@ -0,0 +1,117 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// outputFormats lists the values of -format.
+var outputFormats = []string{"plain", "json", "csv", "tsv"}
+
+// record is a line of the table in the -format=json and -format=csv
+// schema. Kind is "char", "error", "surrogate", "word", "ngram", "emoji"
+// or, for -by, the kind of group; Code is the hex key, the keys of an
+// n-gram or emoji joined by +, the bad byte of an error, or empty for
+// the total of errors, for words and for groups, whose name is in Char.
+type record struct {
+	Kind  string `json:"kind"`
+	Code  string `json:"codepoint"`
+	Char  string `json:"char"`
+	Count uint64 `json:"count"`
+}
+
+// records returns the table, errors last, as records.
+func records(keyFormat string) []record {
+	var list []record
+	for _, e := range entries() {
+		list = append(list, record{"char", key(e.r, keyFormat), rawChar(e.r), e.count})
+	}
+	return append(list, errorRecords()...)
+}
+
+// errorRecords returns the error and surrogate lines of the table as
+// records.
+func errorRecords() []record {
+	var list []record
+	if errors > 0 {
+		list = append(list, record{"error", "", "", errors})
+	}
+	for b, count := range badBytes {
+		if count > 0 {
+			list = append(list, record{"error", fmt.Sprintf("%.2x", b), "", count})
+		}
+	}
+	values := make([]rune, 0, len(surrogates))
+	for r := range surrogates {
+		values = append(values, r)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	for _, r := range values {
+		list = append(list, record{"surrogate", fmt.Sprintf("%.4x", r), "", surrogates[r]})
+	}
+	return list
+}
+
+// rawChar returns the character r itself, unescaped, or the empty string
+// if it is hidden by -hash or is not a character, as with bytes above
+// 7F under -bytes.
+func rawChar(r rune) string {
+	if hashIDs || countBytes && r >= utf8.RuneSelf || !utf8.ValidRune(r) {
+		return ""
+	}
+	return string(r)
+}
+
+// printJSON prints the records as a JSON array, one per line. Under
+// -format-version the array is the records field of an object whose
+// format field is the format version.
+func printJSON(list []record) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	end := "]"
+	if formatVersion {
+		fmt.Fprintf(stdout, "{\"format\":%d,\"records\":[\n", tableFormat)
+		end = "]}"
+	} else {
+		fmt.Fprintln(stdout, "[")
+	}
+	for i, rec := range list {
+		buf.Reset()
+		enc.Encode(rec)
+		line := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+		if i < len(list)-1 {
+			line = append(line, ',')
+		}
+		fmt.Fprintf(stdout, "\t%s\n", line)
+	}
+	fmt.Fprintln(stdout, end)
+}
+
+// printCSV prints the records as comma-separated values, after a header.
+func printCSV(list []record) {
+	w := csv.NewWriter(stdout)
+	w.Write(versioned([]string{"kind", "codepoint", "char", "count"}, "format"))
+	for _, rec := range list {
+		w.Write(versioned([]string{rec.Kind, rec.Code, rec.Char, strconv.FormatUint(rec.Count, 10)}, strconv.Itoa(tableFormat)))
+	}
+	w.Flush()
+}
+
+// versioned returns the CSV row, preceded under -format-version by first,
+// the text of the column that gives the format version.
+func versioned(row []string, first string) []string {
+	if !formatVersion {
+		return row
+	}
+	return append([]string{first}, row...)
+}
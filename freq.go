@@ -6,7 +6,619 @@
 // Unicode code point appears in the input. The -bytes
 // option counts bytes instead. The table is then printed
 // to standard output, one count per line. Nothing is
-// printed for a code point if its count is zero.
+// printed for a code point if its count is zero. The -cstyle
+// option prints unprintable characters as C-style escapes
+// (\xNN, \uNNNN, \UNNNNNNNN) rather than a dash. NUL, which is easily
+// overlooked in mixed text and binary data, is always shown, as ^@
+// unless -cstyle or -control-pictures is set.
+//
+// The -escape option prints white space and other invisible characters
+// in readable form instead: the conventional escapes \t, \n, \r and so
+// on, \xNN for the other ASCII controls, and names, such as SPACE, NBSP
+// and ZWJ, for the spaces and the invisible formatting characters.
+// Characters it does not name are shown as usual.
+//
+// The -classify-cmd option names a shell command that assigns a label
+// to each code point. The command is run once, with the hex values of
+// the counted code points, one per line, on its standard input, and
+// must print lines of the form "key\tlabel". Freq then prints the total
+// count for each label instead of the table. If the command fails the
+// ungrouped table is printed.
+//
+// The -show-errors=K option reports the file and byte offset of each of
+// the first K invalid UTF-8 sequences on standard error, with the bytes
+// around it in hex and the offending byte in brackets, as in
+//
+//	freq: notes.txt: invalid UTF-8 at offset 1042: 6f 20 [e9] 74 65
+//
+// A negative K, as in -show-errors=-1, reports every one. With -field or
+// -reset-on the bytes shown are those of the field or line. The option
+// cannot be combined with -words or -lines, which do not treat invalid
+// UTF-8 as errors.
+//
+// The -field=N option counts only the Nth field (numbered from 1) of
+// each line, where fields are separated by the -delim string, a tab by
+// default. Lines with fewer than N fields are skipped. Quoting is not
+// interpreted and line terminators are not counted.
+//
+// The -recover option also tallies the raw bytes of invalid UTF-8
+// in rune mode and prints them after the error count, one line per
+// byte value, as "error xx\tcount".
+//
+// The -max-runtime option stops reading once the given duration has
+// elapsed and prints the counts accumulated so far, noting on standard
+// error that the table is truncated.
+//
+// The -fold-combining option counts a base character and the combining
+// marks (categories Mn and Mc) that follow it as their canonical (NFC)
+// composition, so "e\u0301" is counted as "é". Marks that have no
+// composition with the preceding character, and marks at the start of
+// the input, are counted on their own. It has no effect with -bytes.
+//
+// The -zero-width option restricts the output to the invisible and
+// format characters, such as U+200B ZERO WIDTH SPACE and the bidi
+// controls, that appear in the input, printing each one's name after
+// its count. The characters are listed in invisible.go.
+//
+// Input files may be named with the repeatable -file option as well as
+// by arguments, which avoids ambiguity for names beginning with a dash.
+// The name "-", given to -file or as an argument, means standard input,
+// so a pipe can be counted along with files, as in "freq a.txt - b.txt".
+// Standard input is read only once, however often it is named.
+//
+// The -files-from0 option names a file of input file names separated by
+// NUL bytes, as printed by "find -print0", so names may hold spaces and
+// newlines. The named files are counted after those given by -file. If
+// the list is "-" it is read from standard input, which then holds no
+// text.
+//
+// The -byte-share option adds a column giving each character's share
+// of the total size of the input, its count times the length of its
+// UTF-8 encoding divided by the total number of bytes.
+//
+// The -state option names a file holding counts saved by a previous
+// run. They are added to the counts of the new input and the totals
+// are written back, so the file accumulates counts across runs. A lock
+// file, the state file's name with ".lock" appended, keeps concurrent
+// runs from losing updates. The -quiet option suppresses the table.
+//
+// The -o option names a file to which the counts, including those added
+// by -merge, are saved in the versioned form of -state, without reading
+// it first, so that the counts of many runs can be combined later:
+//
+//	freq -quiet -o part1.freq corpus1/*
+//	freq -quiet -o part2.freq corpus2/*
+//	freq -merge part1.freq -merge part2.freq
+//
+// The -threshold-percent=P option omits characters that make up less
+// than P percent of the counted characters.
+//
+// The -min=N and -max=N options omit characters counted fewer than N or
+// more than N times, and -top=N prints only the N most frequent of those
+// that remain, ties going to the lower code point, in the order chosen
+// by -sortby. -top applies to the words, n-grams, clusters and emoji of
+// the other modes too; the emoji beyond the top are counted in the other
+// row. The totals behind percentages are those of all the characters
+// counted.
+//
+// The -split-surrogates option recognizes the UTF-8 style encodings of
+// UTF-16 surrogates (U+D800 to U+DFFF), which are invalid but produced
+// by CESU-8 and other faulty encoders. Rather than three decode errors,
+// each is counted once under its nominal value and printed after the
+// errors as "surrogate xxxx\tcount".
+//
+// The -unicode-data option names a UnicodeData.txt file from the
+// Unicode Character Database. The characters it lists decide which code
+// points are printable, pinning the output to that version of Unicode
+// rather than the one Go was built with.
+//
+// The -dedupe option collapses runs of a repeated character, so "aaab"
+// is counted as "ab". It applies to bytes under -bytes, and the last
+// character is forgotten at the end of each input.
+//
+// The -emit-go option prints the table as a Go map literal of type
+// map[rune]uint64, or map[byte]uint64 with -bytes, with hex keys and
+// each character quoted in a comment.
+//
+// The -coverage option reports, for each Unicode block in which
+// characters appear, how many of the block's assigned characters
+// appear, for instance "Basic Latin: 62/128 assigned code points used
+// (48%)". Assignment follows -unicode-data if it is set.
+//
+// The repeatable -merge option adds the counts in a table printed by
+// an earlier run, as text or by -format=json or csv, or in a file saved
+// by -state or -o, to those of the input. The name "-" reads the table
+// from standard input, so tables can be accumulated in a pipeline:
+//
+//	cat old.freq | freq -merge - newfile > new.freq
+//
+// Standard input then holds the table, not text to count; it is not
+// read as input unless named with -file, which would be an error.
+//
+// The repeatable -merge-dir option adds the counts in every file in a
+// directory, each a table or a -state file, such as the partial results
+// of a batch of runs. Files that cannot be parsed are reported and
+// skipped; none of their counts are added. Standard input is read only
+// if named with -file.
+//
+// The -sortby option orders the table by a key, char (the default),
+// count, percent, bytes (the count times the length of the encoding) or
+// name (the Unicode name, as printed by -names, with unnamed characters
+// first), optionally followed by ":asc" (the default) or ":desc", as in
+// -sortby=count:desc. Ties are broken by code point.
+//
+// The -no-controls option skips the C0 and C1 control characters,
+// U+0000 to U+001F, U+007F and U+0080 to U+009F, including tab and
+// newline. With -bytes only 0x00 to 0x1F and 0x7F are skipped, since
+// bytes 0x80 to 0x9F are usually part of multibyte UTF-8 sequences.
+// Decode errors are counted as usual.
+//
+// The -pad=W option right-justifies the count column to W characters.
+// Padded tables can still be read back by -merge.
+// Longer counts are printed in full.
+//
+// The -map option names a file of pairs of hex code points, one pair per
+// line. Each character is counted as the second of its pair, so dash
+// variants, say, can be counted as U+002D. The mapping is applied once;
+// it is not repeated on its result.
+//
+// The -warn-mixed option reports on standard error each word that mixes
+// letters from different scripts, such as Latin and Cyrillic, which may
+// be a homograph spoof. Mixtures normal in East Asian text are allowed.
+// The table is printed as usual.
+//
+// The -reset-on option takes a regular expression. Each input line that
+// matches it ends a segment: the table so far is printed, headed by
+// "# segment N", and the counts start again from zero. The matching
+// line itself is not counted. The last segment is printed at the end,
+// unless the input ends with a matching line.
+//
+// The -output-encoding option prints the character column in ascii,
+// latin1 or windows-1252 rather than UTF-8. Characters the encoding
+// cannot represent are printed as "?".
+//
+// The -encoding option names the encoding of the input, which is
+// transcoded to UTF-8 before it is counted: utf-16le, utf-16be, utf-16
+// (in the byte order of its byte order mark, big-endian if it has none),
+// auto (UTF-8, or UTF-16 if it begins with a UTF-16 byte order mark), or
+// a single-byte encoding as for -output-encoding. A byte order mark
+// that chooses the encoding is not counted. Bytes that do not decode,
+// such as an unpaired surrogate, count as U+FFFD, not as decode errors,
+// and offsets and byte counts are those of the UTF-8.
+//
+// The -grapheme-ratio option reports, after the table, the number of
+// extended grapheme clusters (user-perceived characters, as defined by
+// Unicode Standard Annex #29) and runes in the input, and the number of
+// runes per cluster. A high ratio indicates many combining sequences or
+// emoji sequences.
+//
+// The -strict option treats invalid UTF-8 as fatal: freq reports the
+// file and byte offset of the first decode error and exits with status
+// 1 without printing the table, or after printing the partial table if
+// -partial is also set.
+//
+// An input that cannot be opened, or fails while it is read, is reported
+// and skipped, keeping the counts read before the failure, and once the
+// table is printed freq exits with status 1. So is a directory under -r
+// that cannot be read. -strict makes these failures fatal too: freq
+// exits at the first, without printing the table. There is no option to
+// make one kind of error fatal without the other.
+//
+// The -classify-frequency option labels each line of the table as
+// "unique" if the count is 1, "rare" if the count is at most the count
+// at the -rare percentile of the entries shown (25 by default), and
+// "common" otherwise. The percentile is found by the nearest-rank
+// method, so entries tied with the boundary count are all rare.
+//
+// The -png option, which requires -bytes, also writes the byte counts
+// to the named file as a PNG bar chart: 256 bars, from 00 on the left
+// to ff on the right, scaled to the largest count, with a tick under
+// every 16th value.
+//
+// The -emoji option counts emoji instead of characters: grapheme
+// clusters, such as ZWJ sequences, flags and keycaps, that begin with
+// a character with the Emoji property. Each is printed as its code
+// points joined by '+', the sequence itself and its count. Other text
+// is not counted.
+//
+// Decode errors are not part of the totals from which percentages are
+// computed, by -threshold-percent and -byte-share among others, so the
+// shares of the characters sum to 100%. The -errors-in-total option
+// includes them, each accounting for one byte of the input.
+//
+// The -max-memory option bounds the memory, in bytes, used by the modes
+// that keep a map of what they have seen, -emoji, -words, -lines,
+// -ngram, -graphemes, -warn-mixed and -conditional-entropy. Once the
+// limit is reached they stop adding keys: new emoji, words, lines,
+// n-grams and clusters are counted together in an "other" row at the
+// end of the table, mixed-script words may be reported more than once
+// and new pairs of characters are not counted. A warning says when this
+// has happened.
+//
+// The -tsv option prints the table as tab-separated values for other
+// programs: the key, glyph and count columns, including those of the
+// error lines, are separated by single tabs, and tab, newline, carriage
+// return and backslash are shown by their escapes \t, \n, \r and \\.
+// Other unprintable characters are shown as usual, so the glyph column
+// never holds a raw control character.
+//
+// The -trojan-source option looks for the directional formatting
+// characters, U+202A to U+202E and U+2066 to U+2069, that can disguise
+// source code (CVE-2021-42574). It prints the file, line and byte offset
+// of each occurrence, then the count of each such character instead of
+// the table, and exits with status 1 if any was found.
+//
+// The -human option abbreviates counts of 1000 or more with a suffix
+// for a power of 1000, K, M, G, T, P or E, as in 45K or 1.2M: counts
+// under 10 units keep one decimal place and all are rounded to the
+// nearest digit shown, so 999999 is 1.0M. Such tables cannot be read
+// back by -merge. The option has no effect with -tsv.
+//
+// The -by-ext option keeps separate counts for the input files of each
+// extension, as given by path/filepath.Ext, and prints a table for each,
+// headed by a line such as "# .go", in order of extension. Files with no
+// extension, and standard input, are grouped under "(none)". It cannot
+// be combined with -state, -merge, -merge-dir or -reset-on, nor with
+// -emoji or -longest-runs, whose counts are not kept by group.
+//
+// The -per-file option is like -by-ext but keeps separate counts for
+// each input, printing the tables in the order of the inputs, each
+// headed by a line such as "# a.txt". With either option, -total follows
+// the tables with that of all the inputs together, headed "# total";
+// summaries such as -chi2 always describe all the inputs together.
+//
+// The -chi2 option, which requires -bytes, follows the table with the
+// chi-square statistic of the byte counts against a uniform distribution
+// and its p-value, the probability that uniformly random bytes would
+// give a statistic at least as large. Encrypted or compressed data
+// gives a p-value that is not small; text gives one near 0. Below five
+// expected occurrences of each byte, 1280 bytes in all, the p-value is
+// unreliable and freq says so. Empty input has neither value and
+// prints a dash for each.
+//
+// The -positions option adds two columns to the table, the positions
+// of the first and last occurrences of each character, as file:offset
+// with the byte offset from the start of the file. Characters whose
+// counts were only merged or loaded from -state show dashes.
+//
+// The -distinct option prints just the characters that appear, one per
+// line with its code point and glyph, without counts or decode errors.
+// Each character is counted at most once, so it is faster than the
+// full table.
+//
+// The -by-bytes option adds a column with the number of bytes each
+// character contributes to the input, its count times the length of its
+// encoding, and orders the table by it, largest first, as if by
+// -sortby=bytes:desc. With -words it counts the bytes of each word.
+//
+// The -fold-digits option counts each decimal digit (category Nd), such
+// as the Arabic-Indic, Devanagari and fullwidth digits, as the ASCII
+// digit with the same value. Other numbers, such as Roman numerals and
+// superscripts, are counted as themselves.
+//
+// The -bufsize option sets the size in bytes of the buffers used to
+// read each input and to write the output, 4096 by default. Larger
+// buffers may read very large files on fast storage more quickly.
+//
+// The -control-pictures option shows the C0 control characters and DEL
+// in the glyph column as their symbols from the Control Pictures block,
+// such as ␀ for NUL and ␉ for tab, rather than as a dash.
+//
+// The -head-lines=N option counts only the first N lines of each input,
+// including their newlines, in any mode.
+//
+// The -reference option names a table, in the form read by -merge, of
+// a typical distribution, such as English text. Freq then follows the
+// table with the distance of the input's distribution from it, by the
+// -divergence metric: tv, the total variation distance, from 0 for the
+// same distribution to 1 for disjoint ones, or kl, the Kullback-Leibler
+// divergence in bits, for which half a count is added to the reference
+// for each character seen, so unexpected characters do not make it
+// infinite. Decode errors are not part of either distribution.
+//
+// The -baseline option names an input, counted as the others are, to
+// compare them with. Instead of the table, freq prints, for each
+// character counted in either, in code point order, its count in the
+// baseline, its count in the input, the difference, and the ratio of the
+// counts, or "new" or "gone" for a character in only one, as in
+// "0061 a	10	12	+2	1.20". Decode errors follow as one row.
+//
+// The -histogram option ends each line of the table with a bar, drawn
+// with block characters, whose length is proportional to the count; the
+// largest count draws the longest bar, of -histogram-width characters,
+// or of what fits before the edge of the terminal, by $COLUMNS, if that
+// is not set. The bars are drawn as well in the tables of words, lines,
+// n-grams, clusters, emoji and -by groups. The -plot=html option
+// instead prints a standalone HTML page holding a bar chart of the
+// table.
+//
+// The -markdown option prints the table as a GitHub-flavored Markdown
+// table, with columns "Code point", "Char" and "Count", escaping pipes
+// and backquotes in the Char column. Decode errors and surrogates are
+// labeled rows at the end.
+//
+// The -window=N option shows how varied the input is along its length.
+// After every N characters (or bytes) of each input it prints a line
+// such as "window file:4095\t37", giving the position of the last of
+// those N characters and the number of distinct characters among them.
+//
+// The -case-report option prints, instead of the table, the number of
+// upper case, lower case and title case letters and of letters without
+// case, such as CJK ideographs, each with its percentage of all the
+// letters. Other characters are not included.
+//
+// The -guess option prints, instead of the table, a list of likely
+// encodings of the input, most likely first, each with a confidence
+// from 0 to 1. The judgment rests on simple evidence: a byte order
+// mark, the validity of the input as UTF-8, the placement of NUL bytes,
+// which in UTF-16 text alternate with ASCII, and the presence of bytes
+// 0x80 to 0x9F, which distinguish Windows-1252 from Latin-1. It is a
+// first step with mystery files, not a verdict.
+//
+// The -property option counts only the characters with the named
+// Unicode property, such as White_Space, Dash or Hex_Digit, as given
+// by the unicode package, or one of the derived properties Alphabetic,
+// Lowercase, Uppercase and Math.
+//
+// The -columns option chooses the columns of the table and their order,
+// as a comma-separated list of code (the hex code point), char (the
+// glyph), count, percent (of the total), bytes (the count times the
+// length of the encoding) and name, as in -columns=count,char. The error
+// lines have the same columns, with "error" as the code and the bad byte,
+// or a dash, as the char. The columns are separated by tabs, or with
+// -format=csv printed as CSV under a header of their names.
+//
+// Output is buffered, in a buffer of -bufsize bytes. The -flush-every=N
+// option writes it out after every N lines, so a program reading the
+// output of a long run gets the rows as they are printed.
+//
+// The -conditional-entropy option follows the table with the entropy,
+// in bits, of a character given the one before it, H(next|current),
+// from the counts of adjacent pairs of characters within each input.
+// Low values mean predictable text. The pairs are kept in a map, under
+// -max-memory.
+//
+// The -trailing-ws option follows the table with the number of lines
+// ending in each amount of white space, counted in characters, and the
+// number of lines with any. The carriage return of a CRLF line ending
+// is not trailing white space. With -trailing-ws-fail, freq exits with
+// status 1 if any line has trailing white space. These options do not
+// work with -field.
+//
+// The -plane option prints, instead of the table, the total count for
+// each Unicode plane in use, with its number and name: 0 for the Basic
+// Multilingual Plane, 1 for the Supplementary Multilingual Plane (home
+// of most emoji and historic scripts), and so on.
+//
+// The -follow option reads its one input as tail -f does: at the end of
+// a regular file it waits for more to be written rather than stopping,
+// and every -interval it prints the table of the counts so far, in
+// place of the last one if standard output is a terminal. It runs until
+// it is interrupted, the input is a pipe that is closed, or -max-runtime
+// expires, and then prints the table as usual.
+//
+// The -listen=ADDR option serves, while freq counts, the counts as they
+// stand after the latest read of the input: the number of characters and
+// of decode errors, the counts by general category, and the -listen-top
+// most frequent characters. They are served at /metrics in the text
+// format of Prometheus and at /debug/vars as JSON, under "freq". It is
+// meant for a long-running freq reading a stream, as in
+//
+//	producer | freq -listen=:9100 -quiet
+//
+// The snapshot with the final counts is served until freq exits.
+//
+// The -utf8len option prints, instead of the table, the number of
+// characters whose UTF-8 encoding takes each length, 1 to 4 bytes, and
+// the bytes they take, as in "3\t1200\t3600", then the number of
+// invalid bytes, each a decode error. The characters are those counted,
+// after -map and the folding options.
+//
+// The -utf16 option follows the table with the length of the input in
+// UTF-16 code units, the measure of JavaScript's String length, and the
+// numbers of characters that take a single unit and that take a
+// surrogate pair. Each decode error counts as one unit, as U+FFFD.
+//
+// The -hash option hides the characters of the table, for sharing the
+// shape of a distribution without its content: the code point column
+// holds an identifier, the first 12 hex digits of the HMAC-SHA256 of
+// the code point keyed by the -salt, and the glyph column a dash. The
+// identifiers are stable for a given salt; without a secret salt they
+// can be reversed by trying every code point. Counts and order are
+// unchanged. Tables printed this way cannot be read by -merge. The
+// sequences of -ngram, -graphemes and -emoji are shown as the identifiers
+// of their code points; -words and -lines, whose keys are text, cannot be
+// hashed.
+//
+// The -wc option follows the table with a line like the output of wc,
+// "wc\tLINES WORDS BYTES", counting newlines, words separated by ASCII
+// white space, and bytes of all the input.
+//
+// The -top-per-category=N option prints, instead of the table, the N
+// most frequent characters of each major general category, Letter,
+// Mark, Number, Punctuation, Symbol, Separator and Other, each group
+// headed by a line such as "# Letter". Ties go to the lower code point.
+//
+// The -alert-codepoint=CP option, with -alert-count=N, watches for a
+// flood of one character, CP in hex as for -map: as soon as its count
+// exceeds N, freq prints the table counted so far and an alert on
+// standard error, and exits with status 3.
+//
+// The -fold option counts each character as its simple case folding,
+// the one-to-one mappings of the Unicode CaseFolding.txt, with no regard
+// to language: mostly the lower case, but final ς counts as σ and long
+// ſ as s. İ (U+0130) and ı (U+0131), which fold only under the Turkic
+// rules, are unchanged, and ß stays one character rather than becoming
+// ss. With -bytes only ASCII letters are folded.
+//
+// The -format-version option begins the output with a line declaring
+// the version of the table format, "# freq format 1", so programs that
+// read the output can detect a change. With -format=json the records
+// are instead wrapped in an object, {"format":1,"records":[...]}, and
+// with -format=csv each row begins with a format column holding the
+// version. -merge accepts all three and rejects versions it does not
+// know.
+//
+// The -chunks=N option counts each large regular file in N sections at
+// once, which is faster on a machine with several processors. In rune
+// mode the sections are divided at the start of a character, so the
+// counts are exactly those of reading the file in order. Options that
+// need the input in order, such as -fold-combining, -dedupe, -positions
+// or -show-errors, read it in order regardless.
+//
+// The -j=N option counts up to N input files at once, or as many as
+// GOMAXPROCS if N is 0, which speeds up a run over many files on a
+// machine with several processors. The counts are the same as reading
+// the files one at a time. Like -chunks, it reads in order regardless
+// when the options need it.
+//
+// The -surrogates option adds to each entry above U+FFFF the UTF-16
+// surrogate pair that encodes it, high then low, as in "D83D DE00" for
+// U+1F600.
+//
+// The -timing option reports on standard error, after counting, the
+// bytes read, the time taken to read them and the rate in megabytes
+// (10⁶ bytes) per second. Merged tables and state are not included.
+//
+// The -replacements option ends the output with two lines that set the
+// count of U+FFFD REPLACEMENT CHARACTER properly encoded in the input,
+// labeled "U+FFFD (literal)", beside the count of decode errors,
+// labeled "decode-error". A literal U+FFFD usually means the text was
+// damaged before it was encoded, an error that it was damaged after.
+//
+// The -mem-report option reports on standard error how many of the
+// lazily allocated arrays of the counts were needed, second level (each
+// covering 65536 code points) and third level (each covering 256), the
+// memory they occupy, and how many of the third level's slots were used.
+//
+// The -page option shows the output through a pager when standard
+// output is a terminal: $PAGER if set, otherwise less -R or more.
+// Otherwise it does nothing.
+//
+// The -width=N option counts only the characters whose UTF-8 encoding
+// is N bytes long, 1 to 4: -width=4, for instance, counts only those
+// above U+FFFF, such as most emoji. Decode errors are counted as usual.
+//
+// The -probabilities option replaces each count in the table with its
+// share of the total as a fraction, printed with -precision digits after
+// the decimal point, so the column sums to 1 and can be used directly as
+// a distribution. Decode errors are part of the total, and shown as
+// fractions, only with -errors-in-total. An empty input prints no table.
+//
+// The -percent option follows each count in the table with its share of
+// the total as a percentage and, when the table is sorted by count or
+// percent, the cumulative share of the rows printed so far, so that
+// -sortby=count:desc -percent shows how few characters make up most of
+// the input. It applies as well to the tables of words, lines, n-grams,
+// clusters, emoji and -by groups.
+//
+// The -longest-runs option ends the output with the longest run of each
+// character, the most times it appeared in succession, as in
+// "run 0078 x\t4096", longest first. A long run is often padding or
+// something pathological. Runs do not continue from one input to the
+// next.
+//
+// When no input is named and standard input is a terminal, freq prints
+// a hint and exits with status 2 rather than waiting for text that was
+// probably never meant to be typed. With -stdin-timeout=D it waits D for
+// something to be typed first. Naming "-" reads the terminal as before.
+//
+// The -format option chooses the form of the table: plain, the default;
+// tsv, the same as -tsv; or json or csv, for other programs. The last
+// two print a record for each line of the table with the fields kind
+// (char, error or surrogate), codepoint (the hex key, or the bad byte of
+// an error), char (the character itself, or empty if it is hidden or is
+// not a character) and count. JSON is an array with a record per line;
+// CSV has a header line. Options that print something other than the
+// table, such as -plane, take precedence, and -markdown cannot be
+// combined with json or csv, nor -columns with json.
+//
+// The -words option counts words instead of characters: runs of
+// characters separated by white space, or, with -field-regexp=RE, by
+// matches of RE. A word never spans lines. Each word is printed with
+// its count, as "word\tcount", sorted and filtered as characters are,
+// with -sortby=char or name sorting by the words' bytes. -field, -fold,
+// -by-bytes, -format and -human apply as they do to characters; the
+// options that analyze characters do not, and invalid UTF-8 is counted
+// as part of the word that holds it. -fold folds each character of a
+// word, so ΟΔΟΣ and οδος, with its final sigma, count as one word.
+// -markdown, -columns and -classify-frequency, which shape the table of
+// characters, and -hash are rejected.
+//
+// The -lines option counts lines as -words counts words, each line, or
+// its -field, being one word, so that freq -lines does the work of
+// sort | uniq -c without sorting the input first: it is read as it
+// arrives and only the distinct lines are kept. The line's newline, and
+// a carriage return before it, are not part of the line, and an empty
+// line counts. So that each line prints in its column, tabs, carriage
+// returns and backslashes in it are printed as \t, \r and \\, and the
+// other ASCII controls as \xNN.
+//
+// The -ngram=N option counts the sequences of N consecutive characters,
+// as counted after -map and the folding options, and prints them instead
+// of the characters, each as the keys of its characters joined by "+"
+// and their glyphs, as in "0074+0068 th\t12". Newlines are characters
+// like any other, so sequences span lines, but not inputs. The table is
+// sorted and formatted as for -words.
+//
+// The -by option rolls the counts up into groups and prints the total
+// of each group instead of the characters: -by=category groups them by
+// general category, such as Lu or Nd, -by=block by Unicode block and
+// -by=script by script, such as Latin or Han. Characters of no block or
+// script are grouped as No_Block or Unknown. The groups are sorted by
+// -sortby, with char or name sorting them by name.
+//
+// The -names option adds a column with the Unicode name of each
+// character, such as ZERO WIDTH SPACE, or "-" if it has none. Control
+// characters, which have no names, are given their Unicode 1.0 names,
+// such as LINE FEED (LF). The names are those of the Unicode version
+// of the tables, generated into nametables.go by maketables.go. The
+// column can also be chosen as "name" with -columns.
+//
+// The -stats option ends the output with a summary of the counts: the
+// number of runes (or bytes), the number of distinct ones, the Shannon
+// entropy in bits per character, near 8 for bytes of compressed or
+// encrypted data, and the most and least frequent characters. With
+// -quiet it is printed instead of the table.
+//
+// The -normalize=FORM option counts the input as normalized to one of
+// the Unicode normalization forms nfc, nfd, nfkc or nfkd, so that, for
+// instance, é counts the same whether it was encoded precomposed or as e
+// and a combining acute accent. The compatibility forms also count the
+// likes of the ligature ﬁ as f and i. Together with -fold, it makes
+// characters that differ only in case or encoding count as one. It
+// cannot be combined with -fold-combining, which composes more simply.
+//
+// The -decompress option recognizes inputs, files or standard input,
+// compressed by gzip, bzip2 or zstd, by their magic numbers, and counts
+// their decompressed contents; other inputs are counted as they are.
+// Offsets in reports are those of the decompressed text. Zstandard
+// streams that need a dictionary cannot be read.
+//
+// The -r option counts the files in each directory named as input and
+// in its subdirectories, in lexical order. The repeatable -include=GLOB
+// option counts only the files whose names match one of the patterns,
+// as by path/filepath.Match, such as -include='*.go', and the repeatable
+// -exclude=GLOB option skips the files and directories whose names
+// match, such as -exclude=.git or -exclude=vendor. Symbolic links are
+// not followed.
+//
+// The repeatable -only=SET option counts only the characters in one of
+// the sets, and the repeatable -ignore=SET option does not count those
+// in any of them, so the table and its totals describe just the rest.
+// A set is a range of code points, as in -only=U+0080..U+10FFFF for
+// everything but ASCII, a single code point such as U+00A0, or a regular
+// expression matching one character, such as \p{Cc} or [aeiou]. The
+// sets apply after -map and the folding options.
+//
+// The -graphemes option counts extended grapheme clusters, by the rules
+// of Unicode Standard Annex #29, instead of characters, so that an emoji
+// sequence joined by ZWJ, or a letter and its combining marks, counts as
+// the unit a reader sees. The table is printed as for -ngram, each
+// cluster as the keys of its characters joined by "+" and its glyphs,
+// and sorted and formatted as for -words. As with -emoji, the characters
+// are taken as they are, without -map or the folding options. A cluster
+// does not continue past a decode error or the end of an input.
 package main // import "robpike.io/cmd/freq"
 
 import (
@@ -15,33 +627,854 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"strconv"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 var (
-	countBytes bool
+	countBytes        bool
+	cstyle            bool
+	classifyCmd       string
+	showErrors        int
+	field             int
+	delim             string
+	recoverBytes      bool
+	maxRuntime        time.Duration
+	foldCombining     bool
+	zeroWidth         bool
+	files             fileList
+	byteShare         bool
+	stateFile         string
+	quiet             bool
+	threshold         float64
+	splitSurrogates   bool
+	unicodeDataFile   string
+	dedupe            bool
+	emitGo            bool
+	coverage          bool
+	merges            fileList
+	mergeDirs         fileList
+	sortBy            string
+	noControls        bool
+	pad               int
+	mapFile           string
+	warnMixed         bool
+	resetOn           string
+	outputEncoding    string
+	graphemeRatio     bool
+	strict            bool
+	partial           bool
+	classifyFrequency bool
+	rarePercentile    float64
+	pngFile           string
+	emojiMode         bool
+	errorsInTotal     bool
+	maxMemory         int64
+	tsv               bool
+	trojanSource      bool
+	human             bool
+	byExt             bool
+	chiSquare         bool
+	showPositions     bool
+	distinct          bool
+	byBytes           bool
+	foldDigits        bool
+	bufSize           int
+	controlPictures   bool
+	headLines         int
+	referenceFile     string
+	divergenceMetric  string
+	markdown          bool
+	windowSize        int
+	caseReport        bool
+	guessEncoding     bool
+	property          string
+	columnList        string
+	flushEvery        int
+	condEntropy       bool
+	trailingWS        bool
+	trailingWSFail    bool
+	planes            bool
+	utf16             bool
+	hashIDs           bool
+	salt              string
+	wcSummary         bool
+	topPerCategory    int
+	alertCodePoint    string
+	alertCount        uint64
+	foldCase          bool
+	formatVersion     bool
+	chunks            int
+	surrogatePairs    bool
+	timing            bool
+	replacements      bool
+	filesFrom0        string
+	memReport         bool
+	page              bool
+	runeWidth         int
+	probabilities     bool
+	precision         int
+	longestRuns       bool
+	stdinTimeout      time.Duration
+	outputFormat      string
+	wordMode          bool
+	fieldRegexp       string
+	ngram             int
+	perFile           bool
+	showTotal         bool
+	jobs              int
+	aggregateBy       string
+	showNames         bool
+	stats             bool
+	normalizeForm     string
+	decompress        bool
+	recursive         bool
+	includes          fileList
+	excludes          fileList
+	onlySpecs         fileList
+	ignoreSpecs       fileList
+	graphemeMode      bool
+	lineMode          bool
+	inputEncoding     string
+	baselineFile      string
+	saveFile          string
+	showPercent       bool
+	minCount          uint64
+	maxCount          uint64
+	topN              int
+	histogram         bool
+	histogramWidth    int
+	plotFormat        string
+	escape            bool
+	utf8Lengths       bool
+	follow            bool
+	followInterval    time.Duration
+	listenAddr        string
+	listenTop         int
 )
 
+// outputCharmap is the -output-encoding, or nil for UTF-8.
+var outputCharmap *charmap
+
+// resetRE is the compiled -reset-on pattern.
+var resetRE *regexp.Regexp
+
+// runeOnly holds the options that cannot be combined with -bytes.
+var runeOnly = map[string]*bool{
+	"emoji":         &emojiMode,
+	"trojan-source": &trojanSource,
+	"case-report":   &caseReport,
+	"trailing-ws":   &trailingWS,
+	"plane":         &planes,
+	"graphemes":     &graphemeMode,
+	"utf8len":       &utf8Lengths,
+	"utf16":         &utf16,
+	"surrogates":    &surrogatePairs,
+	"replacements":  &replacements,
+}
+
+// alertRune is the parsed form of -alert-codepoint, or -1.
+var alertRune rune = -1
+
+// columns is the parsed form of -columns.
+var columns []string
+
+// sortKey and sortDesc are the parsed form of -sortby.
+var (
+	sortKey  string
+	sortDesc bool
+)
+
+// fileList is the flag.Value for the repeatable -file option.
+type fileList []string
+
+func (l *fileList) String() string { return strings.Join(*l, ",") }
+
+func (l *fileList) Set(file string) error {
+	*l = append(*l, file)
+	return nil
+}
+
+// has reports whether file is in the list.
+func (l fileList) has(file string) bool {
+	for _, f := range l {
+		if f == file {
+			return true
+		}
+	}
+	return false
+}
+
+// timedOut is set when -max-runtime expires; the read loops poll it.
+// deadline is closed at the same moment, to release a blocked read.
+var (
+	timedOut atomic.Bool
+	deadline = make(chan struct{})
+)
+
+// timedReader is the input under -max-runtime. Each read runs in its own
+// goroutine, so a read that is still blocked when the time runs out is
+// abandoned and returns EOF, and anything it reads later is dropped.
+type timedReader struct {
+	r    io.Reader
+	done chan timedRead // The pending read, if any.
+}
+
+// timedRead is the result of a read by a timedReader.
+type timedRead struct {
+	buf []byte
+	err error
+}
+
+func (t *timedReader) Read(p []byte) (int, error) {
+	if timedOut.Load() {
+		return 0, io.EOF
+	}
+	if t.done == nil {
+		t.done = make(chan timedRead, 1)
+		buf := make([]byte, len(p))
+		go func(done chan<- timedRead) {
+			n, err := t.r.Read(buf)
+			done <- timedRead{buf[:n], err}
+		}(t.done)
+	}
+	select {
+	case res := <-t.done:
+		t.done = nil
+		if timedOut.Load() {
+			return 0, io.EOF
+		}
+		return copy(p, res.buf), res.err
+	case <-deadline:
+		return 0, io.EOF
+	}
+}
+
 func init() {
 	flag.BoolVar(&countBytes, "bytes", false, "count bytes (default is runes)")
 	flag.BoolVar(&countBytes, "b", false, "alias for -bytes")
+	flag.BoolVar(&cstyle, "cstyle", false, "print unprintable characters as C-style escapes")
+	flag.StringVar(&classifyCmd, "classify-cmd", "", "group counts by the labels printed by shell `command`")
+	flag.IntVar(&showErrors, "show-errors", 0, "report the offset and context of the first `K` decode errors, or of all if K is negative")
+	flag.IntVar(&field, "field", 0, "count only field `N` of each line (numbered from 1)")
+	flag.StringVar(&delim, "delim", "\t", "field separator for -field")
+	flag.BoolVar(&recoverBytes, "recover", false, "also count the raw bytes of invalid UTF-8")
+	flag.DurationVar(&maxRuntime, "max-runtime", 0, "stop reading after `duration` and print the partial counts")
+	flag.BoolVar(&foldCombining, "fold-combining", false, "count characters and following combining marks as their composition")
+	flag.BoolVar(&zeroWidth, "zero-width", false, "print only invisible and format characters, with their names")
+	flag.Var(&files, "file", "read input from `file` (repeatable; - is standard input)")
+	flag.BoolVar(&byteShare, "byte-share", false, "add a column with each character's share of the input bytes")
+	flag.StringVar(&stateFile, "state", "", "accumulate counts across runs in `file`")
+	flag.BoolVar(&quiet, "quiet", false, "do not print the table")
+	flag.Float64Var(&threshold, "threshold-percent", 0, "omit characters below `P` percent of the total")
+	flag.BoolVar(&splitSurrogates, "split-surrogates", false, "count encoded UTF-16 surrogates separately from decode errors")
+	flag.StringVar(&unicodeDataFile, "unicode-data", "", "take character properties from UnicodeData.txt `file`")
+	flag.BoolVar(&dedupe, "dedupe", false, "count a run of the same character once")
+	flag.BoolVar(&emitGo, "emit-go", false, "print the table as a Go map literal")
+	flag.BoolVar(&coverage, "coverage", false, "report the fraction of each Unicode block's characters used")
+	flag.Var(&merges, "merge", "add the counts in the table in `file` (repeatable; - is standard input)")
+	flag.Var(&mergeDirs, "merge-dir", "add the counts in every table or state file in `directory` (repeatable)")
+	flag.StringVar(&sortBy, "sortby", "char", "sort the table by `key` (char, count, percent, bytes or name), with optional :asc or :desc")
+	flag.BoolVar(&noControls, "no-controls", false, "do not count control characters")
+	flag.IntVar(&pad, "pad", 0, "right-justify counts to `W` characters")
+	flag.StringVar(&mapFile, "map", "", "count characters as translated by the code point pairs in `file`")
+	flag.BoolVar(&warnMixed, "warn-mixed", false, "report words that mix scripts")
+	flag.StringVar(&resetOn, "reset-on", "", "print and reset the counts at each line matching `regexp`")
+	flag.BoolVar(&graphemeRatio, "grapheme-ratio", false, "report the numbers of grapheme clusters and runes")
+	flag.StringVar(&outputEncoding, "output-encoding", "utf-8", "print characters in `encoding` (utf-8, ascii, latin1 or windows-1252)")
+	flag.BoolVar(&strict, "strict", false, "exit at the first decode error or unreadable input")
+	flag.BoolVar(&partial, "partial", false, "with -strict, print the table counted before the error")
+	flag.BoolVar(&classifyFrequency, "classify-frequency", false, "label entries as unique, rare or common")
+	flag.Float64Var(&rarePercentile, "rare", 25, "with -classify-frequency, `percentile` of entries at or below which counts are rare")
+	flag.StringVar(&pngFile, "png", "", "with -bytes, write a histogram of the counts to `file` as a PNG image")
+	flag.BoolVar(&emojiMode, "emoji", false, "count only emoji, with sequences as single units")
+	flag.BoolVar(&errorsInTotal, "errors-in-total", false, "include decode errors in the totals for percentages")
+	flag.Int64Var(&maxMemory, "max-memory", 0, "stop adding map keys after about `bytes` of memory (0 is no limit)")
+	flag.BoolVar(&tsv, "tsv", false, "print tab-separated columns, escaping tabs and newlines")
+	flag.BoolVar(&trojanSource, "trojan-source", false, "report directional formatting characters and exit 1 if any are found")
+	flag.BoolVar(&human, "human", false, "abbreviate counts with K, M and G suffixes")
+	flag.BoolVar(&byExt, "by-ext", false, "print a table for each file extension")
+	flag.BoolVar(&chiSquare, "chi2", false, "with -bytes, test the counts against a uniform distribution")
+	flag.BoolVar(&showPositions, "positions", false, "add columns with the first and last positions of each character")
+	flag.BoolVar(&distinct, "distinct", false, "list the characters that appear, without counts")
+	flag.BoolVar(&byBytes, "by-bytes", false, "add a column of the bytes each character contributes and sort by it")
+	flag.BoolVar(&foldDigits, "fold-digits", false, "count decimal digits of all scripts as ASCII digits")
+	flag.IntVar(&bufSize, "bufsize", 4096, "buffer input and output in `N` bytes")
+	flag.BoolVar(&controlPictures, "control-pictures", false, "show control characters as their Unicode control pictures")
+	flag.IntVar(&headLines, "head-lines", 0, "count only the first `N` lines of each input (0 is all)")
+	flag.StringVar(&referenceFile, "reference", "", "report the divergence of the counts from the table in `file`")
+	flag.StringVar(&divergenceMetric, "divergence", "tv", "with -reference, the `metric`: tv (total variation) or kl (Kullback-Leibler)")
+	flag.BoolVar(&markdown, "markdown", false, "print the table in Markdown")
+	flag.IntVar(&windowSize, "window", 0, "report the distinct characters in each `N` characters of input")
+	flag.BoolVar(&caseReport, "case-report", false, "print the numbers of upper, lower and title case letters")
+	flag.BoolVar(&guessEncoding, "guess", false, "print the likely encodings of the input")
+	flag.StringVar(&property, "property", "", "count only characters with the Unicode property `name`")
+	flag.StringVar(&columnList, "columns", "", "print the comma-separated `list` of columns: code, char, count, percent, bytes, name")
+	flag.IntVar(&flushEvery, "flush-every", 0, "flush the output after every `N` lines (0 is when done)")
+	flag.BoolVar(&condEntropy, "conditional-entropy", false, "report the entropy of each character given the one before")
+	flag.BoolVar(&trailingWS, "trailing-ws", false, "report the lines with trailing white space")
+	flag.BoolVar(&trailingWSFail, "trailing-ws-fail", false, "with -trailing-ws, exit 1 if any line has trailing white space")
+	flag.BoolVar(&planes, "plane", false, "print the total count of each Unicode plane")
+	flag.BoolVar(&utf16, "utf16", false, "report the length of the input in UTF-16 code units")
+	flag.BoolVar(&hashIDs, "hash", false, "print hashed identifiers instead of the characters")
+	flag.StringVar(&salt, "salt", "", "with -hash, the `key` for the identifiers")
+	flag.BoolVar(&wcSummary, "wc", false, "also report the line, word and byte counts, like wc")
+	flag.IntVar(&topPerCategory, "top-per-category", 0, "print the `N` most frequent characters of each general category")
+	flag.StringVar(&alertCodePoint, "alert-codepoint", "", "exit with status 3 when the count of code point `CP` exceeds -alert-count")
+	flag.Uint64Var(&alertCount, "alert-count", 0, "with -alert-codepoint, the count `N` to exceed")
+	flag.BoolVar(&foldCase, "fold", false, "count characters as their simple case folding")
+	flag.BoolVar(&formatVersion, "format-version", false, "begin the output with a line giving the format version")
+	flag.IntVar(&chunks, "chunks", 1, "count each large file in `N` sections concurrently")
+	flag.BoolVar(&surrogatePairs, "surrogates", false, "show the UTF-16 surrogate pair of characters above U+FFFF")
+	flag.BoolVar(&timing, "timing", false, "report the bytes read and the rate of reading on standard error")
+	flag.BoolVar(&replacements, "replacements", false, "print the counts of literal U+FFFD and of decode errors")
+	flag.StringVar(&filesFrom0, "files-from0", "", "read NUL-separated input file names from `file`")
+	flag.BoolVar(&memReport, "mem-report", false, "report the arrays allocated for the counts on standard error")
+	flag.BoolVar(&page, "page", false, "show the output through a pager if standard output is a terminal")
+	flag.IntVar(&runeWidth, "width", 0, "count only characters whose UTF-8 encoding is `n` bytes long")
+	flag.BoolVar(&probabilities, "probabilities", false, "print each count as a fraction of the total")
+	flag.IntVar(&precision, "precision", 6, "with -probabilities, the number of `digits` after the decimal point")
+	flag.BoolVar(&longestRuns, "longest-runs", false, "print the longest run of each character")
+	flag.DurationVar(&stdinTimeout, "stdin-timeout", 0, "when no input is named and standard input is a terminal, wait `duration` for typing")
+	flag.StringVar(&outputFormat, "format", "plain", "print the table in `form` plain, json, csv or tsv")
+	flag.BoolVar(&wordMode, "words", false, "count words instead of characters")
+	flag.StringVar(&fieldRegexp, "field-regexp", "", "with -words, separate words by matches of `regexp` instead of white space")
+	flag.IntVar(&ngram, "ngram", 0, "count the sequences of `N` consecutive characters")
+	flag.BoolVar(&perFile, "per-file", false, "print a table for each input file")
+	flag.BoolVar(&showTotal, "total", false, "with -per-file or -by-ext, also print the table of all the input")
+	flag.IntVar(&jobs, "j", 1, "count up to `N` files at once (0 is GOMAXPROCS)")
+	flag.StringVar(&aggregateBy, "by", "", "print the totals of each `group`: category, block or script")
+	flag.BoolVar(&showNames, "names", false, "add a column with the Unicode name of each character")
+	flag.BoolVar(&stats, "stats", false, "print the totals, entropy and most and least frequent characters")
+	flag.StringVar(&normalizeForm, "normalize", "", "count the input normalized to `form` nfc, nfd, nfkc or nfkd")
+	flag.BoolVar(&decompress, "decompress", false, "count the decompressed contents of gzip and bzip2 inputs")
+	flag.BoolVar(&recursive, "r", false, "count the files in directories named as input, recursively")
+	flag.Var(&includes, "include", "with -r, count only files whose names match `glob` (repeatable)")
+	flag.Var(&excludes, "exclude", "with -r, skip files and directories whose names match `glob` (repeatable)")
+	flag.Var(&onlySpecs, "only", "count only the characters in `set`, a range, code point or regexp (repeatable)")
+	flag.Var(&ignoreSpecs, "ignore", "do not count the characters in `set`, a range, code point or regexp (repeatable)")
+	flag.BoolVar(&graphemeMode, "graphemes", false, "count grapheme clusters instead of characters")
+	flag.BoolVar(&lineMode, "lines", false, "count lines instead of characters")
+	flag.StringVar(&inputEncoding, "encoding", "utf-8", "read input in `encoding` (utf-8, utf-16le, utf-16be, utf-16, auto or a single-byte encoding)")
+	flag.StringVar(&baselineFile, "baseline", "", "print the differences of the counts from those of the input `file`")
+	flag.StringVar(&saveFile, "o", "", "save the counts to `file`, to be read by -merge")
+	flag.BoolVar(&showPercent, "percent", false, "follow each count with its percentage of the total, and the cumulative percentage if sorted by count")
+	flag.Uint64Var(&minCount, "min", 0, "omit characters counted fewer than `N` times")
+	flag.Uint64Var(&maxCount, "max", 0, "omit characters counted more than `N` times (0 is no limit)")
+	flag.IntVar(&topN, "top", 0, "print only the `N` most frequent characters")
+	flag.BoolVar(&histogram, "histogram", false, "follow each count with a bar proportional to it")
+	flag.IntVar(&histogramWidth, "histogram-width", 0, "with -histogram, the length of the longest bar in `characters` (0 fits the terminal)")
+	flag.StringVar(&plotFormat, "plot", "", "print a bar chart of the counts in `format` (html) instead of the table")
+	flag.BoolVar(&escape, "escape", false, "print white space and invisible characters as escapes or names")
+	flag.BoolVar(&utf8Lengths, "utf8len", false, "print the counts of characters by the length of their UTF-8 encoding")
+	flag.BoolVar(&follow, "follow", false, "keep reading the input as it grows, printing the table every -interval")
+	flag.DurationVar(&followInterval, "interval", 2*time.Second, "with -follow, print the table every `duration`")
+	flag.StringVar(&listenAddr, "listen", "", "serve metrics of the counts on `address` while counting")
+	flag.IntVar(&listenTop, "listen-top", 10, "with -listen, the `number` of most frequent characters served")
 }
 
 func main() {
 	flag.Parse()
-	if flag.NArg() == 0 {
-		read("<stdin>", os.Stdin)
+	if field < 0 || delim == "" {
+		fmt.Fprintln(os.Stderr, "freq: -field must be positive and -delim non-empty")
+		exit(2)
+	}
+	if bufSize <= 0 {
+		fmt.Fprintln(os.Stderr, "freq: -bufsize must be positive")
+		exit(2)
+	}
+	stdout.w = bufio.NewWriterSize(os.Stdout, bufSize)
+	stdout.every = flushEvery
+	if page {
+		if err := startPager(); err != nil {
+			fmt.Fprintln(os.Stderr, "freq: -page:", err)
+			exit(1)
+		}
+	}
+	if runeWidth != 0 && (runeWidth < 1 || runeWidth > utf8.UTFMax) {
+		fmt.Fprintln(os.Stderr, "freq: -width must be between 1 and 4")
+		exit(2)
+	}
+	if runeWidth != 0 && countBytes {
+		fmt.Fprintln(os.Stderr, "freq: -width counts runes, not bytes")
+		exit(2)
+	}
+	if !contains(outputFormats, outputFormat) {
+		fmt.Fprintf(os.Stderr, "freq: unknown -format %q; want one of %s\n", outputFormat, strings.Join(outputFormats, ", "))
+		exit(2)
+	}
+	if !contains(divergences, divergenceMetric) {
+		fmt.Fprintf(os.Stderr, "freq: unknown -divergence %q; want one of %s\n", divergenceMetric, strings.Join(divergences, ", "))
+		exit(2)
+	}
+	if outputFormat == "tsv" {
+		tsv = true
+	}
+	if (outputFormat == "json" || outputFormat == "csv") && markdown {
+		fmt.Fprintf(os.Stderr, "freq: -format=%s cannot be combined with -markdown\n", outputFormat)
+		exit(2)
+	}
+	if outputFormat == "json" && columnList != "" {
+		fmt.Fprintln(os.Stderr, "freq: -format=json cannot be combined with -columns")
+		exit(2)
+	}
+	if lineMode {
+		if wordMode {
+			fmt.Fprintln(os.Stderr, "freq: -lines cannot be combined with -words")
+			exit(2)
+		}
+		wordMode = true
+	}
+	if fieldRegexp != "" {
+		if !wordMode || lineMode {
+			fmt.Fprintln(os.Stderr, "freq: -field-regexp requires -words")
+			exit(2)
+		}
+		re, err := regexp.Compile(fieldRegexp)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "freq: -field-regexp:", err)
+			exit(2)
+		}
+		wordRE = re
+	}
+	if wordMode && (markdown || columnList != "" || classifyFrequency || hashIDs) {
+		fmt.Fprintln(os.Stderr, "freq: -words and -lines cannot be combined with -markdown, -columns, -classify-frequency or -hash")
+		exit(2)
+	}
+	if wordMode && showErrors != 0 {
+		fmt.Fprintln(os.Stderr, "freq: -show-errors cannot be combined with -words or -lines, which count invalid UTF-8 as part of a word")
+		exit(2)
+	}
+	if wordMode && (stateFile != "" || len(merges) > 0 || len(mergeDirs) > 0 || resetOn != "" || grouping() || countBytes) {
+		fmt.Fprintln(os.Stderr, "freq: -words cannot be combined with -state, -merge, -merge-dir, -reset-on, -by-ext, -per-file or -bytes")
+		exit(2)
+	}
+	if plotFormat != "" {
+		if !contains(plotFormats, plotFormat) {
+			fmt.Fprintf(os.Stderr, "freq: unknown -plot %q; want one of %s\n", plotFormat, strings.Join(plotFormats, ", "))
+			exit(2)
+		}
+		if markdown || columnList != "" || outputFormat == "json" || outputFormat == "csv" {
+			fmt.Fprintln(os.Stderr, "freq: -plot cannot be combined with -markdown, -columns or -format")
+			exit(2)
+		}
+	}
+	if follow {
+		if len(files)+flag.NArg() > 1 || len(merges) > 0 || len(mergeDirs) > 0 {
+			fmt.Fprintln(os.Stderr, "freq: -follow reads one input, and cannot be combined with -merge or -merge-dir")
+			exit(2)
+		}
+		if grouping() || resetOn != "" || page || quiet {
+			fmt.Fprintln(os.Stderr, "freq: -follow cannot be combined with -by-ext, -per-file, -reset-on, -page or -quiet")
+			exit(2)
+		}
+		if followInterval <= 0 {
+			fmt.Fprintln(os.Stderr, "freq: -interval must be positive")
+			exit(2)
+		}
+	}
+	if listenAddr != "" {
+		if listenTop < 0 {
+			fmt.Fprintln(os.Stderr, "freq: -listen-top must not be negative")
+			exit(2)
+		}
+	}
+	if histogramWidth < 0 {
+		fmt.Fprintln(os.Stderr, "freq: -histogram-width must not be negative")
+		exit(2)
+	}
+	if topN < 0 {
+		fmt.Fprintln(os.Stderr, "freq: -top must not be negative")
+		exit(2)
+	}
+	if ngram < 0 {
+		fmt.Fprintln(os.Stderr, "freq: -ngram must be positive")
+		exit(2)
+	}
+	if ngram > 0 && (wordMode || stateFile != "" || len(merges) > 0 || len(mergeDirs) > 0 || resetOn != "" || grouping()) {
+		fmt.Fprintln(os.Stderr, "freq: -ngram cannot be combined with -words, -state, -merge, -merge-dir, -reset-on, -by-ext or -per-file")
+		exit(2)
+	}
+	if aggregateBy != "" {
+		if aggregates[aggregateBy] == nil {
+			fmt.Fprintf(os.Stderr, "freq: unknown -by %q; want category, block or script\n", aggregateBy)
+			exit(2)
+		}
+		if countBytes {
+			fmt.Fprintln(os.Stderr, "freq: -by counts runes, not bytes")
+			exit(2)
+		}
+	}
+	if normalizeForm != "" {
+		if err := setNormalization(strings.ToLower(normalizeForm)); err != nil {
+			fmt.Fprintln(os.Stderr, "freq: -normalize:", err)
+			exit(2)
+		}
+		if countBytes || foldCombining {
+			fmt.Fprintln(os.Stderr, "freq: -normalize cannot be combined with -bytes or -fold-combining")
+			exit(2)
+		}
+	}
+	for _, p := range append(includes[:len(includes):len(includes)], excludes...) {
+		if _, err := filepath.Match(p, ""); err != nil {
+			fmt.Fprintf(os.Stderr, "freq: bad pattern %q: %s\n", p, err)
+			exit(2)
+		}
+	}
+	if (len(includes) > 0 || len(excludes) > 0) && !recursive {
+		fmt.Fprintln(os.Stderr, "freq: -include and -exclude require -r")
+		exit(2)
+	}
+	if graphemeMode && (emojiMode || wordMode || ngram > 0 || stateFile != "" || len(merges) > 0 || len(mergeDirs) > 0 || resetOn != "" || grouping()) {
+		fmt.Fprintln(os.Stderr, "freq: -graphemes cannot be combined with -emoji, -words, -ngram, -state, -merge, -merge-dir, -reset-on, -by-ext or -per-file")
+		exit(2)
+	}
+	if err := parseRuneSets(); err != nil {
+		fmt.Fprintln(os.Stderr, "freq: -only or -ignore:", err)
+		exit(2)
+	}
+	if jobs < 0 {
+		fmt.Fprintln(os.Stderr, "freq: -j must not be negative")
+		exit(2)
+	}
+	if jobs == 0 {
+		jobs = runtime.GOMAXPROCS(0)
+	}
+	if precision < 0 || precision > 20 {
+		fmt.Fprintln(os.Stderr, "freq: -precision must be between 0 and 20")
+		exit(2)
+	}
+	if rarePercentile < 0 || rarePercentile > 100 {
+		fmt.Fprintln(os.Stderr, "freq: -rare must be between 0 and 100")
+		exit(2)
+	}
+	for name, set := range runeOnly {
+		if *set && countBytes {
+			fmt.Fprintf(os.Stderr, "freq: -%s counts runes, not bytes\n", name)
+			exit(2)
+		}
+	}
+	if trailingWS && field > 0 {
+		fmt.Fprintln(os.Stderr, "freq: -trailing-ws cannot be combined with -field")
+		exit(2)
+	}
+	if byExt && perFile {
+		fmt.Fprintln(os.Stderr, "freq: -by-ext cannot be combined with -per-file")
+		exit(2)
+	}
+	if grouping() && (stateFile != "" || len(merges) > 0 || len(mergeDirs) > 0 || resetOn != "" || emojiMode || longestRuns) {
+		fmt.Fprintln(os.Stderr, "freq: -by-ext and -per-file cannot be combined with -state, -merge, -merge-dir, -reset-on, -emoji or -longest-runs")
+		exit(2)
+	}
+	if showTotal && !grouping() {
+		fmt.Fprintln(os.Stderr, "freq: -total requires -per-file or -by-ext")
+		exit(2)
+	}
+	if pngFile != "" && !countBytes {
+		fmt.Fprintln(os.Stderr, "freq: -png requires -bytes")
+		exit(2)
+	}
+	if chiSquare && !countBytes {
+		fmt.Fprintln(os.Stderr, "freq: -chi2 requires -bytes")
+		exit(2)
+	}
+	var err error
+	if sortKey, sortDesc, err = parseSortBy(sortBy); err != nil {
+		fmt.Fprintln(os.Stderr, "freq:", err)
+		exit(2)
+	}
+	if byBytes {
+		sortKey, sortDesc = "bytes", true
+	}
+	if resetOn != "" {
+		if resetRE, err = regexp.Compile(resetOn); err != nil {
+			fmt.Fprintln(os.Stderr, "freq: -reset-on:", err)
+			exit(2)
+		}
+	}
+	if e := strings.ToLower(outputEncoding); e != "utf-8" && e != "utf8" {
+		if outputCharmap, err = lookupCharmap(e); err != nil {
+			fmt.Fprintln(os.Stderr, "freq: -output-encoding:", err)
+			exit(2)
+		}
+	}
+	if err := setInputEncoding(inputEncoding); err != nil {
+		fmt.Fprintln(os.Stderr, "freq: -encoding:", err)
+		exit(2)
+	}
+	if inputDecoding != "" && (countBytes || guessEncoding) {
+		fmt.Fprintln(os.Stderr, "freq: -encoding cannot be combined with -bytes or -guess")
+		exit(2)
+	}
+	if baselineFile != "" && (wordMode || ngram > 0 || graphemeMode || emojiMode || aggregateBy != "" || stateFile != "" || len(merges) > 0 || len(mergeDirs) > 0 ||
+		grouping() || showPositions || markdown || columnList != "" || outputFormat == "json" || outputFormat == "csv") {
+		fmt.Fprintln(os.Stderr, "freq: -baseline cannot be combined with the options that change what is counted or printed")
+		exit(2)
 	}
-	for _, file := range flag.Args() {
-		f, err := os.Open(file)
+	if saveFile != "" && (wordMode || ngram > 0 || graphemeMode || emojiMode || grouping() || resetOn != "") {
+		fmt.Fprintln(os.Stderr, "freq: -o cannot be combined with -words, -lines, -ngram, -graphemes, -emoji, -by-ext, -per-file or -reset-on")
+		exit(2)
+	}
+	if baselineFile == "-" {
+		fmt.Fprintln(os.Stderr, "freq: standard input cannot be the -baseline")
+		exit(2)
+	}
+	if merges.has("-") && (files.has("-") || fileList(flag.Args()).has("-")) {
+		fmt.Fprintln(os.Stderr, "freq: standard input cannot be both -merge and text input")
+		exit(2)
+	}
+	if filesFrom0 == "-" && (merges.has("-") || files.has("-") || fileList(flag.Args()).has("-")) {
+		fmt.Fprintln(os.Stderr, "freq: standard input cannot be both -files-from0 and input")
+		exit(2)
+	}
+	if filesFrom0 != "" {
+		names, err := readNames(filesFrom0)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, "freq:", err)
-			os.Exit(1)
+			exit(1)
 		}
-		read(file, f)
-		f.Close()
+		files = append(files, names...)
 	}
-	print()
+	if unicodeDataFile != "" {
+		ucd, err = loadUnicodeData(unicodeDataFile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(1)
+		}
+	}
+	if columnList != "" {
+		if columns, err = parseColumns(columnList); err != nil {
+			fmt.Fprintln(os.Stderr, "freq: -columns:", err)
+			exit(2)
+		}
+	}
+	if alertCodePoint != "" {
+		if alertRune, err = parseCodePoint(alertCodePoint); err != nil {
+			fmt.Fprintln(os.Stderr, "freq: -alert-codepoint:", err)
+			exit(2)
+		}
+	}
+	if property != "" {
+		if inProperty, err = lookupProperty(property); err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(2)
+		}
+	}
+	if referenceFile != "" {
+		if err := loadReference(referenceFile); err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(1)
+		}
+	}
+	if mapFile != "" {
+		runeMap, err = loadMap(mapFile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(1)
+		}
+	}
+	if stateFile != "" {
+		unlock, err := lockState(stateFile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(1)
+		}
+		cleanups = append(cleanups, unlock)
+		if err := loadState(stateFile); err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(1)
+		}
+	}
+	for _, file := range merges {
+		if err := mergeTable(file); err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(1)
+		}
+	}
+	for _, dir := range mergeDirs {
+		if err := mergeDir(dir); err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(1)
+		}
+	}
+	if maxRuntime > 0 {
+		time.AfterFunc(maxRuntime, func() {
+			timedOut.Store(true)
+			close(deadline)
+		})
+	}
+	if listenAddr != "" {
+		if err := startListener(listenAddr); err != nil {
+			fmt.Fprintln(os.Stderr, "freq: -listen:", err)
+			exit(1)
+		}
+	}
+	start := time.Now()
+	if baselineFile != "" {
+		countBaseline(baselineFile)
+	}
+	if len(files) == 0 && flag.NArg() == 0 && !merges.has("-") && len(mergeDirs) == 0 {
+		if !stdinRead && isTerminal(os.Stdin) {
+			stdinRead = true
+			read("<stdin>", terminalInput())
+		}
+		readFile("-")
+	}
+	names := append(files[:len(files):len(files)], flag.Args()...)
+	if recursive {
+		names = expandDirs(names)
+	}
+	readFiles(names)
+	if listenAddr != "" {
+		published.Store(snapshot())
+	}
+	if timing {
+		elapsed := time.Since(start)
+		fmt.Fprintf(os.Stderr, "freq: read %d bytes in %v, %.1f MB/s\n", bytesRead, elapsed.Round(time.Microsecond), float64(bytesRead)/1e6/elapsed.Seconds())
+	}
+	if memReport {
+		second, third, used := counts.Arrays()
+		size := (1 + second + third) * 256 * 8
+		fmt.Fprintf(os.Stderr, "freq: counts use %d second-level and %d third-level arrays, %d bytes; %d of %d slots used\n", second, third, size, used, third*256)
+	}
+	if timedOut.Load() {
+		fmt.Fprintf(os.Stderr, "freq: counting truncated after %v\n", maxRuntime)
+	}
+	if memoryExhausted {
+		fmt.Fprintf(os.Stderr, "freq: -max-memory reached; later keys were not kept\n")
+	}
+	if stateFile != "" {
+		if err := saveState(stateFile); err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(1)
+		}
+	}
+	if saveFile != "" {
+		if err := saveState(saveFile); err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(1)
+		}
+	}
+	if pngFile != "" {
+		if err := writePNG(pngFile); err != nil {
+			fmt.Fprintln(os.Stderr, "freq:", err)
+			exit(1)
+		}
+	}
+	if resetRE != nil {
+		flush()
+		if segment == 1 || counts.Total() > 0 || errors > 0 {
+			endSegment() // A delimiter that ends the input leaves nothing to print.
+		}
+	} else if grouping() && !quiet {
+		printGroups()
+	} else if !quiet {
+		print()
+	}
+	printSummary()
+	if inputFailed || bidiFound > 0 || trailingWSFail && trailing.lines > 0 {
+		exit(1)
+	}
+	exit(0)
+}
+
+// inputFailed records that an input could not be read to its end.
+var inputFailed bool
+
+// inputError reports err, a failure to read an input. With -strict freq
+// exits at once; otherwise the rest of the input is skipped and freq
+// carries on, to exit with status 1 after printing the table.
+func inputError(err error) {
+	fmt.Fprintln(os.Stderr, "freq:", err)
+	if strict {
+		exit(1)
+	}
+	inputFailed = true
+}
+
+// cleanups are run by exit before the program terminates.
+var cleanups []func()
+
+// exit runs the cleanups and exits with the given status.
+func exit(status int) {
+	if err := stdout.Flush(); err != nil && status == 0 {
+		fmt.Fprintln(os.Stderr, "freq:", err)
+		status = 1
+	}
+	for _, f := range cleanups {
+		f()
+	}
+	os.Exit(status)
+}
+
+// readNames returns the NUL-separated file names in file, or in
+// standard input if file is "-". Empty names are ignored.
+func readNames(file string) ([]string, error) {
+	var data []byte
+	var err error
+	if file == "-" {
+		stdinRead = true
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, name := range strings.Split(string(data), "\x00") {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// stdinRead records that standard input has been read.
+var stdinRead bool
+
+// bytesRead is the number of bytes of input counted, for -timing.
+var bytesRead int64
+
+// readFile counts the contents of file, which is standard input if the
+// name is "-".
+func readFile(file string) {
+	if timedOut.Load() {
+		return
+	}
+	if file == "-" {
+		if !stdinRead {
+			stdinRead = true
+			read("<stdin>", os.Stdin)
+		}
+		return
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		inputError(err)
+		return
+	}
+	defer f.Close()
+	if chunks > 1 && canSplit() && !decompress && inputDecoding == "" {
+		if info, err := f.Stat(); err == nil && info.Mode().IsRegular() {
+			if grouping() {
+				useGroup(file)
+			}
+			if err := readChunks(f, info.Size()); err != nil {
+				inputError(fmt.Errorf("%s: %s", file, err))
+				return
+			}
+			bytesRead += info.Size()
+			return
+		}
+	}
+	read(file, f)
 }
 
 // We lazily fill in the intermediate arrays, each 256 entries long.
@@ -52,8 +1485,23 @@ type Counts [256]*[256]*[256]uint64
 
 var counts = new(Counts) // Allocate the top level; we know we'll need it unless the input is empty.
 var errors uint64        // Special count to distinguish FFFD from real errors.
+var badBytes [256]uint64 // Bytes that caused errors; kept only with -recover.
+
+var surrogates = make(map[rune]uint64) // Encoded surrogates; kept only with -split-surrogates.
+
+// pos is the position in the input of the byte or rune being counted.
+var pos struct {
+	file   string
+	line   int
+	offset int64
+}
 
 func (c *Counts) Inc(r rune) {
+	c.Add(r, 1)
+}
+
+// Add adds n to the count for r.
+func (c *Counts) Add(r rune, n uint64) {
 	b2 := (r >> 16) & 0xFF
 	b1 := (r >> 8) & 0xFF
 	b0 := (r >> 0) & 0xFF
@@ -67,84 +1515,598 @@ func (c *Counts) Inc(r rune) {
 		c1 = new([256]uint64)
 		c2[b1] = c1
 	}
-	c1[b0]++
+	c1[b0] += n
 }
 
-func read(file string, f *os.File) {
-	if countBytes {
+// Merge adds the counts in other to c, allocating the intermediate
+// arrays of c as needed. The two share no storage afterwards, so counts
+// made separately, say by goroutines reading different files, can be
+// combined in any order with the same result. Decode errors are kept
+// outside Counts, as plain sums.
+func (c *Counts) Merge(other *Counts) {
+	for b2, o2 := range other {
+		if o2 == nil {
+			continue
+		}
+		c2 := c[b2]
+		if c2 == nil {
+			c2 = new([256]*[256]uint64)
+			c[b2] = c2
+		}
+		for b1, o1 := range o2 {
+			if o1 == nil {
+				continue
+			}
+			c1 := c2[b1]
+			if c1 == nil {
+				c1 = new([256]uint64)
+				c2[b1] = c1
+			}
+			for b0, n := range o1 {
+				c1[b0] += n
+			}
+		}
+	}
+}
+
+// Count returns the count for r.
+func (c *Counts) Count(r rune) uint64 {
+	c2 := (*c)[(r>>16)&0xFF]
+	if c2 == nil {
+		return 0
+	}
+	c1 := c2[(r>>8)&0xFF]
+	if c1 == nil {
+		return 0
+	}
+	return c1[r&0xFF]
+}
+
+// Total returns the sum of the counts.
+func (c *Counts) Total() uint64 {
+	var total uint64
+	c.Do(func(r rune, count uint64) {
+		total += count
+	})
+	return total
+}
+
+// Do calls f for each code point with a nonzero count, in increasing order.
+func (c *Counts) Do(f func(r rune, count uint64)) {
+	for b2, c2 := range *c {
+		if c2 == nil {
+			continue
+		}
+		for b1, c1 := range c2 {
+			if c1 == nil {
+				continue
+			}
+			for b0, count := range c1 {
+				if count != 0 {
+					f(rune((b2<<16)|(b1<<8)|b0), count)
+				}
+			}
+		}
+	}
+}
+
+// Arrays returns the number of second- and third-level arrays allocated
+// in c, and the number of code points with nonzero counts among them.
+func (c *Counts) Arrays() (second, third, used int) {
+	for _, c2 := range *c {
+		if c2 == nil {
+			continue
+		}
+		second++
+		for _, c1 := range c2 {
+			if c1 == nil {
+				continue
+			}
+			third++
+			for _, count := range c1 {
+				if count != 0 {
+					used++
+				}
+			}
+		}
+	}
+	return second, third, used
+}
+
+func read(file string, in io.Reader) {
+	pos.file, pos.line, pos.offset = file, 1, 0
+	if maxRuntime > 0 {
+		in = &timedReader{r: in}
+	}
+	if follow {
+		in = newFollower(in)
+	}
+	if listenAddr != "" {
+		in = &publisher{r: in}
+	}
+	if decompress {
+		var err error
+		if in, err = decompressor(in); err != nil {
+			inputError(fmt.Errorf("%s: %s", file, err))
+			return
+		}
+	}
+	in = newDecoder(in)
+	var f io.Reader = in
+	if headLines > 0 {
+		f = &lineLimiter{r: in, n: headLines}
+	}
+	if guessEncoding {
+		sniff.next()
+		f = io.TeeReader(f, &sniff)
+	}
+	if wcSummary {
+		wc.inWord = false
+		f = io.TeeReader(f, &wc)
+	}
+	if grouping() {
+		useGroup(file)
+	}
+	if warnMixed {
+		clear(mixed.reported)
+	}
+	switch {
+	case canSplit():
+		p := countPart(f)
+		p.add()
+		pos.offset += p.n
+		if p.err != nil {
+			inputError(fmt.Errorf("%s: %s", file, p.err))
+		}
+	case wordMode:
+		readWords(file, f)
+	case field > 0 || resetRE != nil:
+		readLines(file, f)
+	case countBytes:
 		readBytes(file, f)
-	} else {
+	default:
 		readRunes(file, f)
 	}
+	flush()
+	bytesRead += pos.offset
+	last, pairPrev = -1, -1
+	clusters = segmenter{}
+	if windowSize > 0 {
+		resetWindow()
+	}
+	if longestRuns {
+		endRun()
+	}
+	if ngram > 0 {
+		resetGram()
+	}
+	if trailingWS {
+		endTrailing()
+	}
+	if warnMixed {
+		endWord()
+	}
+}
+
+// lineLimiter reads from r until n newlines have been read, stopping
+// just after the last.
+type lineLimiter struct {
+	r io.Reader
+	n int
 }
 
-func readBytes(file string, f *os.File) {
-	buf := bufio.NewReader(f)
-	for {
+func (l *lineLimiter) Read(p []byte) (int, error) {
+	if l.n <= 0 {
+		return 0, io.EOF
+	}
+	n, err := l.r.Read(p)
+	for i, b := range p[:n] {
+		if b == '\n' {
+			if l.n--; l.n == 0 {
+				return i + 1, nil
+			}
+		}
+	}
+	return n, err
+}
+
+// readLines counts f a line at a time, for -field and -reset-on.
+func readLines(file string, f io.Reader) {
+	buf := bufio.NewReaderSize(f, bufSize)
+	for !timedOut.Load() {
+		line, err := buf.ReadString('\n')
+		start := pos.offset
+		if line != "" {
+			countLine(line)
+		}
+		pos.offset = start + int64(len(line))
+		if err != nil {
+			if err == io.EOF {
+				return
+			}
+			inputError(fmt.Errorf("%s: %s", file, err))
+			return
+		}
+	}
+}
+
+// countLine counts a line, including its terminator, or just the selected
+// field if -field is set.
+func countLine(line string) {
+	text := strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+	if resetRE != nil && resetRE.MatchString(text) {
+		endSegment()
+		pos.line++
+		return
+	}
+	if field == 0 {
+		countString(line)
+		return
+	}
+	if fields := strings.SplitN(text, delim, field+1); len(fields) >= field {
+		for _, f := range fields[:field-1] {
+			pos.offset += int64(len(f) + len(delim))
+		}
+		countString(fields[field-1])
+	}
+	pos.line++ // The field holds no newline to count.
+}
+
+// segment is the number of the current -reset-on segment.
+var segment = 1
+
+// endSegment prints the counts of the current -reset-on segment and
+// clears them for the next.
+func endSegment() {
+	flush()
+	if !quiet {
+		fmt.Fprintf(stdout, "# segment %d\n", segment)
+		print()
+	}
+	segment++
+	counts = new(Counts)
+	errors = 0
+	badBytes = [256]uint64{}
+	clear(surrogates)
+	clear(positions)
+}
+
+// countString counts the bytes or runes of s. Decode errors are reported
+// for -show-errors with the bytes of s around them.
+func countString(s string) {
+	if countBytes {
+		for i := 0; i < len(s); i++ {
+			inc(rune(s[i]))
+			pos.offset++
+		}
+		return
+	}
+	for i := 0; i < len(s); {
+		rune, width := utf8.DecodeRuneInString(s[i:])
+		if rune == utf8.RuneError && width == 1 {
+			if r, ok := surrogate([]byte(s[i:min(i+3, len(s))])); ok && splitSurrogates {
+				countSurrogate(r)
+				i += 3
+				pos.offset += 3
+				continue
+			}
+			if showErrors != 0 {
+				before, after := s[max(0, i-errorContext):i], s[i+1:min(i+1+errorContext, len(s))]
+				reportError(pos.file, pos.offset, []byte(before), s[i], []byte(after))
+			}
+			countError(s[i])
+		} else {
+			add(rune)
+		}
+		i += width
+		pos.offset += int64(width)
+	}
+}
+
+func readBytes(file string, f io.Reader) {
+	buf := bufio.NewReaderSize(f, bufSize)
+	for !timedOut.Load() {
 		byte, err := buf.ReadByte()
 		if err != nil {
 			if err == io.EOF {
 				return
 			}
-			fmt.Fprintf(os.Stderr, "freq: %s: %s\n", file, err)
-			os.Exit(1)
+			inputError(fmt.Errorf("%s: %s", file, err))
+			return
 		}
-		counts.Inc(rune(byte))
+		inc(rune(byte))
+		pos.offset++
 	}
 }
 
-func readRunes(file string, f *os.File) {
-	buf := bufio.NewReader(f)
-	for {
+func readRunes(file string, f io.Reader) {
+	buf := bufio.NewReaderSize(f, bufSize)
+	var recent history
+	for !timedOut.Load() {
 		rune, width, err := buf.ReadRune()
 		if err != nil {
 			if err == io.EOF {
 				return
 			}
-			fmt.Fprintf(os.Stderr, "freq: %s: %s\n", file, err)
-			os.Exit(1)
+			inputError(fmt.Errorf("%s: %s", file, err))
+			return
 		}
 		if rune == 0xFFFD && width == 1 {
-			errors++
+			var b byte
+			if showErrors != 0 || recoverBytes || splitSurrogates {
+				buf.UnreadRune()
+				if p, _ := buf.Peek(3); splitSurrogates {
+					if s, ok := surrogate(p); ok {
+						countSurrogate(s)
+						recent.add(p...)
+						buf.Discard(3)
+						pos.offset += 3
+						continue
+					}
+				}
+				b, _ = buf.ReadByte()
+			}
+			if showErrors != 0 {
+				after, _ := buf.Peek(errorContext)
+				reportError(file, pos.offset, recent.bytes(), b, after)
+				recent.add(b)
+			}
+			countError(b)
 		} else {
-			counts.Inc(rune)
+			add(rune)
+			if showErrors != 0 {
+				var enc [utf8.UTFMax]byte
+				recent.add(enc[:utf8.EncodeRune(enc[:], rune)]...)
+			}
 		}
+		pos.offset += int64(width)
 	}
 }
 
-func print() {
-	if countBytes {
-		printCounts("%.2x %c\t%d\n", "%.2x -\t%d\n")
-	} else {
-		printCounts("%.4x %c\t%d\n", "%.4x -\t%d\n")
+// clusters segments the input for -grapheme-ratio, which counts the
+// runes and grapheme clusters.
+var (
+	clusters       segmenter
+	totalRunes     uint64
+	totalGraphemes uint64
+)
+
+// pending holds the base character under -fold-combining, to which
+// following marks may be added. It is negative when there is none.
+var pending rune = -1
+
+// add counts the rune r.
+func add(r rune) {
+	if norm.on {
+		normalize(r)
+		return
 	}
+	addRune(r)
 }
 
-func printCounts(printable, unprintable string) {
-	for b2 := range *counts {
-		c2 := (*counts)[b2]
-		if c2 == nil {
-			continue
+// addRune counts the rune r, after any -normalize.
+func addRune(r rune) {
+	if r == '\n' {
+		pos.line++
+	}
+	if trojanSource {
+		checkTrojan(r)
+	}
+	if trailingWS {
+		checkTrailing(r)
+	}
+	if warnMixed {
+		checkMixed(r)
+	}
+	if graphemeRatio {
+		totalRunes++
+		if clusters.next(r) {
+			totalGraphemes++
 		}
-		for b1 := range c2 {
-			c1 := c2[b1]
-			if c1 == nil {
-				continue
-			}
-			for b0, count := range c1 {
-				if count == 0 {
-					continue
-				}
-				r := rune((b2 << 16) | (b1 << 8) | b0)
-				if r != ' ' && strconv.IsPrint(r) {
-					fmt.Printf(printable, r, r, count)
-				} else {
-					fmt.Printf(unprintable, r, count)
-				}
+	}
+	if emojiMode {
+		addEmoji(r)
+		return
+	}
+	if graphemeMode {
+		addCluster(r)
+		return
+	}
+	if foldCombining {
+		isMark := unicode.In(r, unicode.Mn, unicode.Mc)
+		if isMark && pending >= 0 {
+			if c, ok := composition[[2]rune{pending, r}]; ok {
+				pending = c
+				return
 			}
 		}
+		flush()
+		if !isMark {
+			pending = r
+			return
+		}
+	}
+	inc(r)
+}
+
+// last is the character most recently counted, for -dedupe.
+var last rune = -1
+
+// inc counts the byte or rune r, the last step of counting.
+func inc(r rune) {
+	r, ok := translate(r)
+	if !ok {
+		return
+	}
+	if longestRuns {
+		addRun(r)
+	}
+	if dedupe {
+		if r == last {
+			return
+		}
+		last = r
+	}
+	if showPositions {
+		recordPosition(r)
+	}
+	if windowSize > 0 {
+		addWindow(r)
+	}
+	if condEntropy {
+		addPair(r)
+	}
+	if ngram > 0 {
+		addGram(r)
 	}
-	if errors > 0 {
-		fmt.Printf("error -\t%d\n", errors)
+	if distinct && counts.Count(r) > 0 {
+		return
+	}
+	counts.Inc(r)
+	if r == alertRune && counts.Count(r) > alertCount {
+		alert(r)
+	}
+}
+
+// translate returns the character that r is counted as, after -map and
+// the folding options, and reports whether it is counted at all, which
+// depends on its encoded width as read.
+func translate(r rune) (rune, bool) {
+	if runeWidth > 0 && utf8.RuneLen(r) != runeWidth {
+		return r, false
+	}
+	if to, ok := runeMap[r]; ok {
+		r = to
+	}
+	if foldDigits && !countBytes {
+		r = asciiDigit(r)
+	}
+	if foldCase && (!countBytes || r < utf8.RuneSelf) {
+		r = simpleFold(r)
+	}
+	if noControls && isControl(r) {
+		return r, false
+	}
+	if inProperty != nil && !inProperty(r) {
+		return r, false
+	}
+	if selection != nil && !selected(r) {
+		return r, false
+	}
+	return r, true
+}
+
+// alert prints the table so far and exits because the count of r has
+// exceeded -alert-count.
+func alert(r rune) {
+	print()
+	stdout.Flush()
+	fmt.Fprintf(os.Stderr, "freq: %s: count of U+%.4X exceeded %d at offset %d\n", pos.file, r, alertCount, pos.offset)
+	exit(3)
+}
+
+// isControl reports whether r is a control character for -no-controls.
+func isControl(r rune) bool {
+	return r < 0x20 || r == 0x7F || !countBytes && 0x80 <= r && r <= 0x9F
+}
+
+// flush counts any character held back by add. It is called at the
+// end of each input and at decode errors.
+func flush() {
+	if norm.on {
+		flushNormalization()
+	}
+	if pending >= 0 {
+		inc(pending)
+		pending = -1
+	}
+	if emojiMode {
+		endEmoji()
+		emojiSeg = segmenter{}
+	}
+	if graphemeMode {
+		endCluster()
+		clusterSeg = segmenter{}
+	}
+}
+
+// checkStrict stops the program at a decode error under -strict.
+func checkStrict() {
+	if !strict {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "freq: %s: invalid UTF-8 at offset %d\n", pos.file, pos.offset)
+	if partial {
+		print()
+	}
+	exit(1)
+}
+
+// countError records a decode error caused by the byte b.
+func countError(b byte) {
+	checkStrict()
+	flush()
+	clusters = segmenter{}
+	errors++
+	if recoverBytes {
+		badBytes[b]++
+	}
+}
+
+// surrogate reports whether p begins with a three-byte UTF-8 style
+// encoding of a UTF-16 surrogate, and if so returns its value.
+func surrogate(p []byte) (rune, bool) {
+	if len(p) < 3 || p[0] != 0xED || p[1] < 0xA0 || p[1] > 0xBF || p[2]&0xC0 != 0x80 {
+		return 0, false
+	}
+	return rune(p[0]&0x0F)<<12 | rune(p[1]&0x3F)<<6 | rune(p[2]&0x3F), true
+}
+
+func countSurrogate(r rune) {
+	checkStrict()
+	flush()
+	surrogates[r]++
+}
+
+// errorContext is the number of bytes shown on each side of a decode error.
+const errorContext = 4
+
+// history holds the most recent bytes read, for error reports.
+type history struct {
+	buf [errorContext]byte
+	n   int // Total number of bytes added.
+}
+
+func (h *history) add(p ...byte) {
+	for _, b := range p {
+		h.buf[h.n%len(h.buf)] = b
+		h.n++
+	}
+}
+
+// bytes returns the recorded bytes, oldest first.
+func (h *history) bytes() []byte {
+	if h.n < len(h.buf) {
+		return h.buf[:h.n]
+	}
+	i := h.n % len(h.buf)
+	return append(h.buf[i:len(h.buf):len(h.buf)], h.buf[:i]...)
+}
+
+var shownErrors int
+
+// reportError describes the invalid byte b at offset in file, showing the
+// bytes before and after it.
+func reportError(file string, offset int64, before []byte, b byte, after []byte) {
+	shownErrors++
+	switch {
+	case showErrors < 0:
+	case shownErrors == showErrors+1:
+		fmt.Fprintf(os.Stderr, "freq: %s: further decode errors not shown\n", file)
+		return
+	case shownErrors > showErrors:
+		return
 	}
+	context := strings.TrimSpace(fmt.Sprintf("% x [%.2x] % x", before, b, after))
+	fmt.Fprintf(os.Stderr, "freq: %s: invalid UTF-8 at offset %d: %s\n", file, offset, context)
 }
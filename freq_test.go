@@ -0,0 +1,156 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+// randomCounts returns Counts of n random characters, some in each of a
+// few planes so that the second and third levels are sparse.
+func randomCounts(rng *rand.Rand, n int) *Counts {
+	c := new(Counts)
+	for range n {
+		var r rune
+		switch rng.Intn(4) {
+		case 0:
+			r = rune(rng.Intn(0x80))
+		case 1:
+			r = rune(rng.Intn(0x10000))
+		case 2:
+			r = 0x1F000 + rune(rng.Intn(0x1000))
+		default:
+			r = rune(rng.Intn(0x110000))
+		}
+		c.Add(r, uint64(1+rng.Intn(1000)))
+	}
+	return c
+}
+
+// clone returns a copy of c that shares no tables with it, with the
+// same tables allocated.
+func clone(c *Counts) *Counts {
+	d := new(Counts)
+	for b2, c2 := range c {
+		if c2 == nil {
+			continue
+		}
+		d[b2] = new([256]*[256]uint64)
+		for b1, c1 := range c2 {
+			if c1 != nil {
+				t := *c1
+				d[b2][b1] = &t
+			}
+		}
+	}
+	return d
+}
+
+// merged returns the sum of the Counts, merged in order into a new one.
+func merged(list ...*Counts) *Counts {
+	c := new(Counts)
+	for _, o := range list {
+		c.Merge(clone(o))
+	}
+	return c
+}
+
+// sameCounts reports whether a and b hold the same counts in the same
+// allocated tables.
+func sameCounts(t *testing.T, what string, a, b *Counts) {
+	t.Helper()
+	for b2 := range a {
+		a2, b2t := a[b2], b[b2]
+		if (a2 == nil) != (b2t == nil) {
+			t.Fatalf("%s: second-level table %#x allocated in only one", what, b2)
+		}
+		if a2 == nil {
+			continue
+		}
+		for b1 := range a2 {
+			a1, b1t := a2[b1], b2t[b1]
+			if (a1 == nil) != (b1t == nil) {
+				t.Fatalf("%s: third-level table %#x/%#x allocated in only one", what, b2, b1)
+			}
+			if a1 != nil && *a1 != *b1t {
+				t.Fatalf("%s: counts of table %#x/%#x differ", what, b2, b1)
+			}
+		}
+	}
+}
+
+func TestMergeCommutative(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := range 50 {
+		a, b := randomCounts(rng, rng.Intn(500)), randomCounts(rng, rng.Intn(500))
+		if i%10 == 0 {
+			a = new(Counts)
+		}
+		sameCounts(t, "a+b vs b+a", merged(a, b), merged(b, a))
+	}
+}
+
+func TestMergeAssociative(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for range 50 {
+		a, b, c := randomCounts(rng, rng.Intn(500)), randomCounts(rng, rng.Intn(500)), randomCounts(rng, rng.Intn(500))
+		left := merged(merged(a, b), c)
+		right := merged(a, merged(b, c))
+		sameCounts(t, "(a+b)+c vs a+(b+c)", left, right)
+		sameCounts(t, "(a+b)+c vs c+(b+a)", left, merged(c, merged(b, a)))
+	}
+}
+
+func TestMergeTotals(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	a, b := randomCounts(rng, 300), randomCounts(rng, 300)
+	want := make(map[rune]uint64)
+	for _, c := range []*Counts{a, b} {
+		c.Do(func(r rune, n uint64) { want[r] += n })
+	}
+	got := merged(a, b)
+	n := 0
+	got.Do(func(r rune, count uint64) {
+		n++
+		if count != want[r] {
+			t.Errorf("%U: count %d, want %d", r, count, want[r])
+		}
+	})
+	if n != len(want) {
+		t.Errorf("%d characters, want %d", n, len(want))
+	}
+}
+
+func TestMergeDoesNotShare(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	a := randomCounts(rng, 100)
+	saved := clone(a)
+	c := new(Counts)
+	c.Merge(a)
+	c.Do(func(r rune, n uint64) { c.Add(r, 1) })
+	sameCounts(t, "merged from after change", a, saved)
+}
+
+// capture returns what f prints to stdout.
+func capture(f func()) string {
+	var buf bytes.Buffer
+	saved := stdout
+	stdout = &output{w: bufio.NewWriter(&buf)}
+	defer func() { stdout = saved }()
+	f()
+	stdout.Flush()
+	return buf.String()
+}
+
+// countText replaces the counts with those of the characters of text.
+func countText(text string) {
+	counts = new(Counts)
+	for _, r := range text {
+		counts.Inc(r)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "sort"
+
+// gbClass is a value of the Grapheme_Cluster_Break property, or
+// gbExtPict for an Extended_Pictographic character, which the rules
+// of Unicode Standard Annex #29 treat as another class.
+type gbClass uint8
+
+const (
+	gbOther gbClass = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRI
+	gbPrepend
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+	gbExtPict
+)
+
+// gbRange is a range of code points in the same class.
+type gbRange struct {
+	lo, hi rune
+	class  gbClass
+}
+
+// graphemeClass returns the class of r.
+func graphemeClass(r rune) gbClass {
+	i := sort.Search(len(graphemeBreaks), func(i int) bool { return graphemeBreaks[i].hi >= r })
+	if i < len(graphemeBreaks) && graphemeBreaks[i].lo <= r {
+		return graphemeBreaks[i].class
+	}
+	return gbOther
+}
+
+// A segmenter divides a stream of runes into extended grapheme
+// clusters, following the rules of Unicode Standard Annex #29.
+// The zero value is ready to use at the start of the stream.
+type segmenter struct {
+	started bool
+	prev    gbClass
+	ri      int  // Number of consecutive regional indicators ending at prev.
+	pict    bool // The runes ending at prev are ExtPict Extend*.
+	pictZWJ bool // The runes ending at prev are ExtPict Extend* ZWJ.
+}
+
+// next reports whether r begins a new cluster, and advances past it.
+func (s *segmenter) next(r rune) bool {
+	c := graphemeClass(r)
+	brk := s.breaks(c)
+	s.started = true
+	s.pictZWJ = s.pict && c == gbZWJ
+	s.pict = c == gbExtPict || s.pict && c == gbExtend
+	if c == gbRI {
+		s.ri++
+	} else {
+		s.ri = 0
+	}
+	s.prev = c
+	return brk
+}
+
+// breaks reports whether there is a cluster boundary between the previous
+// rune and one of class c. The comments give the rule numbers of UAX #29.
+func (s *segmenter) breaks(c gbClass) bool {
+	prev := s.prev
+	switch {
+	case !s.started: // GB1
+		return true
+	case prev == gbCR && c == gbLF: // GB3
+		return false
+	case prev == gbControl || prev == gbCR || prev == gbLF: // GB4
+		return true
+	case c == gbControl || c == gbCR || c == gbLF: // GB5
+		return true
+	case prev == gbL && (c == gbL || c == gbV || c == gbLV || c == gbLVT): // GB6
+		return false
+	case (prev == gbLV || prev == gbV) && (c == gbV || c == gbT): // GB7
+		return false
+	case (prev == gbLVT || prev == gbT) && c == gbT: // GB8
+		return false
+	case c == gbExtend || c == gbZWJ: // GB9
+		return false
+	case c == gbSpacingMark: // GB9a
+		return false
+	case prev == gbPrepend: // GB9b
+		return false
+	case s.pictZWJ && c == gbExtPict: // GB11
+		return false
+	case prev == gbRI && c == gbRI && s.ri%2 == 1: // GB12, GB13
+		return false
+	}
+	return true // GB999
+}
+
+// The clusters of -graphemes: the runes of the current cluster, the
+// segmenter that finds its end, and the count of each cluster, keyed by
+// its runes as a string.
+var (
+	clusterRunes   []rune
+	clusterSeg     segmenter
+	graphemeCounts = make(map[string]uint64)
+	graphemeOther  uint64 // Clusters not kept because of -max-memory.
+)
+
+// addCluster adds r to the current cluster, counting the cluster first
+// if r begins a new one.
+func addCluster(r rune) {
+	if clusterSeg.next(r) {
+		endCluster()
+	}
+	clusterRunes = append(clusterRunes, r)
+}
+
+// endCluster counts the current cluster, if any.
+func endCluster() {
+	if len(clusterRunes) == 0 {
+		return
+	}
+	s := string(clusterRunes)
+	countKey(graphemeCounts, s, &graphemeOther)
+	clusterRunes = clusterRunes[:0]
+}
@@ -0,0 +1,148 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+)
+
+// A sniffer gathers the statistics -guess uses to judge the encoding
+// of the bytes written to it.
+type sniffer struct {
+	n       uint64    // Bytes seen.
+	start   []byte    // The first bytes of the first input, for the BOM.
+	offset  uint64    // Offset in the current input.
+	nul     [2]uint64 // NULs at even and odd offsets.
+	high    uint64    // Bytes 0x80 and above.
+	c1      uint64    // Bytes 0x80 to 0x9F.
+	c1Unset uint64    // Bytes unassigned in Windows-1252.
+	multi   uint64    // Valid multibyte UTF-8 sequences.
+	invalid uint64    // Invalid UTF-8 bytes.
+	carry   []byte    // An incomplete UTF-8 sequence.
+}
+
+var sniff sniffer
+
+// next prepares for a new input.
+func (s *sniffer) next() {
+	s.invalid += uint64(len(s.carry))
+	s.carry = s.carry[:0]
+	s.offset = 0
+}
+
+func (s *sniffer) Write(p []byte) (int, error) {
+	if len(s.start) < 3 && s.n == s.offset {
+		s.start = append(s.start, p[:min(len(p), 3-len(s.start))]...)
+	}
+	for _, b := range p {
+		switch {
+		case b == 0:
+			s.nul[s.offset%2]++
+		case b >= 0x80:
+			s.high++
+			if b <= 0x9F {
+				s.c1++
+				if windows1252[b] < 0 {
+					s.c1Unset++
+				}
+			}
+		}
+		s.offset++
+	}
+	s.n += uint64(len(p))
+	data := append(s.carry, p...)
+	for i := 0; i < len(data); {
+		if data[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		if !utf8.FullRune(data[i:]) {
+			s.carry = append(s.carry[:0], data[i:]...)
+			return len(p), nil
+		}
+		r, w := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && w == 1 {
+			s.invalid++
+		} else {
+			s.multi++
+		}
+		i += w
+	}
+	s.carry = s.carry[:0]
+	return len(p), nil
+}
+
+// guess is a candidate encoding and the confidence in it, from 0 to 1.
+type guess struct {
+	encoding   string
+	confidence float64
+}
+
+// guesses returns the candidate encodings, most likely first. The
+// heuristics are simple: a byte order mark is decisive; NULs at every
+// other offset suggest UTF-16 of mostly ASCII text; valid UTF-8
+// suggests UTF-8, as long as it has few NULs; bytes that are not UTF-8
+// suggest a single-byte encoding, Windows-1252 if bytes 0x80 to 0x9F,
+// which are controls in Latin-1, appear.
+func (s *sniffer) guesses() []guess {
+	s.next()
+	switch {
+	case bytes.HasPrefix(s.start, []byte("\xEF\xBB\xBF")):
+		return []guess{{"utf-8", 1}}
+	case bytes.HasPrefix(s.start, []byte("\xFF\xFE")):
+		return []guess{{"utf-16le", 1}}
+	case bytes.HasPrefix(s.start, []byte("\xFE\xFF")):
+		return []guess{{"utf-16be", 1}}
+	}
+	if s.n == 0 {
+		return nil
+	}
+	n := float64(s.n)
+	half := n / 2
+	nuls := float64(s.nul[0] + s.nul[1])
+	list := []guess{
+		{"utf-16le", min(1, float64(s.nul[1])/half)},
+		{"utf-16be", min(1, float64(s.nul[0])/half)},
+	}
+	ascii := 0.0
+	if s.high == 0 {
+		ascii = 1 - nuls/n
+	}
+	list = append(list, guess{"ascii", ascii})
+	utf8Conf := 0.0
+	switch {
+	case s.invalid == 0 && s.high == 0:
+		utf8Conf = 0.9 * (1 - nuls/n) // Also ASCII.
+	case s.invalid == 0:
+		utf8Conf = 1 - nuls/n
+	default:
+		utf8Conf = 0.9 * float64(s.multi) / float64(s.multi+s.invalid)
+	}
+	list = append(list, guess{"utf-8", utf8Conf})
+	var single float64
+	if s.high > 0 {
+		single = 0.8 * float64(s.invalid) / float64(s.high) * (1 - nuls/n)
+	}
+	cp1252, latin1 := single, single*0.95
+	if s.c1 > 0 {
+		latin1 *= 0.5
+		if s.c1Unset > 0 {
+			cp1252 *= 0.5
+		}
+	}
+	list = append(list, guess{"windows-1252", cp1252}, guess{"latin1", latin1})
+	sort.SliceStable(list, func(i, j int) bool { return list[i].confidence > list[j].confidence })
+	return list
+}
+
+// printGuesses prints the candidate encodings of the input.
+func printGuesses() {
+	for _, g := range sniff.guesses() {
+		fmt.Fprintf(stdout, "%s\t%.2f\n", g.encoding, g.confidence)
+	}
+}
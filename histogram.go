@@ -0,0 +1,106 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// tableWidth is the room left for the columns of the table before the
+// -histogram bars when the width is found from the terminal.
+const tableWidth = 32
+
+// histogramLength returns the length, in characters, of the longest
+// -histogram bar: -histogram-width if set, otherwise what fits in the
+// $COLUMNS of the terminal, or of 80 columns, after the table.
+func histogramLength() int {
+	if histogramWidth > 0 {
+		return histogramWidth
+	}
+	columns, err := strconv.Atoi(os.Getenv("COLUMNS"))
+	if err != nil || columns <= 0 {
+		columns = 80
+	}
+	return max(columns-tableWidth, 10)
+}
+
+// eighths holds the blocks that draw the last, partial character of a bar.
+var eighths = []string{"", "▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+
+// bar returns a bar for count out of a largest count of max, which is
+// drawn length characters long, to the nearest eighth of a character.
+// Any count above zero draws something.
+func bar(count, max uint64, length int) string {
+	if max == 0 {
+		return ""
+	}
+	n := (count*uint64(length)*8 + max - 1) / max
+	return strings.Repeat("█", int(n/8)) + eighths[n%8]
+}
+
+// bars prints the -histogram column of the rows of a table, scaled to
+// the largest count given to fit.
+type bars struct {
+	most uint64
+}
+
+// fit makes room for a row with the given count.
+func (b *bars) fit(count uint64) {
+	b.most = max(b.most, count)
+}
+
+func (b *bars) print(count uint64) {
+	if histogram {
+		fmt.Fprintf(stdout, "\t%s", bar(count, b.most, histogramLength()))
+	}
+}
+
+// plotFormats lists the forms accepted by -plot.
+var plotFormats = []string{"html"}
+
+// printPlot prints, instead of the table, a standalone HTML page holding
+// a bar chart of the counts, one bar per character in the order of the
+// table.
+func printPlot(keyFormat string) {
+	list := entries()
+	var max uint64
+	for _, e := range list {
+		if e.count > max {
+			max = e.count
+		}
+	}
+	fmt.Fprint(stdout, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>freq</title>
+<style>
+body { font-family: sans-serif; }
+td { padding: 0 0.5em; white-space: nowrap; }
+td.count { text-align: right; }
+td.bar { width: 100%; }
+div { background: steelblue; height: 1em; }
+</style>
+</head>
+<body>
+<table>
+`)
+	for _, e := range list {
+		width := 0.0
+		if max > 0 {
+			width = 100 * float64(e.count) / float64(max)
+		}
+		fmt.Fprintf(stdout, "<tr><td><code>%s</code></td><td>%s</td><td class=\"count\">%s</td><td class=\"bar\"><div style=\"width: %.2f%%\"></div></td></tr>\n",
+			key(e.r, keyFormat), html.EscapeString(glyph(e.r)), formatCount(e.count), width)
+	}
+	if errors > 0 {
+		fmt.Fprintf(stdout, "<tr><td>error</td><td></td><td class=\"count\">%s</td><td></td></tr>\n", formatCount(errors))
+	}
+	fmt.Fprint(stdout, "</table>\n</body>\n</html>\n")
+}
@@ -0,0 +1,68 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// invisible lists the zero-width, format and filler characters reported
+// by -zero-width, with their Unicode names. They occupy no space when
+// displayed, or look like a space, so they are easily introduced by
+// copy and paste and hard to spot. To flag another character, add it here.
+var invisible = map[rune]string{
+	0x00AD:  "SOFT HYPHEN",
+	0x034F:  "COMBINING GRAPHEME JOINER",
+	0x061C:  "ARABIC LETTER MARK",
+	0x115F:  "HANGUL CHOSEONG FILLER",
+	0x1160:  "HANGUL JUNGSEONG FILLER",
+	0x17B4:  "KHMER VOWEL INHERENT AQ",
+	0x17B5:  "KHMER VOWEL INHERENT AA",
+	0x180E:  "MONGOLIAN VOWEL SEPARATOR",
+	0x200B:  "ZERO WIDTH SPACE",
+	0x200C:  "ZERO WIDTH NON-JOINER",
+	0x200D:  "ZERO WIDTH JOINER",
+	0x200E:  "LEFT-TO-RIGHT MARK",
+	0x200F:  "RIGHT-TO-LEFT MARK",
+	0x202A:  "LEFT-TO-RIGHT EMBEDDING",
+	0x202B:  "RIGHT-TO-LEFT EMBEDDING",
+	0x202C:  "POP DIRECTIONAL FORMATTING",
+	0x202D:  "LEFT-TO-RIGHT OVERRIDE",
+	0x202E:  "RIGHT-TO-LEFT OVERRIDE",
+	0x2060:  "WORD JOINER",
+	0x2061:  "FUNCTION APPLICATION",
+	0x2062:  "INVISIBLE TIMES",
+	0x2063:  "INVISIBLE SEPARATOR",
+	0x2064:  "INVISIBLE PLUS",
+	0x2066:  "LEFT-TO-RIGHT ISOLATE",
+	0x2067:  "RIGHT-TO-LEFT ISOLATE",
+	0x2068:  "FIRST STRONG ISOLATE",
+	0x2069:  "POP DIRECTIONAL ISOLATE",
+	0x206A:  "INHIBIT SYMMETRIC SWAPPING",
+	0x206B:  "ACTIVATE SYMMETRIC SWAPPING",
+	0x206C:  "INHIBIT ARABIC FORM SHAPING",
+	0x206D:  "ACTIVATE ARABIC FORM SHAPING",
+	0x206E:  "NATIONAL DIGIT SHAPES",
+	0x206F:  "NOMINAL DIGIT SHAPES",
+	0x3164:  "HANGUL FILLER",
+	0xFE00:  "VARIATION SELECTOR-1",
+	0xFE01:  "VARIATION SELECTOR-2",
+	0xFE02:  "VARIATION SELECTOR-3",
+	0xFE03:  "VARIATION SELECTOR-4",
+	0xFE04:  "VARIATION SELECTOR-5",
+	0xFE05:  "VARIATION SELECTOR-6",
+	0xFE06:  "VARIATION SELECTOR-7",
+	0xFE07:  "VARIATION SELECTOR-8",
+	0xFE08:  "VARIATION SELECTOR-9",
+	0xFE09:  "VARIATION SELECTOR-10",
+	0xFE0A:  "VARIATION SELECTOR-11",
+	0xFE0B:  "VARIATION SELECTOR-12",
+	0xFE0C:  "VARIATION SELECTOR-13",
+	0xFE0D:  "VARIATION SELECTOR-14",
+	0xFE0E:  "VARIATION SELECTOR-15",
+	0xFE0F:  "VARIATION SELECTOR-16",
+	0xFEFF:  "ZERO WIDTH NO-BREAK SPACE",
+	0xFFA0:  "HALFWIDTH HANGUL FILLER",
+	0xFFF9:  "INTERLINEAR ANNOTATION ANCHOR",
+	0xFFFA:  "INTERLINEAR ANNOTATION SEPARATOR",
+	0xFFFB:  "INTERLINEAR ANNOTATION TERMINATOR",
+	0xE0001: "LANGUAGE TAG",
+}
@@ -0,0 +1,125 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"expvar"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// metrics is a snapshot of the counts for -listen.
+type metrics struct {
+	Total      uint64            `json:"total"`
+	Errors     uint64            `json:"errors"`
+	Categories map[string]uint64 `json:"categories,omitempty"`
+	Top        []topCount        `json:"top"`
+}
+
+// topCount is one of the most frequent characters in metrics.
+type topCount struct {
+	Code  string `json:"codepoint"`
+	Count uint64 `json:"count"`
+}
+
+// countsLock is held while the counts may change, except while -listen
+// input is being read, so that the server sees the counts as they stand
+// between reads.
+var countsLock sync.Mutex
+
+// published holds the final snapshot of the counts, once all the input
+// is read, after which countsLock is not released again.
+var published atomic.Pointer[metrics]
+
+// startListener serves the counts on addr, as Prometheus metrics
+// at /metrics and as JSON at /debug/vars, under "freq".
+func startListener(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	countsLock.Lock()
+	expvar.Publish("freq", expvar.Func(func() any { return current() }))
+	http.HandleFunc("/metrics", serveMetrics)
+	go http.Serve(ln, nil)
+	return nil
+}
+
+// current returns a snapshot of the counts as they stand.
+func current() *metrics {
+	if m := published.Load(); m != nil {
+		return m
+	}
+	countsLock.Lock()
+	defer countsLock.Unlock()
+	return snapshot()
+}
+
+// snapshot returns a snapshot of the counts.
+func snapshot() *metrics {
+	m := &metrics{Errors: errors}
+	if !countBytes {
+		m.Categories = make(map[string]uint64)
+	}
+	var list []entry
+	counts.Do(func(r rune, count uint64) {
+		m.Total += count
+		if m.Categories != nil {
+			m.Categories[categoryOf(r)] += count
+		}
+		list = append(list, entry{r, count})
+	})
+	sort.SliceStable(list, func(i, j int) bool { return list[i].count > list[j].count })
+	format := "%.4x"
+	if countBytes {
+		format = "%.2x"
+	}
+	for _, e := range list[:min(len(list), listenTop)] {
+		m.Top = append(m.Top, topCount{fmt.Sprintf(format, e.r), e.count})
+	}
+	return m
+}
+
+// serveMetrics writes the latest snapshot in the Prometheus text format.
+func serveMetrics(w http.ResponseWriter, req *http.Request) {
+	m := current()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	fmt.Fprintf(w, "# HELP freq_characters_total Characters, or bytes, counted.\n# TYPE freq_characters_total counter\n")
+	fmt.Fprintf(w, "freq_characters_total %d\n", m.Total)
+	fmt.Fprintf(w, "# HELP freq_decode_errors_total Invalid UTF-8 sequences.\n# TYPE freq_decode_errors_total counter\n")
+	fmt.Fprintf(w, "freq_decode_errors_total %d\n", m.Errors)
+	if m.Categories != nil {
+		fmt.Fprintf(w, "# HELP freq_category_characters_total Characters counted, by general category.\n# TYPE freq_category_characters_total counter\n")
+		names := make([]string, 0, len(m.Categories))
+		for name := range m.Categories {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(w, "freq_category_characters_total{category=%q} %d\n", name, m.Categories[name])
+		}
+	}
+	fmt.Fprintf(w, "# HELP freq_top_characters Counts of the most frequent characters.\n# TYPE freq_top_characters gauge\n")
+	for _, t := range m.Top {
+		fmt.Fprintf(w, "freq_top_characters{codepoint=%q} %d\n", t.Code, t.Count)
+	}
+}
+
+// publisher reads the input of -listen, releasing countsLock while it
+// waits for data.
+type publisher struct {
+	r io.Reader
+}
+
+func (p *publisher) Read(b []byte) (int, error) {
+	countsLock.Unlock()
+	defer countsLock.Lock()
+	return p.r.Read(b)
+}
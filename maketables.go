@@ -0,0 +1,384 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// Maketables generates tables.go, the Unicode data freq needs that the
+// standard unicode package does not provide. It reads the Unicode
+// Character Database from a directory or URL:
+//
+//	go run maketables.go [-ucd https://www.unicode.org/Public/UCD/latest/ucd]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ucd = flag.String("ucd", "https://www.unicode.org/Public/UCD/latest/ucd", "`location` of the Unicode Character Database")
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("maketables: ")
+	flag.Parse()
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by maketables.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package main\n\n")
+	exclusions, version := readExclusions()
+	fmt.Fprintf(&out, "// tablesVersion is the Unicode version the tables were generated from.\n")
+	fmt.Fprintf(&out, "const tablesVersion = %q\n\n", version)
+	writeComposition(&out, exclusions)
+	writeBlocks(&out)
+	writeGraphemeBreaks(&out)
+	writeEmoji(&out)
+	writeNormalization(&out)
+	writeFile("tables.go", &out)
+
+	// The names are most of the data, so they have a file of their own.
+	var names bytes.Buffer
+	fmt.Fprintf(&names, "// Code generated by maketables.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&names, "package main\n\n")
+	writeNames(&names)
+	writeFile("nametables.go", &names)
+}
+
+// writeFile formats the Go source in buf and writes it to the named file.
+func writeFile(name string, buf *bytes.Buffer) {
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(name, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// open returns the named file of the database.
+func open(name string) io.ReadCloser {
+	if strings.HasPrefix(*ucd, "http://") || strings.HasPrefix(*ucd, "https://") {
+		resp, err := http.Get(*ucd + "/" + name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("%s: %s", name, resp.Status)
+		}
+		return resp.Body
+	}
+	f, err := os.Open(path.Join(*ucd, name))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return f
+}
+
+// lines calls f with the semicolon-separated fields of each data line
+// of the named file, with comments and surrounding spaces removed.
+func lines(name string, f func(fields []string)) {
+	r := open(name)
+	defer r.Close()
+	scan := bufio.NewScanner(r)
+	for scan.Scan() {
+		line, _, _ := strings.Cut(scan.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		f(fields)
+	}
+	if err := scan.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+var versionRE = regexp.MustCompile(`CompositionExclusions-([0-9.]+)\.txt`)
+
+// readExclusions returns the code points excluded from composition and
+// the Unicode version recorded in the file's header.
+func readExclusions() (map[rune]bool, string) {
+	r := open("CompositionExclusions.txt")
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+	version := "unknown"
+	if m := versionRE.FindSubmatch(data); m != nil {
+		version = string(m[1])
+	}
+	excluded := make(map[rune]bool)
+	for _, line := range strings.Split(string(data), "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		if line = strings.TrimSpace(line); line != "" {
+			excluded[parseRune(line)] = true
+		}
+	}
+	return excluded, version
+}
+
+// writeComposition writes the table of canonical pairwise compositions:
+// every two-rune canonical decomposition whose result is a starter, is
+// not excluded, and does not begin with a non-starter.
+func writeComposition(w io.Writer, excluded map[rune]bool) {
+	type pair struct{ a, b, c rune }
+	ccc := make(map[rune]bool) // Runes with nonzero combining class.
+	var pairs []pair
+	lines("UnicodeData.txt", func(fields []string) {
+		r := parseRune(fields[0])
+		if fields[3] != "0" {
+			ccc[r] = true
+		}
+		d := strings.Fields(fields[5])
+		if len(d) != 2 || strings.HasPrefix(d[0], "<") || excluded[r] {
+			return
+		}
+		pairs = append(pairs, pair{parseRune(d[0]), parseRune(d[1]), r})
+	})
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].c < pairs[j].c })
+	fmt.Fprintf(w, "// composition maps a starter followed by a combining character to\n")
+	fmt.Fprintf(w, "// their canonical composition.\n")
+	fmt.Fprintf(w, "var composition = map[[2]rune]rune{\n")
+	for _, p := range pairs {
+		if ccc[p.c] || ccc[p.a] {
+			continue
+		}
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X}: 0x%04X,\n", p.a, p.b, p.c)
+	}
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// writeBlocks writes the table of Unicode blocks, in order.
+func writeBlocks(w io.Writer) {
+	fmt.Fprintf(w, "// blocks lists the Unicode blocks in increasing order.\n")
+	fmt.Fprintf(w, "var blocks = []block{\n")
+	lines("Blocks.txt", func(fields []string) {
+		lo, hi, ok := strings.Cut(fields[0], "..")
+		if !ok || len(fields) < 2 {
+			log.Fatalf("Blocks.txt: bad line %q", strings.Join(fields, ";"))
+		}
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X, %q},\n", parseRune(lo), parseRune(hi), fields[1])
+	})
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// gbNames maps Grapheme_Cluster_Break values to the constants in grapheme.go.
+var gbNames = map[string]string{
+	"CR":                    "gbCR",
+	"LF":                    "gbLF",
+	"Control":               "gbControl",
+	"Extend":                "gbExtend",
+	"ZWJ":                   "gbZWJ",
+	"Regional_Indicator":    "gbRI",
+	"Prepend":               "gbPrepend",
+	"SpacingMark":           "gbSpacingMark",
+	"L":                     "gbL",
+	"V":                     "gbV",
+	"T":                     "gbT",
+	"LV":                    "gbLV",
+	"LVT":                   "gbLVT",
+	"Extended_Pictographic": "gbExtPict",
+}
+
+// propertyRanges calls f for each line of a property file, with the
+// range of code points it covers and the property value.
+func propertyRanges(name string, f func(lo, hi rune, value string)) {
+	lines(name, func(fields []string) {
+		if len(fields) < 2 {
+			log.Fatalf("%s: bad line %q", name, strings.Join(fields, ";"))
+		}
+		lo, hi, ok := strings.Cut(fields[0], "..")
+		if !ok {
+			hi = lo
+		}
+		f(parseRune(lo), parseRune(hi), fields[1])
+	})
+}
+
+// writeGraphemeBreaks writes the table of Grapheme_Cluster_Break values,
+// folding in Extended_Pictographic, which the segmentation rules also use.
+func writeGraphemeBreaks(w io.Writer) {
+	class := make(map[rune]string)
+	propertyRanges("auxiliary/GraphemeBreakProperty.txt", func(lo, hi rune, value string) {
+		if gbNames[value] == "" {
+			log.Fatalf("unknown Grapheme_Cluster_Break value %q", value)
+		}
+		for r := lo; r <= hi; r++ {
+			class[r] = gbNames[value]
+		}
+	})
+	propertyRanges("emoji/emoji-data.txt", func(lo, hi rune, value string) {
+		if value != "Extended_Pictographic" {
+			return
+		}
+		for r := lo; r <= hi; r++ {
+			if class[r] == "" {
+				class[r] = "gbExtPict"
+			}
+		}
+	})
+	fmt.Fprintf(w, "// graphemeBreaks lists the ranges of code points by Grapheme_Cluster_Break\n")
+	fmt.Fprintf(w, "// property, in increasing order, with Extended_Pictographic characters as\n")
+	fmt.Fprintf(w, "// gbExtPict. Code points not listed are gbOther.\n")
+	fmt.Fprintf(w, "var graphemeBreaks = []gbRange{\n")
+	for r := rune(0); r <= 0x10FFFF; r++ {
+		c := class[r]
+		if c == "" {
+			continue
+		}
+		lo := r
+		for r+1 <= 0x10FFFF && class[r+1] == c {
+			r++
+		}
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X, %s},\n", lo, r, c)
+	}
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// writeEmoji writes the table of characters with the Emoji property.
+func writeEmoji(w io.Writer) {
+	var ranges [][2]rune
+	propertyRanges("emoji/emoji-data.txt", func(lo, hi rune, value string) {
+		if value != "Emoji" {
+			return
+		}
+		if n := len(ranges); n > 0 && ranges[n-1][1]+1 == lo {
+			ranges[n-1][1] = hi
+			return
+		}
+		ranges = append(ranges, [2]rune{lo, hi})
+	})
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+	fmt.Fprintf(w, "// emojiRanges lists the ranges of code points with the Emoji property,\n")
+	fmt.Fprintf(w, "// in increasing order.\n")
+	fmt.Fprintf(w, "var emojiRanges = []runeRange{\n")
+	for _, r := range ranges {
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X},\n", r[0], r[1])
+	}
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// writeNormalization writes the tables -normalize needs beyond
+// composition: the nonzero canonical combining classes and the
+// decomposition mappings, canonical and compatibility.
+func writeNormalization(w io.Writer) {
+	fmt.Fprintf(w, "// combiningClasses holds the nonzero canonical combining classes.\n")
+	fmt.Fprintf(w, "var combiningClasses = map[rune]uint8{\n")
+	lines("UnicodeData.txt", func(fields []string) {
+		if fields[3] != "0" {
+			fmt.Fprintf(w, "\t0x%04X: %s,\n", parseRune(fields[0]), fields[3])
+		}
+	})
+	fmt.Fprintf(w, "}\n\n")
+	fmt.Fprintf(w, "// decompositions holds the decomposition mappings of the characters\n")
+	fmt.Fprintf(w, "// that have them, one level deep.\n")
+	fmt.Fprintf(w, "var decompositions = map[rune]decomposition{\n")
+	lines("UnicodeData.txt", func(fields []string) {
+		d := strings.Fields(fields[5])
+		if len(d) == 0 {
+			return
+		}
+		compat := strings.HasPrefix(d[0], "<")
+		if compat {
+			d = d[1:]
+		}
+		var s []rune
+		for _, c := range d {
+			s = append(s, parseRune(c))
+		}
+		fmt.Fprintf(w, "\t0x%04X: {%t, %+q},\n", parseRune(fields[0]), compat, string(s))
+	})
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// derivedNames maps the labels of the First/Last ranges of UnicodeData.txt
+// whose characters' names are derived from their code points, by rule NR2
+// of the Unicode Standard, to the prefix of the names. Hangul syllables,
+// under rule NR1, are named by hangulName in names.go.
+var derivedNames = []struct{ label, prefix string }{
+	{"CJK Ideograph", "CJK UNIFIED IDEOGRAPH"},
+	{"Tangut Ideograph", "TANGUT IDEOGRAPH"},
+}
+
+// writeNames writes the table of character names, leaving out those
+// derived from the code point, which are written as ranges instead. A
+// control character, which has no name, is given its Unicode 1.0 name.
+func writeNames(w io.Writer) {
+	type name struct {
+		r    rune
+		name string
+	}
+	type nameRange struct {
+		lo, hi rune
+		prefix string
+	}
+	var names []name
+	var ranges []nameRange
+	addRange := func(lo, hi rune, prefix string) {
+		if n := len(ranges); n > 0 && ranges[n-1].prefix == prefix && ranges[n-1].hi+1 == lo {
+			ranges[n-1].hi = hi
+			return
+		}
+		ranges = append(ranges, nameRange{lo, hi, prefix})
+	}
+	var first rune
+	lines("UnicodeData.txt", func(fields []string) {
+		r, n := parseRune(fields[0]), fields[1]
+		switch {
+		case strings.HasSuffix(n, ", First>"):
+			first = r
+		case strings.HasSuffix(n, ", Last>"):
+			for _, d := range derivedNames {
+				if strings.HasPrefix(n, "<"+d.label) {
+					addRange(first, r, d.prefix)
+				}
+			}
+		case n == "<control>":
+			if len(fields) > 10 && fields[10] != "" {
+				names = append(names, name{r, fields[10]})
+			}
+		case strings.HasPrefix(n, "<"), strings.HasPrefix(n, "HANGUL SYLLABLE "):
+		case strings.HasSuffix(n, "-"+fields[0]):
+			addRange(r, r, strings.TrimSuffix(n, "-"+fields[0]))
+		default:
+			names = append(names, name{r, n})
+		}
+	})
+	fmt.Fprintf(w, "// derivedNameRanges lists the ranges of code points, in increasing order,\n")
+	fmt.Fprintf(w, "// whose names are the prefix followed by a hyphen and the code point.\n")
+	fmt.Fprintf(w, "var derivedNameRanges = []nameRange{\n")
+	for _, r := range ranges {
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X, %q},\n", r.lo, r.hi, r.prefix)
+	}
+	fmt.Fprintf(w, "}\n\n")
+	fmt.Fprintf(w, "// charNames lists the other named characters in increasing order.\n")
+	fmt.Fprintf(w, "var charNames = []charName{\n")
+	for _, n := range names {
+		fmt.Fprintf(w, "\t{0x%04X, %q},\n", n.r, n.name)
+	}
+	fmt.Fprintf(w, "}\n")
+}
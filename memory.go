@@ -0,0 +1,39 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// keyOverhead approximates the memory a map entry takes beyond the
+// bytes of its key.
+const keyOverhead = 64
+
+// The memory accounting for -max-memory. Only the map-based modes,
+// whose number of keys depends on the input, are tracked; the counts
+// of characters have a fixed bound.
+var (
+	keyMemory       int64 // Estimated bytes held by map keys.
+	memoryExhausted bool  // Some key was refused.
+)
+
+// admitKey reports whether a new map key of n bytes fits within
+// -max-memory, and if so accounts for it.
+func admitKey(n int) bool {
+	size := int64(n) + keyOverhead
+	if maxMemory > 0 && keyMemory+size > maxMemory {
+		memoryExhausted = true
+		return false
+	}
+	keyMemory += size
+	return true
+}
+
+// countKey adds one to the count of key in m or, if the key is new and
+// there is no room for it, to other.
+func countKey(m map[string]uint64, key string, other *uint64) {
+	if _, ok := m[key]; ok || admitKey(len(key)) {
+		m[key]++
+	} else {
+		*other++
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// mergeTable adds the counts in a table printed by an earlier run of
+// freq, or saved by -state or -o, read from file, to the current
+// counts. The file "-" is the standard input.
+func mergeTable(file string) error {
+	var data []byte
+	var err error
+	if file == "-" {
+		file = "<stdin>"
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+	t, err := parseCounts(file, data)
+	if err != nil {
+		return err
+	}
+	t.add()
+	return nil
+}
+
+// parseCounts parses data, read from file, as a state file or, failing
+// that, as the records of -format=json or csv, or as a table.
+func parseCounts(file string, data []byte) (*tableCounts, error) {
+	if t, err := decodeState(file, bytes.NewReader(data)); err == nil {
+		return t, nil
+	}
+	switch text := bytes.TrimSpace(data); {
+	case bytes.HasPrefix(text, []byte("[")) || bytes.HasPrefix(text, []byte("{")):
+		return parseJSON(file, text)
+	case bytes.HasPrefix(text, []byte("kind,")) || bytes.HasPrefix(text, []byte("format,kind,")):
+		return parseCSV(file, bytes.NewReader(data))
+	}
+	return parseTable(file, bytes.NewReader(data))
+}
+
+// newTableCounts returns an empty tableCounts.
+func newTableCounts() *tableCounts {
+	return &tableCounts{
+		counts:     make(map[rune]uint64),
+		surrogates: make(map[rune]uint64),
+	}
+}
+
+// parseTable parses the table in r. Each line holds a hex code point
+// (two digits for a byte table), the character, a tab and the count,
+// possibly followed by more tab-separated columns, which are ignored.
+// The error and surrogate lines are understood too, as are tables
+// printed with -tsv, which separate the first two columns by a tab,
+// and the header printed by -format-version, which must give a version
+// this program understands.
+func parseTable(file string, r io.Reader) (*tableCounts, error) {
+	t := newTableCounts()
+	scan := bufio.NewScanner(r)
+	for line := 1; scan.Scan(); line++ {
+		bad := func(what string) error {
+			return fmt.Errorf("%s:%d: %s: %q", file, line, what, scan.Text())
+		}
+		if v, ok := strings.CutPrefix(scan.Text(), formatHeader); ok {
+			if v != strconv.Itoa(tableFormat) {
+				return nil, bad("unsupported format version")
+			}
+			continue
+		}
+		columns := strings.Split(scan.Text(), "\t")
+		keys := strings.Fields(columns[0])
+		if len(columns) < 2 || len(keys) == 0 {
+			return nil, bad("malformed line")
+		}
+		countColumn := columns[1]
+		if len(keys) == 1 && len(columns) >= 3 {
+			keys, countColumn = columns[:2], columns[2]
+		}
+		count, err := strconv.ParseUint(strings.TrimSpace(countColumn), 10, 64) // -pad pads it with spaces.
+		if err != nil {
+			return nil, bad("bad count")
+		}
+		rec := record{Kind: "char", Code: keys[0], Count: count}
+		switch keys[0] {
+		case "error":
+			rec.Kind, rec.Code = "error", ""
+			if len(keys) >= 2 && keys[1] != "-" {
+				rec.Code = keys[1]
+			}
+		case "surrogate":
+			rec.Kind, rec.Code = "surrogate", keys[len(keys)-1]
+		}
+		if err := t.addRecord(rec); err != nil {
+			return nil, bad(err.Error())
+		}
+	}
+	if err := scan.Err(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// parseJSON parses the records printed by -format=json, which under
+// -format-version are wrapped in an object giving the format version.
+func parseJSON(file string, data []byte) (*tableCounts, error) {
+	var list []record
+	if data[0] == '{' {
+		var v struct {
+			Format  int      `json:"format"`
+			Records []record `json:"records"`
+		}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, fmt.Errorf("%s: %s", file, err)
+		}
+		if v.Format != tableFormat {
+			return nil, fmt.Errorf("%s: unsupported format version %d", file, v.Format)
+		}
+		list = v.Records
+	} else if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("%s: %s", file, err)
+	}
+	t := newTableCounts()
+	for i, rec := range list {
+		if err := t.addRecord(rec); err != nil {
+			return nil, fmt.Errorf("%s: record %d: %s", file, i+1, err)
+		}
+	}
+	return t, nil
+}
+
+// parseCSV parses the records printed by -format=csv, which under
+// -format-version begin with a column giving the format version.
+func parseCSV(file string, r io.Reader) (*tableCounts, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", file, err)
+	}
+	versioned := rows[0][0] == "format"
+	t := newTableCounts()
+	for i, row := range rows[1:] {
+		line := i + 2
+		if versioned {
+			if row[0] != strconv.Itoa(tableFormat) {
+				return nil, fmt.Errorf("%s:%d: unsupported format version %q", file, line, row[0])
+			}
+			row = row[1:]
+		}
+		if len(row) != 4 {
+			return nil, fmt.Errorf("%s:%d: malformed record", file, line)
+		}
+		count, err := strconv.ParseUint(row[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: bad count %q", file, line, row[3])
+		}
+		if err := t.addRecord(record{row[0], row[1], row[2], count}); err != nil {
+			return nil, fmt.Errorf("%s:%d: %s", file, line, err)
+		}
+	}
+	return t, nil
+}
+
+// tableCounts holds counts read from a file, to be added to the current
+// counts once the whole file has been read.
+type tableCounts struct {
+	counts     map[rune]uint64
+	errors     uint64
+	badBytes   [256]uint64
+	surrogates map[rune]uint64
+}
+
+// add adds t to the current counts.
+func (t *tableCounts) add() {
+	for r, count := range t.counts {
+		counts.Add(r, count)
+	}
+	errors += t.errors
+	for b, count := range t.badBytes {
+		badBytes[b] += count
+	}
+	for r, count := range t.surrogates {
+		surrogates[r] += count
+	}
+}
+
+// addRecord adds the count of rec, a character, error or surrogate, to t.
+// Only those kinds can be merged.
+func (t *tableCounts) addRecord(rec record) error {
+	switch rec.Kind {
+	case "char":
+		v, err := strconv.ParseUint(rec.Code, 16, 32)
+		if err != nil || v > 0x10FFFF {
+			return fmt.Errorf("bad code point")
+		}
+		if (len(rec.Code) == 2) != countBytes {
+			return fmt.Errorf("byte and rune tables mixed; check -bytes")
+		}
+		t.counts[rune(v)] += rec.Count
+	case "error":
+		if rec.Code == "" {
+			t.errors += rec.Count
+			break
+		}
+		b, err := strconv.ParseUint(rec.Code, 16, 8)
+		if err != nil {
+			return fmt.Errorf("bad byte")
+		}
+		t.badBytes[b] += rec.Count
+	case "surrogate":
+		v, err := strconv.ParseUint(rec.Code, 16, 32)
+		if err != nil {
+			return fmt.Errorf("bad surrogate")
+		}
+		t.surrogates[rune(v)] += rec.Count
+	default:
+		return fmt.Errorf("cannot merge %s counts", rec.Kind)
+	}
+	return nil
+}
+
+// mergeDir adds the counts in every table or -state file in dir.
+// Files that cannot be read or parsed are reported and skipped.
+func mergeDir(dir string) error {
+	list, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range list {
+		if !e.Type().IsRegular() {
+			continue
+		}
+		file := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "freq: skipping %s\n", err)
+			continue
+		}
+		t, err := parseCounts(file, data)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "freq: skipping %s\n", err)
+			continue
+		}
+		t.add()
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMergeFormats(t *testing.T) {
+	defer func(f string, v bool) { outputFormat, formatVersion = f, v }(outputFormat, formatVersion)
+	for _, format := range []string{"plain", "json", "csv"} {
+		for _, version := range []bool{false, true} {
+			outputFormat, formatVersion, headerDone = format, version, false
+			countText("a,b\"aé")
+			errors = 2
+			out := capture(print)
+			errors = 0
+			tab, err := parseCounts("table", []byte(out))
+			if err != nil {
+				t.Errorf("-format=%s -format-version=%t: %v\n%s", format, version, err, out)
+				continue
+			}
+			if len(tab.counts) != 5 || tab.counts['a'] != 2 || tab.counts['é'] != 1 || tab.errors != 2 {
+				t.Errorf("-format=%s -format-version=%t: read %v, %d errors from\n%s", format, version, tab.counts, tab.errors, out)
+			}
+			if !version {
+				continue
+			}
+			future := strings.Replace(out, "1", "2", 1) // The version comes first.
+			if _, err := parseCounts("table", []byte(future)); err == nil || !strings.Contains(err.Error(), "unsupported format version") {
+				t.Errorf("-format=%s: version 2 accepted: %v\n%s", format, err, future)
+			}
+		}
+	}
+}
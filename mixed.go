@@ -0,0 +1,111 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// allowedMixes lists the combinations of scripts that are normal within
+// a single word, following the Highly Restrictive profile of Unicode
+// Technical Standard #39. Any other combination is reported by
+// -warn-mixed as a possible spoof.
+var allowedMixes = [][]string{
+	{"Latin", "Han", "Hiragana", "Katakana"},
+	{"Latin", "Han", "Bopomofo"},
+	{"Latin", "Han", "Hangul"},
+}
+
+// mixed accumulates the current word for -warn-mixed.
+var mixed struct {
+	word     strings.Builder
+	scripts  map[string]bool
+	reported map[string]bool // Words already reported for this file.
+	last     *unicode.RangeTable
+	lastName string
+}
+
+// checkMixed adds r to the current word, or ends the word if r cannot
+// be part of one.
+func checkMixed(r rune) {
+	if !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r) && r != '_' {
+		endWord()
+		return
+	}
+	mixed.word.WriteRune(r)
+	if !unicode.IsLetter(r) {
+		return
+	}
+	if script := scriptOf(r); script != "Common" && script != "Inherited" && script != "" {
+		if mixed.scripts == nil {
+			mixed.scripts = make(map[string]bool)
+		}
+		mixed.scripts[script] = true
+	}
+}
+
+// endWord reports the current word if it mixes scripts and starts a new one.
+func endWord() {
+	if len(mixed.scripts) > 1 && !allowedMix(mixed.scripts) {
+		word := mixed.word.String()
+		if !mixed.reported[word] {
+			if mixed.reported == nil {
+				mixed.reported = make(map[string]bool)
+			}
+			if admitKey(len(word)) {
+				mixed.reported[word] = true
+			}
+			var names []string
+			for s := range mixed.scripts {
+				names = append(names, s)
+			}
+			sort.Strings(names)
+			fmt.Fprintf(os.Stderr, "freq: %s: word %q mixes scripts %s\n", pos.file, word, strings.Join(names, "+"))
+		}
+	}
+	mixed.word.Reset()
+	clear(mixed.scripts)
+}
+
+// allowedMix reports whether the set of scripts is one of allowedMixes.
+func allowedMix(scripts map[string]bool) bool {
+Mixes:
+	for _, allowed := range allowedMixes {
+		for s := range scripts {
+			if !contains(allowed, s) {
+				continue Mixes
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, t := range list {
+		if t == s {
+			return true
+		}
+	}
+	return false
+}
+
+// scriptOf returns the name of the script of r, or "" if it has none.
+func scriptOf(r rune) string {
+	if mixed.last != nil && unicode.Is(mixed.last, r) {
+		return mixed.lastName
+	}
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			mixed.last, mixed.lastName = table, name
+			return name
+		}
+	}
+	return ""
+}
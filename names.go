@@ -0,0 +1,73 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// charName is a character and its name, as listed in UnicodeData.txt.
+type charName struct {
+	r    rune
+	name string
+}
+
+// nameRange is a range of characters named by a prefix and their code
+// points, such as CJK UNIFIED IDEOGRAPH-4E00.
+type nameRange struct {
+	lo, hi rune
+	prefix string
+}
+
+// The Hangul syllables are named from their jamo, by the algorithm of
+// section 3.12 of the Unicode Standard.
+const (
+	hangulBase  = 0xAC00
+	hangulCount = 11172
+	jamoV       = 21
+	jamoT       = 28
+)
+
+var (
+	jamoLNames = []string{"G", "GG", "N", "D", "DD", "R", "M", "B", "BB", "S", "SS", "", "J", "JJ", "C", "K", "T", "P", "H"}
+	jamoVNames = []string{"A", "AE", "YA", "YAE", "EO", "E", "YEO", "YE", "O", "WA", "WAE", "OE", "YO", "U", "WEO", "WE", "WI", "YU", "EU", "YI", "I"}
+	jamoTNames = []string{"", "G", "GG", "GS", "N", "NJ", "NH", "D", "L", "LG", "LM", "LB", "LS", "LT", "LP", "LH", "M", "B", "BS", "S", "SS", "NG", "J", "C", "K", "T", "P", "H"}
+)
+
+// hangulName returns the name of the Hangul syllable r.
+func hangulName(r rune) string {
+	s := int(r - hangulBase)
+	return "HANGUL SYLLABLE " + jamoLNames[s/(jamoV*jamoT)] + jamoVNames[s%(jamoV*jamoT)/jamoT] + jamoTNames[s%jamoT]
+}
+
+// nameOf returns the Unicode name of r, or "" if it has none. Control
+// characters have their Unicode 1.0 names, such as LINE FEED (LF).
+func nameOf(r rune) string {
+	if hangulBase <= r && r < hangulBase+hangulCount {
+		return hangulName(r)
+	}
+	i := sort.Search(len(derivedNameRanges), func(i int) bool { return derivedNameRanges[i].hi >= r })
+	if i < len(derivedNameRanges) && derivedNameRanges[i].lo <= r {
+		return fmt.Sprintf("%s-%04X", derivedNameRanges[i].prefix, r)
+	}
+	i = sort.Search(len(charNames), func(i int) bool { return charNames[i].r >= r })
+	if i < len(charNames) && charNames[i].r == r {
+		return charNames[i].name
+	}
+	return ""
+}
+
+// nameColumn returns the text of the name column for r, which is "-"
+// when r has no name, is hidden by -hash, or is a byte above 7F.
+func nameColumn(r rune) string {
+	if hashIDs || countBytes && r > 0x7F {
+		return "-"
+	}
+	if name := nameOf(r); name != "" {
+		return name
+	}
+	return "-"
+}
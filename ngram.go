@@ -0,0 +1,97 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The n-grams of -ngram: the last ngram characters of the current input
+// and the count of each sequence, keyed by the sequence as a string.
+var (
+	gram       []rune
+	gramCounts = make(map[string]uint64)
+	gramOther  uint64 // Sequences not kept because of -max-memory.
+)
+
+// addGram adds r to the current sequence and, once it holds ngram
+// characters, counts it.
+func addGram(r rune) {
+	if len(gram) == ngram {
+		copy(gram, gram[1:])
+		gram = gram[:ngram-1]
+	}
+	gram = append(gram, r)
+	if len(gram) < ngram {
+		return
+	}
+	s := string(gram)
+	countKey(gramCounts, s, &gramOther)
+}
+
+// resetGram starts a new sequence at the end of an input.
+func resetGram() {
+	gram = gram[:0]
+}
+
+// printSequences prints the counts of sequences of characters, such as
+// n-grams, in the form chosen by -format, each as the keys of its
+// characters joined by + and their glyphs. Kind is the kind of the
+// records for -format=json and csv.
+func printSequences(m map[string]uint64, other uint64, kind, keyFormat string) {
+	list, total := wordEntries(m, other)
+	var records []record
+	share := shares{total: total}
+	var bars bars
+	for _, e := range list {
+		bars.fit(e.count)
+	}
+	bars.fit(other)
+	for _, e := range list {
+		var keys []string
+		var glyphs strings.Builder
+		for _, r := range e.word {
+			keys = append(keys, key(r, keyFormat))
+			glyphs.WriteString(glyph(r))
+		}
+		code := strings.Join(keys, "+")
+		switch outputFormat {
+		case "json", "csv":
+			records = append(records, record{kind, code, rawString(e.word), e.count})
+			continue
+		}
+		fmt.Fprintf(stdout, "%s%s%s\t%*s", code, sep(), glyphs.String(), pad, formatTally(e.count, total))
+		share.print(e.count)
+		if byBytes {
+			fmt.Fprintf(stdout, "\t%s", formatCount(e.count*uint64(len(e.word))))
+		}
+		bars.print(e.count)
+		fmt.Fprintln(stdout)
+	}
+	switch outputFormat {
+	case "json", "csv":
+		if other > 0 {
+			records = append(records, record{"other", "", "", other})
+		}
+	default:
+		printOther("other"+sep()+"-", other, &share, &bars)
+	}
+	switch outputFormat {
+	case "json":
+		printJSON(records)
+	case "csv":
+		printCSV(records)
+	}
+}
+
+// rawString returns the characters of s, as rawChar does for each.
+func rawString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		b.WriteString(rawChar(r))
+	}
+	return b.String()
+}
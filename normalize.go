@@ -0,0 +1,147 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "fmt"
+
+// decomposition is a decomposition mapping from UnicodeData.txt, which
+// for a compatibility mapping applies only to the NFKD and NFKC forms.
+type decomposition struct {
+	compat bool
+	runes  string
+}
+
+// normForms lists the values of -normalize.
+var normForms = map[string]struct{ compat, compose bool }{
+	"nfd":  {false, false},
+	"nfc":  {false, true},
+	"nfkd": {true, false},
+	"nfkc": {true, true},
+}
+
+// The Hangul syllables decompose into jamo and compose from them by the
+// algorithm of section 3.12 of the Unicode Standard.
+const (
+	jamoLBase = 0x1100
+	jamoVBase = 0x1161
+	jamoTBase = 0x11A7
+	jamoL     = 19
+)
+
+// The normalizer of -normalize. The segment is the current starter, if
+// any, and the characters after it with nonzero combining classes, fully
+// decomposed and in canonical order, waiting to be composed and counted.
+var norm struct {
+	on      bool
+	compat  bool
+	compose bool
+	segment []rune
+}
+
+// setNormalization selects the -normalize form.
+func setNormalization(form string) error {
+	f, ok := normForms[form]
+	if !ok {
+		return fmt.Errorf("unknown form %q; want nfc, nfd, nfkc or nfkd", form)
+	}
+	norm.on, norm.compat, norm.compose = true, f.compat, f.compose
+	return nil
+}
+
+// normalize takes the next character of the input and counts, by
+// addRune, the normalized characters it completes.
+func normalize(r rune) {
+	decompose(r, func(c rune) {
+		if combiningClasses[c] != 0 {
+			// Insert the mark in canonical order among those after the starter.
+			s := append(norm.segment, c)
+			for i := len(s) - 1; i > 0 && combiningClasses[s[i-1]] > combiningClasses[c]; i-- {
+				s[i], s[i-1] = s[i-1], s[i]
+			}
+			norm.segment = s
+			return
+		}
+		if norm.compose && len(norm.segment) > 0 {
+			composeSegment()
+			if len(norm.segment) == 1 && combiningClasses[norm.segment[0]] == 0 {
+				if p, ok := composePair(norm.segment[0], c); ok {
+					norm.segment[0] = p
+					return
+				}
+			}
+		}
+		flushNormalization()
+		norm.segment = append(norm.segment, c)
+	})
+}
+
+// flushNormalization counts the characters of the current segment.
+func flushNormalization() {
+	if norm.compose {
+		composeSegment()
+	}
+	for _, c := range norm.segment {
+		addRune(c)
+	}
+	norm.segment = norm.segment[:0]
+}
+
+// decompose calls f with each character of the full decomposition of r.
+func decompose(r rune, f func(rune)) {
+	if hangulBase <= r && r < hangulBase+hangulCount {
+		s := r - hangulBase
+		f(jamoLBase + s/(jamoV*jamoT))
+		f(jamoVBase + s%(jamoV*jamoT)/jamoT)
+		if t := s % jamoT; t != 0 {
+			f(jamoTBase + t)
+		}
+		return
+	}
+	d, ok := decompositions[r]
+	if !ok || d.compat && !norm.compat {
+		f(r)
+		return
+	}
+	for _, c := range d.runes {
+		decompose(c, f)
+	}
+}
+
+// composeSegment composes the marks of the segment with its starter
+// where the canonical composition algorithm allows: a mark combines
+// unless another with the same or a higher combining class, but not
+// combined, lies between it and the starter.
+func composeSegment() {
+	s := norm.segment
+	if len(s) < 2 || combiningClasses[s[0]] != 0 {
+		return
+	}
+	out := s[:1]
+	var lastClass uint8 // Class of the last mark not combined, or 0.
+	for _, c := range s[1:] {
+		class := combiningClasses[c]
+		if lastClass == 0 || lastClass < class {
+			if p, ok := composePair(out[0], c); ok {
+				out[0] = p
+				continue
+			}
+		}
+		lastClass = class
+		out = append(out, c)
+	}
+	norm.segment = out
+}
+
+// composePair returns the primary composite of a and b, if there is one.
+func composePair(a, b rune) (rune, bool) {
+	if jamoLBase <= a && a < jamoLBase+jamoL && jamoVBase <= b && b < jamoVBase+jamoV {
+		return hangulBase + ((a-jamoLBase)*jamoV+(b-jamoVBase))*jamoT, true
+	}
+	if s := a - hangulBase; 0 <= s && s < hangulCount && s%jamoT == 0 && jamoTBase < b && b < jamoTBase+jamoT {
+		return a + (b - jamoTBase), true
+	}
+	c, ok := composition[[2]rune{a, b}]
+	return c, ok
+}
@@ -0,0 +1,55 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+)
+
+// output is a buffered writer to standard output that, if every is
+// positive, flushes after every that many lines.
+type output struct {
+	w     *bufio.Writer
+	every int
+	lines int
+}
+
+// stdout is where the results are printed. Main sizes its buffer by
+// -bufsize and exit flushes it.
+var stdout = &output{w: bufio.NewWriter(os.Stdout)}
+
+func (o *output) Write(p []byte) (int, error) {
+	if o.every <= 0 {
+		return o.w.Write(p)
+	}
+	n := 0
+	for len(p) > 0 {
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			m, err := o.w.Write(p)
+			return n + m, err
+		}
+		m, err := o.w.Write(p[:i+1])
+		n += m
+		if err != nil {
+			return n, err
+		}
+		p = p[i+1:]
+		if o.lines++; o.lines >= o.every {
+			o.lines = 0
+			if err := o.w.Flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Flush writes any buffered output.
+func (o *output) Flush() error {
+	return o.w.Flush()
+}
@@ -0,0 +1,46 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"os"
+	"os/exec"
+)
+
+// startPager directs the output for -page through a pager if standard
+// output is a terminal. The pager is $PAGER, run by the shell, or else
+// "less -R" or "more", whichever is found first. Exit waits for the
+// pager to finish.
+func startPager() error {
+	if !isTerminal(os.Stdout) {
+		return nil
+	}
+	var cmd *exec.Cmd
+	if pager := os.Getenv("PAGER"); pager != "" {
+		cmd = exec.Command("sh", "-c", pager)
+	} else if path, err := exec.LookPath("less"); err == nil {
+		cmd = exec.Command(path, "-R")
+	} else if path, err := exec.LookPath("more"); err == nil {
+		cmd = exec.Command(path)
+	} else {
+		return nil
+	}
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	w, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	stdout.w = bufio.NewWriterSize(w, bufSize)
+	cleanups = append(cleanups, func() {
+		w.Close()
+		cmd.Wait()
+	})
+	return nil
+}
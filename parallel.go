@@ -0,0 +1,247 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"unicode/utf8"
+)
+
+// minChunk is the smallest section of a file worth counting separately.
+const minChunk = 1 << 20
+
+// partialCounts holds the counts of part of the input, made
+// independently of the global counts and added to them afterwards.
+type partialCounts struct {
+	counts   *Counts
+	errors   uint64
+	badBytes [256]uint64
+	n        int64 // Bytes read.
+	err      error
+}
+
+// sequentialOptions lists the options under which inputs must be read
+// in order, by read, because each character's treatment depends on what
+// came before or because they report positions as they go.
+var sequentialOptions = []*bool{
+	&warnMixed, &graphemeRatio, &foldCombining, &dedupe, &showPositions,
+	&condEntropy, &trailingWS, &trojanSource, &emojiMode, &strict,
+	&splitSurrogates, &distinct, &guessEncoding, &wcSummary, &longestRuns,
+	&wordMode, &graphemeMode, &follow,
+}
+
+// canSplit reports whether the input may be counted in independent parts.
+func canSplit() bool {
+	for _, set := range sequentialOptions {
+		if *set {
+			return false
+		}
+	}
+	// The -only and -ignore verdicts are cached in a map, unsafe to share.
+	return showErrors == 0 && windowSize == 0 && ngram == 0 && headLines == 0 && field == 0 && !norm.on && selection == nil &&
+		resetRE == nil && alertRune < 0 && listenAddr == ""
+}
+
+// countPart counts the bytes or runes of r, as read does in the absence
+// of the sequentialOptions. It reads r in blocks and decodes them itself,
+// tallying bytes, and ASCII runes, in a flat array that is translated and
+// added to the counts at the end, which is much faster than reading a
+// rune at a time.
+func countPart(r io.Reader) *partialCounts {
+	p := &partialCounts{counts: new(Counts)}
+	var small [256]uint64 // Counts of the bytes, or ASCII runes, as read.
+	block := make([]byte, max(bufSize, utf8.UTFMax))
+	held := 0 // Bytes of a rune begun at the end of the previous block.
+	for !timedOut.Load() {
+		n, err := r.Read(block[held:])
+		p.n += int64(n)
+		data := block[:held+n]
+		if countBytes {
+			for _, b := range data {
+				small[b]++
+			}
+		} else {
+			held = p.decode(data, &small, err != nil)
+			copy(block, data[len(data)-held:])
+		}
+		if err != nil {
+			p.setErr(err)
+			break
+		}
+	}
+	for b, count := range small {
+		if count == 0 {
+			continue
+		}
+		if r, ok := translate(rune(b)); ok {
+			p.counts.Add(r, count)
+		}
+	}
+	return p
+}
+
+// decode counts the runes of data, the ASCII ones in small, and returns
+// the number of bytes at its end that begin an incomplete rune, unless
+// the data is final, in which case they are decode errors.
+func (p *partialCounts) decode(data []byte, small *[256]uint64, final bool) int {
+	for i := 0; i < len(data); {
+		if b := data[i]; b < utf8.RuneSelf {
+			small[b]++
+			i++
+			continue
+		}
+		if !final && !utf8.FullRune(data[i:]) {
+			return len(data) - i
+		}
+		c, width := utf8.DecodeRune(data[i:])
+		if c == utf8.RuneError && width == 1 {
+			p.errors++
+			if recoverBytes {
+				p.badBytes[data[i]]++
+			}
+		} else if c, ok := translate(c); ok {
+			p.counts.Inc(c)
+		}
+		i += width
+	}
+	return 0
+}
+
+func (p *partialCounts) setErr(err error) {
+	if err != io.EOF {
+		p.err = err
+	}
+}
+
+// add adds p to the current counts.
+func (p *partialCounts) add() {
+	counts.Merge(p.counts)
+	errors += p.errors
+	for b, count := range p.badBytes {
+		badBytes[b] += count
+	}
+}
+
+// readChunks counts f, of the given size, in -chunks sections at once.
+// In rune mode the sections begin only at bytes that start a character,
+// or that would be decode errors, so they divide the input exactly as
+// reading it in order does.
+func readChunks(f *os.File, size int64) error {
+	n := int64(chunks)
+	if size/n < minChunk {
+		n = max(1, size/minChunk)
+	}
+	starts := []int64{0}
+	for i := int64(1); i < n; i++ {
+		start := max(size*i/n, starts[len(starts)-1])
+		if !countBytes {
+			start = runeStart(f, start, size)
+		}
+		starts = append(starts, start)
+	}
+	starts = append(starts, size)
+	parts := make([]*partialCounts, len(starts)-1)
+	var wg sync.WaitGroup
+	for i := range parts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			parts[i] = countPart(io.NewSectionReader(f, starts[i], starts[i+1]-starts[i]))
+		}()
+	}
+	wg.Wait()
+	for _, p := range parts {
+		if p.err != nil {
+			return p.err
+		}
+		p.add()
+	}
+	return nil
+}
+
+// runeStart returns the offset of the first byte at or after off in f
+// that is not a UTF-8 continuation byte, or size if there is none.
+func runeStart(f *os.File, off, size int64) int64 {
+	var b [1]byte
+	for ; off < size; off++ {
+		if _, err := f.ReadAt(b[:], off); err != nil || b[0]&0xC0 != 0x80 {
+			return off
+		}
+	}
+	return size
+}
+
+// readFiles counts the named inputs, in order. With -j, when the options
+// allow, the files are counted by concurrent workers, each into its own
+// partialCounts, which are added to the counts in the order of the names,
+// so the result, and any -per-file tables, are as if they were read in
+// order. At most -j files are being counted or waiting to be added at
+// once. Standard input is read in its turn.
+func readFiles(names []string) {
+	if jobs == 1 || !canSplit() {
+		for _, file := range names {
+			readFile(file)
+		}
+		return
+	}
+	results := make([]chan *partialCounts, len(names))
+	for i := range results {
+		results[i] = make(chan *partialCounts, 1)
+	}
+	slots := make(chan bool, jobs)
+	go func() {
+		for i, file := range names {
+			if file == "-" {
+				continue
+			}
+			slots <- true
+			go func() {
+				results[i] <- countFile(file)
+			}()
+		}
+	}()
+	for i, file := range names {
+		if file == "-" {
+			readFile(file)
+			continue
+		}
+		p := <-results[i]
+		<-slots
+		if p.err != nil {
+			inputError(p.err)
+		}
+		if p.counts == nil {
+			continue
+		}
+		if grouping() {
+			useGroup(file)
+		}
+		p.add()
+		bytesRead += p.n
+	}
+}
+
+// countFile counts the named file into a partialCounts of its own.
+func countFile(file string) *partialCounts {
+	f, err := os.Open(file)
+	if err != nil {
+		return &partialCounts{err: err}
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if decompress {
+		if r, err = decompressor(f); err != nil {
+			return &partialCounts{err: fmt.Errorf("%s: %s", file, err)}
+		}
+	}
+	p := countPart(newDecoder(r))
+	if p.err != nil {
+		p.err = fmt.Errorf("%s: %s", file, p.err)
+	}
+	return p
+}
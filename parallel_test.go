@@ -0,0 +1,262 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// benchText returns n bytes of text mixing ASCII with two-, three- and
+// four-byte characters, so that some runes straddle block boundaries.
+func benchText(n int) []byte {
+	rng := rand.New(rand.NewSource(1))
+	pieces := []string{"a", "e", "t", " ", "\n", "é", "ü", "€", "日", "本", "😀"}
+	var b bytes.Buffer
+	for b.Len() < n {
+		b.WriteString(pieces[rng.Intn(len(pieces))])
+	}
+	return b.Bytes()
+}
+
+// benchFile writes data to a file in a temporary directory.
+func benchFile(b *testing.B, dir string, i int, data []byte) string {
+	file := filepath.Join(dir, fmt.Sprintf("input%d.txt", i))
+	if err := os.WriteFile(file, data, 0o644); err != nil {
+		b.Fatal(err)
+	}
+	return file
+}
+
+// benchBufSizes are the -bufsize values the read benchmarks compare.
+var benchBufSizes = []int{512, 4096, 64 << 10, 1 << 20}
+
+// benchBufSize runs f as a sub-benchmark for each of benchBufSizes.
+func benchBufSize(b *testing.B, f func(b *testing.B)) {
+	defer func(n int) { bufSize = n }(bufSize)
+	for _, n := range benchBufSizes {
+		bufSize = n
+		b.Run(fmt.Sprintf("bufsize=%d", n), f)
+	}
+}
+
+// benchOpen writes data to a file and opens it. The read benchmarks
+// read a file, rather than memory, so that -bufsize sets the number of
+// system calls.
+func benchOpen(b *testing.B, data []byte) *os.File {
+	f, err := os.Open(benchFile(b, b.TempDir(), 0, data))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { f.Close() })
+	return f
+}
+
+// rewind returns f to its start.
+func rewind(b *testing.B, f *os.File) *os.File {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		b.Fatal(err)
+	}
+	return f
+}
+
+// BenchmarkReadRunes measures the rune-at-a-time reading that read does
+// when an option needs the characters in order.
+func BenchmarkReadRunes(b *testing.B) {
+	data := benchText(4 << 20)
+	f := benchOpen(b, data)
+	benchBufSize(b, func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for b.Loop() {
+			counts = new(Counts)
+			readRunes("bench", rewind(b, f))
+		}
+	})
+}
+
+// BenchmarkCountPart measures the block reading that read does otherwise.
+func BenchmarkCountPart(b *testing.B) {
+	data := benchText(4 << 20)
+	f := benchOpen(b, data)
+	benchBufSize(b, func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for b.Loop() {
+			if p := countPart(rewind(b, f)); p.err != nil {
+				b.Fatal(p.err)
+			}
+		}
+	})
+}
+
+func BenchmarkCountPartBytes(b *testing.B) {
+	data := benchText(4 << 20)
+	b.SetBytes(int64(len(data)))
+	countBytes = true
+	defer func() { countBytes = false }()
+	for b.Loop() {
+		countPart(bytes.NewReader(data))
+	}
+}
+
+func BenchmarkReadChunks(b *testing.B) {
+	data := benchText(16 << 20)
+	f, err := os.Open(benchFile(b, b.TempDir(), 0, data))
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer f.Close()
+	for _, n := range []int{1, 4} {
+		b.Run(fmt.Sprintf("chunks=%d", n), func(b *testing.B) {
+			chunks = n
+			defer func() { chunks = 1 }()
+			b.SetBytes(int64(len(data)))
+			for b.Loop() {
+				counts = new(Counts)
+				if err := readChunks(f, int64(len(data))); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkReadFiles measures -j over a batch of files, against reading
+// them in turn with -j=1 and with a worker for each processor.
+func BenchmarkReadFiles(b *testing.B) {
+	dir := b.TempDir()
+	data := benchText(2 << 20)
+	var names []string
+	for i := range 16 {
+		names = append(names, benchFile(b, dir, i, data))
+	}
+	for _, j := range []int{1, 4, runtime.GOMAXPROCS(0)} {
+		b.Run(fmt.Sprintf("j=%d", j), func(b *testing.B) {
+			jobs = j
+			defer func() { jobs = 1 }()
+			b.SetBytes(int64(len(data) * len(names)))
+			for b.Loop() {
+				counts = new(Counts)
+				readFiles(names)
+			}
+		})
+	}
+}
+
+// tally is a copy of the counts, errors and bad bytes, for comparison.
+type tally struct {
+	counts   map[rune]uint64
+	errors   uint64
+	badBytes [256]uint64
+}
+
+// takeTally returns a tally of the current counts and resets them.
+func takeTally() tally {
+	t := tally{counts: make(map[rune]uint64), errors: errors, badBytes: badBytes}
+	counts.Do(func(r rune, count uint64) { t.counts[r] = count })
+	counts, errors, badBytes = new(Counts), 0, [256]uint64{}
+	return t
+}
+
+func (t tally) equal(u tally) bool {
+	if t.errors != u.errors || t.badBytes != u.badBytes || len(t.counts) != len(u.counts) {
+		return false
+	}
+	for r, count := range t.counts {
+		if u.counts[r] != count {
+			return false
+		}
+	}
+	return true
+}
+
+// spoil returns data with truncated, overlong and stray bytes inserted
+// every so often.
+func spoil(data []byte) []byte {
+	bad := []string{"\xff", "\xe2\x82", "\xc0\x80", "\x80\x80", "\xf0\x9f\x98"}
+	var b bytes.Buffer
+	for i := 0; i < len(data); i += 100003 {
+		b.Write(data[i:min(i+100003, len(data))])
+		b.WriteString(bad[i%len(bad)])
+	}
+	return b.Bytes()
+}
+
+var splitTests = []struct {
+	name    string
+	data    []byte
+	bytes   bool
+	recover bool
+}{
+	{"text", benchText(5 << 20), false, false},
+	{"emoji", bytes.Repeat([]byte("😀"), 5<<18+1), false, false},
+	{"invalid", spoil(benchText(5 << 20)), false, false},
+	{"recover", spoil(benchText(5 << 20)), false, true},
+	{"bytes", spoil(benchText(5 << 20)), true, false},
+}
+
+// TestSplitMatchesSequential checks that -chunks and -j count exactly
+// what reading the input a character at a time does, whatever falls at
+// the boundaries of the sections.
+func TestSplitMatchesSequential(t *testing.T) {
+	defer func() { chunks, jobs, countBytes, recoverBytes = 1, 1, false, false }()
+	dir := t.TempDir()
+	for _, test := range splitTests {
+		countBytes, recoverBytes = test.bytes, test.recover
+		for shift := range 4 { // Move the boundaries across the characters.
+			data := test.data[shift:]
+			file := filepath.Join(dir, fmt.Sprintf("%s%d", test.name, shift))
+			if err := os.WriteFile(file, data, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			takeTally()
+			if countBytes {
+				readBytes(file, bytes.NewReader(data))
+			} else {
+				readRunes(file, bytes.NewReader(data))
+			}
+			flush()
+			want := takeTally()
+
+			chunks = 4
+			readFile(file)
+			chunks = 1
+			if got := takeTally(); !got.equal(want) {
+				t.Errorf("%s shifted %d: -chunks=4 counts differ from sequential: %d errors, want %d", test.name, shift, got.errors, want.errors)
+			}
+
+			// Three files that together hold the data, cut at odd places.
+			var names []string
+			for i, cut := range [][2]int{{0, 1000001}, {1000001, 3000007}, {3000007, len(data)}} {
+				name := fmt.Sprintf("%s.%d", file, i)
+				if err := os.WriteFile(name, data[cut[0]:cut[1]], 0o644); err != nil {
+					t.Fatal(err)
+				}
+				names = append(names, name)
+			}
+			for _, name := range names {
+				part, _ := os.ReadFile(name)
+				if countBytes {
+					readBytes(name, bytes.NewReader(part))
+				} else {
+					readRunes(name, bytes.NewReader(part))
+				}
+				flush()
+			}
+			want = takeTally()
+			jobs = 4
+			readFiles(names)
+			jobs = 1
+			if got := takeTally(); !got.equal(want) {
+				t.Errorf("%s shifted %d: -j=4 counts differ from sequential: %d errors, want %d", test.name, shift, got.errors, want.errors)
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+)
+
+// Dimensions of the -png histogram, in pixels.
+const (
+	barWidth    = 3
+	chartHeight = 256
+	tickHeight  = 4 // Marks below the bars at every 16th byte value.
+)
+
+// writePNG writes a bar chart of the byte counts to file, one bar per
+// byte value from 00 to ff, scaled so the largest count fills the height.
+func writePNG(file string) error {
+	var c [256]uint64
+	var max uint64
+	counts.Do(func(r rune, count uint64) {
+		c[r] = count
+		if count > max {
+			max = count
+		}
+	})
+	img := image.NewGray(image.Rect(0, 0, 256*barWidth, chartHeight+tickHeight))
+	for i := range img.Pix {
+		img.Pix[i] = 0xFF
+	}
+	for b, count := range c {
+		h := 0
+		if max > 0 {
+			h = int((count*chartHeight + max - 1) / max) // Round up so any count shows.
+		}
+		for x := b * barWidth; x < (b+1)*barWidth-1; x++ {
+			for y := chartHeight - h; y < chartHeight; y++ {
+				img.SetGray(x, y, color.Gray{0})
+			}
+			if b%16 == 0 {
+				for y := chartHeight; y < chartHeight+tickHeight; y++ {
+					img.SetGray(x, y, color.Gray{0x80})
+				}
+			}
+		}
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
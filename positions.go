@@ -0,0 +1,41 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "fmt"
+
+// position is a place in the input.
+type position struct {
+	file   string
+	offset int64
+}
+
+func (p position) String() string {
+	return fmt.Sprintf("%s:%d", p.file, p.offset)
+}
+
+// positions holds the first and last positions of each character
+// counted, for -positions.
+var positions = make(map[rune]*[2]position)
+
+// recordPosition notes that r was counted at the current position.
+func recordPosition(r rune) {
+	p := position{pos.file, pos.offset}
+	if fl, ok := positions[r]; ok {
+		fl[1] = p
+		return
+	}
+	positions[r] = &[2]position{p, p}
+}
+
+// positionColumns returns the -positions columns for r, which are dashes
+// if its counts were merged rather than read.
+func positionColumns(r rune) string {
+	fl, ok := positions[r]
+	if !ok {
+		return "-\t-"
+	}
+	return fl[0].String() + "\t" + fl[1].String()
+}
@@ -0,0 +1,831 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"math"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tableFormat is the version of the table format announced by
+// -format-version. It changes when the columns or their meaning do.
+const tableFormat = 1
+
+// formatHeader is the header line printed by -format-version.
+const formatHeader = "# freq format "
+
+// headerDone records that the -format-version header has been printed.
+var headerDone bool
+
+func print() {
+	if formatVersion && !headerDone && outputFormat != "json" && outputFormat != "csv" {
+		fmt.Fprintf(stdout, "%s%d\n", formatHeader, tableFormat)
+		headerDone = true
+	}
+	if wordMode {
+		printWords()
+		return
+	}
+	format := "%.4x"
+	if countBytes {
+		format = "%.2x"
+	}
+	if baseline != nil {
+		printDiff(format)
+		return
+	}
+	if graphemeMode {
+		printSequences(graphemeCounts, graphemeOther, "grapheme", format)
+		return
+	}
+	if ngram > 0 {
+		printSequences(gramCounts, gramOther, "ngram", format)
+		return
+	}
+	if aggregateBy != "" {
+		printAggregates()
+		return
+	}
+	if classifyCmd != "" {
+		err := classify(classifyCmd, format)
+		if err == nil {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "freq: classify: %s; printing ungrouped counts\n", err)
+	}
+	if trojanSource {
+		printTrojan(format + "\t%d\t%s\n")
+		return
+	}
+	if markdown {
+		printMarkdown(format)
+		return
+	}
+	if plotFormat != "" {
+		printPlot(format)
+		return
+	}
+	if distinct {
+		for _, e := range entries() {
+			fmt.Fprintf(stdout, "%s%s%s\n", key(e.r, format), sep(), glyph(e.r))
+		}
+		return
+	}
+	if emojiMode {
+		printEmoji()
+		return
+	}
+	if emitGo {
+		printGo()
+		return
+	}
+	if guessEncoding {
+		printGuesses()
+		return
+	}
+	if topPerCategory > 0 {
+		printTopPerCategory(format)
+		return
+	}
+	if planes {
+		printPlanes()
+		return
+	}
+	if utf8Lengths {
+		printUTF8Lengths()
+		return
+	}
+	if caseReport {
+		printCases()
+		return
+	}
+	if coverage {
+		printCoverage()
+		return
+	}
+	if zeroWidth && !countBytes {
+		printInvisible(format + " %s\t%d\t%s\n")
+		return
+	}
+	switch outputFormat {
+	case "json":
+		printJSON(records(format))
+		return
+	case "csv":
+		if columns != nil {
+			printColumnsCSV(format)
+		} else {
+			printCSV(records(format))
+		}
+		return
+	}
+	if columns != nil {
+		printColumns(format)
+		return
+	}
+	printCounts(format)
+}
+
+// formatCount returns the text for a count: decimal, or under -human
+// (but not -tsv) abbreviated with a suffix K, M, G, T, P or E for a
+// power of 1000, as in 45K, to one decimal place below 10, as in 1.2M.
+// Values are rounded to the nearest digit shown.
+func formatCount(n uint64) string {
+	if !human || tsv || n < 1000 {
+		return strconv.FormatUint(n, 10)
+	}
+	v := float64(n)
+	for _, suffix := range "KMGTPE" {
+		v /= 1000
+		switch {
+		case v < 9.95:
+			return fmt.Sprintf("%.1f%c", v, suffix)
+		case v < 999.5:
+			return fmt.Sprintf("%.0f%c", v, suffix)
+		}
+	}
+	return strconv.FormatUint(n, 10) // Not reached: 1<<64 is about 18.4E.
+}
+
+// formatTally formats the count n for the count column of the table:
+// as a fraction of total for -probabilities, otherwise as formatCount.
+func formatTally(n, total uint64) string {
+	if !probabilities {
+		return formatCount(n)
+	}
+	p := 0.0
+	if total > 0 {
+		p = float64(n) / float64(total)
+	}
+	return strconv.FormatFloat(p, 'f', precision, 64)
+}
+
+// majorCategories lists the major general categories, with their names.
+var majorCategories = []struct {
+	c    byte
+	name string
+}{
+	{'L', "Letter"},
+	{'M', "Mark"},
+	{'N', "Number"},
+	{'P', "Punctuation"},
+	{'S', "Symbol"},
+	{'Z', "Separator"},
+	{'C', "Other"},
+}
+
+// printTopPerCategory prints, for each major general category, its
+// -top-per-category most frequent characters. Ties go to the lower
+// code point.
+func printTopPerCategory(keyFormat string) {
+	list := entries()
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].count != list[j].count {
+			return list[i].count > list[j].count
+		}
+		return list[i].r < list[j].r
+	})
+	top := make(map[byte][]entry)
+	for _, e := range list {
+		c := majorCategory(e.r)
+		if len(top[c]) < topPerCategory {
+			top[c] = append(top[c], e)
+		}
+	}
+	for _, mc := range majorCategories {
+		if len(top[mc.c]) == 0 {
+			continue
+		}
+		fmt.Fprintf(stdout, "# %s\n", mc.name)
+		for _, e := range top[mc.c] {
+			fmt.Fprintf(stdout, "%s%s%s\t%*s\n", key(e.r, keyFormat), sep(), glyph(e.r), pad, formatCount(e.count))
+		}
+	}
+}
+
+// printUTF16 prints the length of the input in UTF-16 code units, as
+// JavaScript measures strings, and how many characters took one unit
+// and how many a surrogate pair. A decode error becomes U+FFFD, one unit.
+func printUTF16() {
+	var bmp, astral uint64
+	counts.Do(func(r rune, count uint64) {
+		if r > 0xFFFF {
+			astral += count
+		} else {
+			bmp += count
+		}
+	})
+	fmt.Fprintf(stdout, "utf-16 units\t%d\n", bmp+2*astral+errors)
+	fmt.Fprintf(stdout, "single units\t%d\n", bmp+errors)
+	fmt.Fprintf(stdout, "surrogate pairs\t%d\n", astral)
+}
+
+// printCases prints the number of upper, lower and title case letters
+// counted, and of letters without case, with each one's share of all
+// the letters.
+func printCases() {
+	classes := []string{"upper", "lower", "title", "uncased"}
+	var n [4]uint64
+	var letters uint64
+	counts.Do(func(r rune, count uint64) {
+		switch {
+		case !unicode.IsLetter(r):
+			return
+		case unicode.IsUpper(r):
+			n[0] += count
+		case unicode.IsLower(r):
+			n[1] += count
+		case unicode.IsTitle(r):
+			n[2] += count
+		default:
+			n[3] += count
+		}
+		letters += count
+	})
+	for i, class := range classes {
+		share := 0.0
+		if letters > 0 {
+			share = 100 * float64(n[i]) / float64(letters)
+		}
+		fmt.Fprintf(stdout, "%s\t%*s\t%.2f%%\n", class, pad, formatCount(n[i]), share)
+	}
+}
+
+// printMarkdown prints the table as a GitHub-flavored Markdown table,
+// with the error and surrogate counts as final rows.
+func printMarkdown(format string) {
+	fmt.Fprintln(stdout, "| Code point | Char | Count |")
+	fmt.Fprintln(stdout, "|---|---|---:|")
+	for _, e := range entries() {
+		fmt.Fprintf(stdout, "| %s | %s | %s |\n", key(e.r, format), markdownEscaper.Replace(glyph(e.r)), formatCount(e.count))
+	}
+	if errors > 0 {
+		fmt.Fprintf(stdout, "| error | - | %s |\n", formatCount(errors))
+	}
+	for b, count := range badBytes {
+		if count > 0 {
+			fmt.Fprintf(stdout, "| error %.2x | - | %s |\n", b, formatCount(count))
+		}
+	}
+	values := make([]rune, 0, len(surrogates))
+	for r := range surrogates {
+		values = append(values, r)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	for _, r := range values {
+		fmt.Fprintf(stdout, "| surrogate %.4x | - | %s |\n", r, formatCount(surrogates[r]))
+	}
+}
+
+// markdownEscaper escapes the characters that would break a Markdown
+// table cell.
+var markdownEscaper = strings.NewReplacer("|", `\|`, "`", "\\`")
+
+// sep returns the separator between the key and glyph columns.
+func sep() string {
+	if tsv || columns != nil {
+		return "\t"
+	}
+	return " "
+}
+
+// printSummary prints the totals requested in addition to the table.
+func printSummary() {
+	if graphemeRatio {
+		fmt.Fprintf(stdout, "graphemes\t%d\n", totalGraphemes)
+		fmt.Fprintf(stdout, "runes\t%d\n", totalRunes)
+		ratio := 0.0
+		if totalGraphemes > 0 {
+			ratio = float64(totalRunes) / float64(totalGraphemes)
+		}
+		fmt.Fprintf(stdout, "runes/grapheme\t%.3f\n", ratio)
+	}
+	if chiSquare {
+		printChiSquare()
+	}
+	if reference != nil {
+		printDivergence()
+	}
+	if condEntropy {
+		printConditionalEntropy()
+	}
+	if stats {
+		printStats()
+	}
+	if trailingWS {
+		printTrailing()
+	}
+	if utf16 {
+		printUTF16()
+	}
+	if wcSummary {
+		printWC()
+	}
+	if longestRuns {
+		format := "%.4x"
+		if countBytes {
+			format = "%.2x"
+		}
+		printRuns(format)
+	}
+	if replacements {
+		fmt.Fprintf(stdout, "U+FFFD (literal)\t%d\n", counts.Count(utf8.RuneError))
+		fmt.Fprintf(stdout, "decode-error\t%d\n", errors)
+	}
+}
+
+// printCounts prints the table, with the code points in keyFormat.
+func printCounts(keyFormat string) {
+	var size uint64
+	if byteShare {
+		size = encodedSize()
+	}
+	list := entries()
+	var rare uint64
+	if classifyFrequency {
+		rare = rareCount(list)
+	}
+	var t uint64
+	if probabilities || showPercent {
+		t = total()
+	}
+	share := shares{total: t}
+	var bars bars
+	for _, e := range list {
+		bars.fit(e.count)
+	}
+	for _, e := range list {
+		fmt.Fprintf(stdout, "%s%s%s\t%*s", key(e.r, keyFormat), sep(), glyph(e.r), pad, formatTally(e.count, t))
+		share.print(e.count)
+		if byteShare {
+			fmt.Fprintf(stdout, "\t%.2f%%", 100*float64(e.count*width(e.r))/float64(size))
+		}
+		if byBytes {
+			fmt.Fprintf(stdout, "\t%s", formatCount(e.count*width(e.r)))
+		}
+		if classifyFrequency {
+			fmt.Fprintf(stdout, "\t%s", frequencyClass(e.count, rare))
+		}
+		if showPositions {
+			fmt.Fprintf(stdout, "\t%s", positionColumns(e.r))
+		}
+		if showNames {
+			fmt.Fprintf(stdout, "\t%s", nameColumn(e.r))
+		}
+		if surrogatePairs && e.r > 0xFFFF && !hashIDs {
+			hi, lo := surrogatePair(e.r)
+			fmt.Fprintf(stdout, "\t%.4X %.4X", hi, lo)
+		}
+		bars.print(e.count)
+		fmt.Fprintln(stdout)
+	}
+	printErrors()
+}
+
+// shares prints the -percent columns of the rows of a table whose counts
+// sum to total: the share of the row and, if the table is sorted by
+// count, the cumulative share of the rows so far.
+type shares struct {
+	total, sum uint64
+}
+
+func (s *shares) print(count uint64) {
+	if !showPercent {
+		return
+	}
+	fmt.Fprintf(stdout, "\t%.2f%%", percent(count, s.total))
+	if sortKey == "count" || sortKey == "percent" {
+		s.sum += count
+		fmt.Fprintf(stdout, "\t%.2f%%", percent(s.sum, s.total))
+	}
+}
+
+// percent returns n as a percentage of total.
+func percent(n, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(n) / float64(total)
+}
+
+// printUTF8Lengths prints, for each length of UTF-8 encoding, 1 to 4
+// bytes, the number of characters with that length and their bytes,
+// followed by the number of bytes that did not decode.
+func printUTF8Lengths() {
+	var n [utf8.UTFMax + 1]uint64
+	counts.Do(func(r rune, count uint64) {
+		if length := utf8.RuneLen(r); length > 0 {
+			n[length] += count
+		}
+	})
+	for length := 1; length <= utf8.UTFMax; length++ {
+		fmt.Fprintf(stdout, "%d\t%*s\t%s\n", length, pad, formatCount(n[length]), formatCount(n[length]*uint64(length)))
+	}
+	if errors > 0 {
+		fmt.Fprintf(stdout, "error\t%*s\t%s\n", pad, formatCount(errors), formatCount(errors))
+	}
+}
+
+// surrogatePair returns the UTF-16 surrogates that encode r, which
+// must be above U+FFFF.
+func surrogatePair(r rune) (hi, lo rune) {
+	r -= 0x10000
+	return 0xD800 + r>>10, 0xDC00 + r&0x3FF
+}
+
+// entry is a counted character and its count.
+type entry struct {
+	r     rune
+	count uint64
+}
+
+// entries returns the counted characters that pass the output filters,
+// at most -top of them, in the order requested by -sortby.
+func entries() []entry {
+	var list []entry
+	total := total()
+	counts.Do(func(r rune, count uint64) {
+		if shown(count, total) {
+			list = append(list, entry{r, count})
+		}
+	})
+	if topN > 0 && len(list) > topN {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].count > list[j].count })
+		list = list[:topN]
+		sort.Slice(list, func(i, j int) bool { return list[i].r < list[j].r })
+	}
+	less := func(i, j int) bool { return list[i].r < list[j].r }
+	switch sortKey {
+	case "count", "percent":
+		less = func(i, j int) bool { return list[i].count < list[j].count }
+	case "bytes":
+		less = func(i, j int) bool { return list[i].count*width(list[i].r) < list[j].count*width(list[j].r) }
+	case "name":
+		names := make(map[rune]string, len(list))
+		for _, e := range list {
+			names[e.r] = nameColumn(e.r)
+		}
+		less = func(i, j int) bool { return names[list[i].r] < names[list[j].r] }
+	}
+	if sortDesc {
+		asc := less
+		less = func(i, j int) bool { return asc(j, i) }
+	}
+	// The list is in code point order, so a stable sort breaks ties by code point.
+	sort.SliceStable(list, less)
+	return list
+}
+
+// rareCount returns the count at the -rare percentile of the entries,
+// by the nearest-rank method: the smallest count such that at least
+// that percentage of the entries have a count no larger.
+func rareCount(list []entry) uint64 {
+	if len(list) == 0 {
+		return 0
+	}
+	c := make([]uint64, len(list))
+	for i, e := range list {
+		c[i] = e.count
+	}
+	sort.Slice(c, func(i, j int) bool { return c[i] < c[j] })
+	rank := int(math.Ceil(rarePercentile / 100 * float64(len(c))))
+	if rank < 1 {
+		return 0 // Nothing is rare.
+	}
+	return c[min(rank, len(c))-1]
+}
+
+// frequencyClass returns the label for an entry with the given count when
+// rare is the -rare percentile count. Counts equal to it are rare.
+func frequencyClass(count, rare uint64) string {
+	switch {
+	case count == 1:
+		return "unique"
+	case count <= rare:
+		return "rare"
+	}
+	return "common"
+}
+
+// sortKeys lists the keys accepted by -sortby.
+var sortKeys = []string{"char", "count", "percent", "bytes", "name"}
+
+// parseSortBy parses the -sortby value: a key from sortKeys, optionally
+// followed by ":asc" or ":desc".
+func parseSortBy(s string) (key string, desc bool, err error) {
+	key, dir, _ := strings.Cut(s, ":")
+	switch dir {
+	case "", "asc":
+	case "desc":
+		desc = true
+	default:
+		return "", false, fmt.Errorf("bad -sortby direction %q; want asc or desc", dir)
+	}
+	for _, k := range sortKeys {
+		if key == k {
+			return key, desc, nil
+		}
+	}
+	return "", false, fmt.Errorf("unknown -sortby key %q; want one of %s", key, strings.Join(sortKeys, ", "))
+}
+
+// width returns the number of bytes r occupied in the input.
+func width(r rune) uint64 {
+	if countBytes {
+		return 1
+	}
+	return uint64(utf8.RuneLen(r))
+}
+
+// total returns the total against which percentages are computed: the
+// number of counted characters, plus the decode errors if
+// -errors-in-total is set.
+func total() uint64 {
+	t := counts.Total()
+	if errorsInTotal {
+		t += errors
+	}
+	return t
+}
+
+// encodedSize returns the number of bytes in the counted input,
+// including one for each decode error if -errors-in-total is set.
+func encodedSize() uint64 {
+	var size uint64
+	if errorsInTotal {
+		size = errors
+	}
+	counts.Do(func(r rune, count uint64) {
+		size += count * width(r)
+	})
+	return size
+}
+
+// printGo prints the counts as a Go map literal, formatted by gofmt.
+func printGo() {
+	keyType, line := "rune", "\t0x%.4x: %d, // %s\n"
+	if countBytes {
+		keyType, line = "byte", "\t0x%.2x: %d, // %s\n"
+	}
+	const prefix = "package p\n\nvar _ = "
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%smap[%s]uint64{\n", prefix, keyType)
+	total := total()
+	counts.Do(func(r rune, count uint64) {
+		if !shown(count, total) {
+			return
+		}
+		quoted := strconv.QuoteRune(r)
+		if countBytes && r >= utf8.RuneSelf {
+			quoted = fmt.Sprintf(`'\x%.2x'`, r)
+		}
+		fmt.Fprintf(&b, line, r, count, quoted)
+	})
+	fmt.Fprintf(&b, "}\n")
+	if errors > 0 {
+		fmt.Fprintf(&b, "\n// decode errors: %d\n", errors)
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "freq: emit-go:", err)
+		exit(1)
+	}
+	stdout.Write(src[len(prefix):])
+}
+
+// shown reports whether an entry with the given count, out of total,
+// passes the output filters.
+func shown(count, total uint64) bool {
+	return 100*float64(count) >= threshold*float64(total) && count >= minCount && (maxCount == 0 || count <= maxCount)
+}
+
+// printInvisible prints the counts of the characters in the invisible table.
+func printInvisible(format string) {
+	for _, e := range entries() {
+		if name, ok := invisible[e.r]; ok {
+			fmt.Fprintf(stdout, format, e.r, glyph(e.r), e.count, name)
+		}
+	}
+	printErrors()
+}
+
+func printErrors() {
+	format := formatCount
+	if probabilities && errorsInTotal {
+		t := total()
+		format = func(n uint64) string { return formatTally(n, t) }
+	}
+	if errors > 0 {
+		fmt.Fprintf(stdout, "error%s-\t%*s\n", sep(), pad, format(errors))
+	}
+	for b, count := range badBytes {
+		if count > 0 {
+			fmt.Fprintf(stdout, "error%s%.2x\t%*s\n", sep(), b, pad, format(count))
+		}
+	}
+	values := make([]rune, 0, len(surrogates))
+	for r := range surrogates {
+		values = append(values, r)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	for _, r := range values {
+		fmt.Fprintf(stdout, "surrogate%s%.4x\t%*s\n", sep(), r, pad, formatCount(surrogates[r]))
+	}
+}
+
+// classify runs command with the counted code points, formatted using
+// keyFormat, on its standard input, and prints the total count for each
+// label it reports, in label order. Code points the command does not
+// mention are grouped as "unclassified".
+func classify(command, keyFormat string) error {
+	var keys bytes.Buffer
+	counts.Do(func(r rune, count uint64) {
+		fmt.Fprintf(&keys, keyFormat+"\n", r)
+	})
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Stdin = &keys
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return err
+	}
+	labels := make(map[rune]string)
+	for _, line := range strings.Split(string(out), "\n") {
+		if line == "" {
+			continue
+		}
+		key, label, ok := strings.Cut(line, "\t")
+		if !ok {
+			return fmt.Errorf("bad output line %q", line)
+		}
+		r, err := strconv.ParseUint(key, 16, 32)
+		if err != nil {
+			return fmt.Errorf("bad key in output line %q", line)
+		}
+		labels[rune(r)] = label
+	}
+	totals := make(map[string]uint64)
+	counts.Do(func(r rune, count uint64) {
+		label, ok := labels[r]
+		if !ok {
+			label = "unclassified"
+		}
+		totals[label] += count
+	})
+	names := make([]string, 0, len(totals))
+	for label := range totals {
+		names = append(names, label)
+	}
+	sort.Strings(names)
+	for _, label := range names {
+		fmt.Fprintf(stdout, "%s\t%d\n", label, totals[label])
+	}
+	printErrors()
+	return nil
+}
+
+// key returns the text to print in the code point column for r: its
+// value in keyFormat, or under -hash an identifier derived from it and
+// the -salt.
+func key(r rune, keyFormat string) string {
+	if !hashIDs {
+		return fmt.Sprintf(keyFormat, r)
+	}
+	m := hmac.New(sha256.New, []byte(salt))
+	binary.Write(m, binary.BigEndian, int32(r))
+	return hex.EncodeToString(m.Sum(nil)[:6])
+}
+
+// glyph returns the text to print in the character column for r.
+// Spaces and unprintable characters are shown as a dash, or as a
+// C-style escape if -cstyle is set, so no raw control bytes reach
+// the output, except NUL, which is shown as ^@ so it is not overlooked.
+// The result is in the -output-encoding. Under -tsv, the characters in
+// tsvEscapes are shown by their escapes, and under -control-pictures,
+// the C0 controls and DEL by their control pictures. Under -hash the
+// glyph is always a dash.
+func glyph(r rune) string {
+	if hashIDs {
+		return "-"
+	}
+	if controlPictures && (r < 0x20 || r == 0x7F) {
+		p := string(0x2400 + min(r, 0x21)) // DEL's picture follows those of C0.
+		if outputCharmap != nil {
+			return outputCharmap.encodeString(p)
+		}
+		return p
+	}
+	if e, ok := tsvEscapes[r]; ok && tsv {
+		return e
+	}
+	if escape {
+		if e, ok := readableEscape(r); ok {
+			return e
+		}
+	}
+	if r != ' ' && isPrint(r) {
+		if outputCharmap != nil {
+			return outputCharmap.encodeString(string(r))
+		}
+		return string(r)
+	}
+	if cstyle {
+		return cEscape(r)
+	}
+	if r == 0 {
+		return "^@"
+	}
+	return "-"
+}
+
+// tsvEscapes holds the escapes -tsv uses for characters that would
+// break or confuse the columns.
+var tsvEscapes = map[rune]string{
+	'\t': `\t`,
+	'\n': `\n`,
+	'\r': `\r`,
+	'\\': `\\`,
+}
+
+// readableEscapes holds the names -escape gives the white space and
+// invisible characters, most of them their Unicode abbreviations.
+var readableEscapes = map[rune]string{
+	'\a':   `\a`,
+	'\b':   `\b`,
+	'\t':   `\t`,
+	'\n':   `\n`,
+	'\v':   `\v`,
+	'\f':   `\f`,
+	'\r':   `\r`,
+	' ':    "SPACE",
+	0x0085: "NEL",
+	0x00A0: "NBSP",
+	0x00AD: "SHY",
+	0x1680: "OGHAM SPACE",
+	0x2000: "NQSP",
+	0x2001: "MQSP",
+	0x2002: "ENSP",
+	0x2003: "EMSP",
+	0x2004: "3/MSP",
+	0x2005: "4/MSP",
+	0x2006: "6/MSP",
+	0x2007: "FSP",
+	0x2008: "PSP",
+	0x2009: "THSP",
+	0x200A: "HSP",
+	0x200B: "ZWSP",
+	0x200C: "ZWNJ",
+	0x200D: "ZWJ",
+	0x200E: "LRM",
+	0x200F: "RLM",
+	0x2028: "LSEP",
+	0x2029: "PSEP",
+	0x202F: "NNBSP",
+	0x205F: "MMSP",
+	0x2060: "WJ",
+	0x3000: "IDSP",
+	0xFEFF: "ZWNBSP",
+}
+
+// readableEscape returns the -escape form of r: a name from
+// readableEscapes, or \xNN for the other ASCII controls. With -bytes only
+// ASCII is named, as the other bytes are not characters.
+func readableEscape(r rune) (string, bool) {
+	if countBytes && r >= 0x80 {
+		return "", false
+	}
+	if e, ok := readableEscapes[r]; ok {
+		return e, true
+	}
+	if r < 0x20 || r == 0x7F {
+		return fmt.Sprintf(`\x%.2x`, r), true
+	}
+	return "", false
+}
+
+// cEscape returns the C-style escape for r: \xNN for bytes and ASCII,
+// \uNNNN for the rest of the Basic Multilingual Plane, \UNNNNNNNN beyond.
+func cEscape(r rune) string {
+	switch {
+	case countBytes || r < 0x80:
+		return fmt.Sprintf(`\x%.2x`, r)
+	case r <= 0xFFFF:
+		return fmt.Sprintf(`\u%.4x`, r)
+	}
+	return fmt.Sprintf(`\U%.8x`, r)
+}
@@ -0,0 +1,53 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestHashHidesInput(t *testing.T) {
+	hashIDs = true
+	defer func() { hashIDs = false }()
+	text := "ξψω"
+	countText(text)
+	emojiCounts = map[string]uint64{"😀": 2, "👍🏽": 1}
+	defer clear(emojiCounts)
+	gramCounts = map[string]uint64{"ξψ": 1, "ψω": 1}
+	defer clear(gramCounts)
+	out := capture(print) + capture(printEmoji) + capture(func() { printSequences(gramCounts, 0, "ngram", "%.4x") })
+	for _, r := range text + "😀👍🏽" {
+		if strings.ContainsRune(out, r) || strings.Contains(out, fmt.Sprintf("%.4x", r)) {
+			t.Errorf("-hash output shows %q:\n%s", r, out)
+		}
+	}
+}
+
+func TestColumnsCSVCountsRaw(t *testing.T) {
+	defer func(h bool, c []string) { human, columns = h, c }(human, columns)
+	human, columns = true, []string{"code", "count", "bytes"}
+	counts = new(Counts)
+	counts.Add('a', 1500)
+	got := capture(func() { printColumnsCSV("%.4x") })
+	want := "code,count,bytes\n0061,1500,1500\n"
+	if got != want {
+		t.Errorf("-human -format=csv -columns: got %q; want %q", got, want)
+	}
+}
+
+func TestColumnsErrorRows(t *testing.T) {
+	defer func(c []string) { columns = c }(columns)
+	columns = []string{"count", "char"}
+	countText("a")
+	errors, badBytes['\xff'] = 1, 1
+	defer func() { errors, badBytes = 0, [256]uint64{} }()
+	got := capture(func() { printColumns("%.4x") })
+	want := "1\ta\n1\t-\n1\tff\n"
+	if got != want {
+		t.Errorf("-columns=count,char: got %q; want %q", got, want)
+	}
+}
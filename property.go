@@ -0,0 +1,43 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// derivedProperties holds the derived properties that the unicode
+// package does not provide directly, defined from those it does.
+var derivedProperties = map[string][]*unicode.RangeTable{
+	"Alphabetic": {unicode.L, unicode.Nl, unicode.Other_Alphabetic},
+	"Lowercase":  {unicode.Ll, unicode.Other_Lowercase},
+	"Uppercase":  {unicode.Lu, unicode.Other_Uppercase},
+	"Math":       {unicode.Sm, unicode.Other_Math},
+}
+
+// inProperty is the test for -property, or nil if it is not set.
+var inProperty func(r rune) bool
+
+// lookupProperty returns a test for the named Unicode property.
+func lookupProperty(name string) (func(r rune) bool, error) {
+	if t, ok := unicode.Properties[name]; ok {
+		return func(r rune) bool { return unicode.Is(t, r) }, nil
+	}
+	if ts, ok := derivedProperties[name]; ok {
+		return func(r rune) bool { return unicode.In(r, ts...) }, nil
+	}
+	var names []string
+	for n := range unicode.Properties {
+		names = append(names, n)
+	}
+	for n := range derivedProperties {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("unknown property %q; want one of %s", name, strings.Join(names, ", "))
+}
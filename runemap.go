@@ -0,0 +1,59 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// runeMap holds the translations loaded by -map.
+var runeMap map[rune]rune
+
+// loadMap reads a -map file. Each line holds two code points in hex,
+// optionally prefixed by U+ or 0x: a character and the one it is to be
+// counted as. Blank lines and text after # are ignored.
+func loadMap(file string) (map[rune]rune, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	m := make(map[rune]rune)
+	scan := bufio.NewScanner(f)
+	for line := 1; scan.Scan(); line++ {
+		text, _, _ := strings.Cut(scan.Text(), "#")
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: want two code points", file, line)
+		}
+		from, err1 := parseCodePoint(fields[0])
+		to, err2 := parseCodePoint(fields[1])
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("%s:%d: bad code point", file, line)
+		}
+		if countBytes && (from > 0xFF || to > 0xFF) {
+			return nil, fmt.Errorf("%s:%d: code point is not a byte", file, line)
+		}
+		m[from] = to
+	}
+	return m, scan.Err()
+}
+
+// parseCodePoint parses a hex code point such as 2014, U+2014 or 0x2014.
+func parseCodePoint(s string) (rune, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(strings.ToLower(s), "u+"), "0x")
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil || v > 0x10FFFF {
+		return 0, fmt.Errorf("bad code point %q", s)
+	}
+	return rune(v), nil
+}
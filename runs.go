@@ -0,0 +1,54 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// The runs of -longest-runs: the character of the current run and its
+// length so far, and the longest run seen of each character.
+var runs struct {
+	r       rune
+	n       uint64
+	longest map[rune]uint64
+}
+
+// addRun extends the current run with r or starts a new one.
+func addRun(r rune) {
+	if runs.longest == nil {
+		runs.longest = make(map[rune]uint64)
+	}
+	if r != runs.r || runs.n == 0 {
+		runs.r, runs.n = r, 0
+	}
+	runs.n++
+	if runs.n > runs.longest[r] {
+		runs.longest[r] = runs.n
+	}
+}
+
+// endRun ends the current run at the end of an input.
+func endRun() {
+	runs.n = 0
+}
+
+// printRuns prints the longest run of each character, longest first.
+func printRuns(keyFormat string) {
+	list := make([]entry, 0, len(runs.longest))
+	for r, n := range runs.longest {
+		list = append(list, entry{r, n})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].count != list[j].count {
+			return list[i].count > list[j].count
+		}
+		return list[i].r < list[j].r
+	})
+	for _, e := range list {
+		fmt.Fprintf(stdout, "run %s%s%s\t%d\n", key(e.r, keyFormat), sep(), glyph(e.r), e.count)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// stateVersion identifies the layout of saved counts.
+const stateVersion = 1
+
+// savedState is the form in which counts are saved, gob-encoded.
+type savedState struct {
+	Version  int
+	Bytes    bool // The counts are of bytes rather than runes.
+	Counts   map[rune]uint64
+	Errors   uint64
+	BadBytes [256]uint64
+}
+
+// loadState adds the counts saved in file to the current ones. A file
+// that does not exist holds no counts.
+func loadState(file string) error {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	t, err := decodeState(file, f)
+	if err != nil {
+		return err
+	}
+	t.add()
+	return nil
+}
+
+// decodeState reads the counts saved in r, which was read from file.
+func decodeState(file string, r io.Reader) (*tableCounts, error) {
+	var s savedState
+	if err := gob.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	if s.Version != stateVersion {
+		return nil, fmt.Errorf("%s: unknown version %d", file, s.Version)
+	}
+	if s.Bytes != countBytes {
+		return nil, fmt.Errorf("%s: saved counts are of the other mode; check -bytes", file)
+	}
+	return &tableCounts{counts: s.Counts, errors: s.Errors, badBytes: s.BadBytes}, nil
+}
+
+// saveState writes the current counts to file, replacing it atomically.
+func saveState(file string) error {
+	s := savedState{
+		Version:  stateVersion,
+		Bytes:    countBytes,
+		Counts:   make(map[rune]uint64),
+		Errors:   errors,
+		BadBytes: badBytes,
+	}
+	counts.Do(func(r rune, count uint64) {
+		s.Counts[r] = count
+	})
+	tmp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	if err := gob.NewEncoder(tmp).Encode(&s); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
+
+// lockTimeout is how long lockState waits for another freq to finish.
+const lockTimeout = 30 * time.Second
+
+// lockState prevents other instances from using file by creating
+// file.lock, waiting while it already exists. It returns a function
+// that removes the lock.
+func lockState(file string) (unlock func(), err error) {
+	lock := file + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		f, err := os.OpenFile(lock, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			fmt.Fprintf(f, "%d\n", os.Getpid())
+			f.Close()
+			return func() { os.Remove(lock) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%s is held by another freq; remove it if none is running", lock)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,9589 @@
+// Code generated by maketables.go; DO NOT EDIT.
+
+package main
+
+// tablesVersion is the Unicode version the tables were generated from.
+const tablesVersion = "14.0.0"
+
+// composition maps a starter followed by a combining character to
+// their canonical composition.
+var composition = map[[2]rune]rune{
+	{0x0041, 0x0300}:   0x00C0,
+	{0x0041, 0x0301}:   0x00C1,
+	{0x0041, 0x0302}:   0x00C2,
+	{0x0041, 0x0303}:   0x00C3,
+	{0x0041, 0x0308}:   0x00C4,
+	{0x0041, 0x030A}:   0x00C5,
+	{0x0043, 0x0327}:   0x00C7,
+	{0x0045, 0x0300}:   0x00C8,
+	{0x0045, 0x0301}:   0x00C9,
+	{0x0045, 0x0302}:   0x00CA,
+	{0x0045, 0x0308}:   0x00CB,
+	{0x0049, 0x0300}:   0x00CC,
+	{0x0049, 0x0301}:   0x00CD,
+	{0x0049, 0x0302}:   0x00CE,
+	{0x0049, 0x0308}:   0x00CF,
+	{0x004E, 0x0303}:   0x00D1,
+	{0x004F, 0x0300}:   0x00D2,
+	{0x004F, 0x0301}:   0x00D3,
+	{0x004F, 0x0302}:   0x00D4,
+	{0x004F, 0x0303}:   0x00D5,
+	{0x004F, 0x0308}:   0x00D6,
+	{0x0055, 0x0300}:   0x00D9,
+	{0x0055, 0x0301}:   0x00DA,
+	{0x0055, 0x0302}:   0x00DB,
+	{0x0055, 0x0308}:   0x00DC,
+	{0x0059, 0x0301}:   0x00DD,
+	{0x0061, 0x0300}:   0x00E0,
+	{0x0061, 0x0301}:   0x00E1,
+	{0x0061, 0x0302}:   0x00E2,
+	{0x0061, 0x0303}:   0x00E3,
+	{0x0061, 0x0308}:   0x00E4,
+	{0x0061, 0x030A}:   0x00E5,
+	{0x0063, 0x0327}:   0x00E7,
+	{0x0065, 0x0300}:   0x00E8,
+	{0x0065, 0x0301}:   0x00E9,
+	{0x0065, 0x0302}:   0x00EA,
+	{0x0065, 0x0308}:   0x00EB,
+	{0x0069, 0x0300}:   0x00EC,
+	{0x0069, 0x0301}:   0x00ED,
+	{0x0069, 0x0302}:   0x00EE,
+	{0x0069, 0x0308}:   0x00EF,
+	{0x006E, 0x0303}:   0x00F1,
+	{0x006F, 0x0300}:   0x00F2,
+	{0x006F, 0x0301}:   0x00F3,
+	{0x006F, 0x0302}:   0x00F4,
+	{0x006F, 0x0303}:   0x00F5,
+	{0x006F, 0x0308}:   0x00F6,
+	{0x0075, 0x0300}:   0x00F9,
+	{0x0075, 0x0301}:   0x00FA,
+	{0x0075, 0x0302}:   0x00FB,
+	{0x0075, 0x0308}:   0x00FC,
+	{0x0079, 0x0301}:   0x00FD,
+	{0x0079, 0x0308}:   0x00FF,
+	{0x0041, 0x0304}:   0x0100,
+	{0x0061, 0x0304}:   0x0101,
+	{0x0041, 0x0306}:   0x0102,
+	{0x0061, 0x0306}:   0x0103,
+	{0x0041, 0x0328}:   0x0104,
+	{0x0061, 0x0328}:   0x0105,
+	{0x0043, 0x0301}:   0x0106,
+	{0x0063, 0x0301}:   0x0107,
+	{0x0043, 0x0302}:   0x0108,
+	{0x0063, 0x0302}:   0x0109,
+	{0x0043, 0x0307}:   0x010A,
+	{0x0063, 0x0307}:   0x010B,
+	{0x0043, 0x030C}:   0x010C,
+	{0x0063, 0x030C}:   0x010D,
+	{0x0044, 0x030C}:   0x010E,
+	{0x0064, 0x030C}:   0x010F,
+	{0x0045, 0x0304}:   0x0112,
+	{0x0065, 0x0304}:   0x0113,
+	{0x0045, 0x0306}:   0x0114,
+	{0x0065, 0x0306}:   0x0115,
+	{0x0045, 0x0307}:   0x0116,
+	{0x0065, 0x0307}:   0x0117,
+	{0x0045, 0x0328}:   0x0118,
+	{0x0065, 0x0328}:   0x0119,
+	{0x0045, 0x030C}:   0x011A,
+	{0x0065, 0x030C}:   0x011B,
+	{0x0047, 0x0302}:   0x011C,
+	{0x0067, 0x0302}:   0x011D,
+	{0x0047, 0x0306}:   0x011E,
+	{0x0067, 0x0306}:   0x011F,
+	{0x0047, 0x0307}:   0x0120,
+	{0x0067, 0x0307}:   0x0121,
+	{0x0047, 0x0327}:   0x0122,
+	{0x0067, 0x0327}:   0x0123,
+	{0x0048, 0x0302}:   0x0124,
+	{0x0068, 0x0302}:   0x0125,
+	{0x0049, 0x0303}:   0x0128,
+	{0x0069, 0x0303}:   0x0129,
+	{0x0049, 0x0304}:   0x012A,
+	{0x0069, 0x0304}:   0x012B,
+	{0x0049, 0x0306}:   0x012C,
+	{0x0069, 0x0306}:   0x012D,
+	{0x0049, 0x0328}:   0x012E,
+	{0x0069, 0x0328}:   0x012F,
+	{0x0049, 0x0307}:   0x0130,
+	{0x004A, 0x0302}:   0x0134,
+	{0x006A, 0x0302}:   0x0135,
+	{0x004B, 0x0327}:   0x0136,
+	{0x006B, 0x0327}:   0x0137,
+	{0x004C, 0x0301}:   0x0139,
+	{0x006C, 0x0301}:   0x013A,
+	{0x004C, 0x0327}:   0x013B,
+	{0x006C, 0x0327}:   0x013C,
+	{0x004C, 0x030C}:   0x013D,
+	{0x006C, 0x030C}:   0x013E,
+	{0x004E, 0x0301}:   0x0143,
+	{0x006E, 0x0301}:   0x0144,
+	{0x004E, 0x0327}:   0x0145,
+	{0x006E, 0x0327}:   0x0146,
+	{0x004E, 0x030C}:   0x0147,
+	{0x006E, 0x030C}:   0x0148,
+	{0x004F, 0x0304}:   0x014C,
+	{0x006F, 0x0304}:   0x014D,
+	{0x004F, 0x0306}:   0x014E,
+	{0x006F, 0x0306}:   0x014F,
+	{0x004F, 0x030B}:   0x0150,
+	{0x006F, 0x030B}:   0x0151,
+	{0x0052, 0x0301}:   0x0154,
+	{0x0072, 0x0301}:   0x0155,
+	{0x0052, 0x0327}:   0x0156,
+	{0x0072, 0x0327}:   0x0157,
+	{0x0052, 0x030C}:   0x0158,
+	{0x0072, 0x030C}:   0x0159,
+	{0x0053, 0x0301}:   0x015A,
+	{0x0073, 0x0301}:   0x015B,
+	{0x0053, 0x0302}:   0x015C,
+	{0x0073, 0x0302}:   0x015D,
+	{0x0053, 0x0327}:   0x015E,
+	{0x0073, 0x0327}:   0x015F,
+	{0x0053, 0x030C}:   0x0160,
+	{0x0073, 0x030C}:   0x0161,
+	{0x0054, 0x0327}:   0x0162,
+	{0x0074, 0x0327}:   0x0163,
+	{0x0054, 0x030C}:   0x0164,
+	{0x0074, 0x030C}:   0x0165,
+	{0x0055, 0x0303}:   0x0168,
+	{0x0075, 0x0303}:   0x0169,
+	{0x0055, 0x0304}:   0x016A,
+	{0x0075, 0x0304}:   0x016B,
+	{0x0055, 0x0306}:   0x016C,
+	{0x0075, 0x0306}:   0x016D,
+	{0x0055, 0x030A}:   0x016E,
+	{0x0075, 0x030A}:   0x016F,
+	{0x0055, 0x030B}:   0x0170,
+	{0x0075, 0x030B}:   0x0171,
+	{0x0055, 0x0328}:   0x0172,
+	{0x0075, 0x0328}:   0x0173,
+	{0x0057, 0x0302}:   0x0174,
+	{0x0077, 0x0302}:   0x0175,
+	{0x0059, 0x0302}:   0x0176,
+	{0x0079, 0x0302}:   0x0177,
+	{0x0059, 0x0308}:   0x0178,
+	{0x005A, 0x0301}:   0x0179,
+	{0x007A, 0x0301}:   0x017A,
+	{0x005A, 0x0307}:   0x017B,
+	{0x007A, 0x0307}:   0x017C,
+	{0x005A, 0x030C}:   0x017D,
+	{0x007A, 0x030C}:   0x017E,
+	{0x004F, 0x031B}:   0x01A0,
+	{0x006F, 0x031B}:   0x01A1,
+	{0x0055, 0x031B}:   0x01AF,
+	{0x0075, 0x031B}:   0x01B0,
+	{0x0041, 0x030C}:   0x01CD,
+	{0x0061, 0x030C}:   0x01CE,
+	{0x0049, 0x030C}:   0x01CF,
+	{0x0069, 0x030C}:   0x01D0,
+	{0x004F, 0x030C}:   0x01D1,
+	{0x006F, 0x030C}:   0x01D2,
+	{0x0055, 0x030C}:   0x01D3,
+	{0x0075, 0x030C}:   0x01D4,
+	{0x00DC, 0x0304}:   0x01D5,
+	{0x00FC, 0x0304}:   0x01D6,
+	{0x00DC, 0x0301}:   0x01D7,
+	{0x00FC, 0x0301}:   0x01D8,
+	{0x00DC, 0x030C}:   0x01D9,
+	{0x00FC, 0x030C}:   0x01DA,
+	{0x00DC, 0x0300}:   0x01DB,
+	{0x00FC, 0x0300}:   0x01DC,
+	{0x00C4, 0x0304}:   0x01DE,
+	{0x00E4, 0x0304}:   0x01DF,
+	{0x0226, 0x0304}:   0x01E0,
+	{0x0227, 0x0304}:   0x01E1,
+	{0x00C6, 0x0304}:   0x01E2,
+	{0x00E6, 0x0304}:   0x01E3,
+	{0x0047, 0x030C}:   0x01E6,
+	{0x0067, 0x030C}:   0x01E7,
+	{0x004B, 0x030C}:   0x01E8,
+	{0x006B, 0x030C}:   0x01E9,
+	{0x004F, 0x0328}:   0x01EA,
+	{0x006F, 0x0328}:   0x01EB,
+	{0x01EA, 0x0304}:   0x01EC,
+	{0x01EB, 0x0304}:   0x01ED,
+	{0x01B7, 0x030C}:   0x01EE,
+	{0x0292, 0x030C}:   0x01EF,
+	{0x006A, 0x030C}:   0x01F0,
+	{0x0047, 0x0301}:   0x01F4,
+	{0x0067, 0x0301}:   0x01F5,
+	{0x004E, 0x0300}:   0x01F8,
+	{0x006E, 0x0300}:   0x01F9,
+	{0x00C5, 0x0301}:   0x01FA,
+	{0x00E5, 0x0301}:   0x01FB,
+	{0x00C6, 0x0301}:   0x01FC,
+	{0x00E6, 0x0301}:   0x01FD,
+	{0x00D8, 0x0301}:   0x01FE,
+	{0x00F8, 0x0301}:   0x01FF,
+	{0x0041, 0x030F}:   0x0200,
+	{0x0061, 0x030F}:   0x0201,
+	{0x0041, 0x0311}:   0x0202,
+	{0x0061, 0x0311}:   0x0203,
+	{0x0045, 0x030F}:   0x0204,
+	{0x0065, 0x030F}:   0x0205,
+	{0x0045, 0x0311}:   0x0206,
+	{0x0065, 0x0311}:   0x0207,
+	{0x0049, 0x030F}:   0x0208,
+	{0x0069, 0x030F}:   0x0209,
+	{0x0049, 0x0311}:   0x020A,
+	{0x0069, 0x0311}:   0x020B,
+	{0x004F, 0x030F}:   0x020C,
+	{0x006F, 0x030F}:   0x020D,
+	{0x004F, 0x0311}:   0x020E,
+	{0x006F, 0x0311}:   0x020F,
+	{0x0052, 0x030F}:   0x0210,
+	{0x0072, 0x030F}:   0x0211,
+	{0x0052, 0x0311}:   0x0212,
+	{0x0072, 0x0311}:   0x0213,
+	{0x0055, 0x030F}:   0x0214,
+	{0x0075, 0x030F}:   0x0215,
+	{0x0055, 0x0311}:   0x0216,
+	{0x0075, 0x0311}:   0x0217,
+	{0x0053, 0x0326}:   0x0218,
+	{0x0073, 0x0326}:   0x0219,
+	{0x0054, 0x0326}:   0x021A,
+	{0x0074, 0x0326}:   0x021B,
+	{0x0048, 0x030C}:   0x021E,
+	{0x0068, 0x030C}:   0x021F,
+	{0x0041, 0x0307}:   0x0226,
+	{0x0061, 0x0307}:   0x0227,
+	{0x0045, 0x0327}:   0x0228,
+	{0x0065, 0x0327}:   0x0229,
+	{0x00D6, 0x0304}:   0x022A,
+	{0x00F6, 0x0304}:   0x022B,
+	{0x00D5, 0x0304}:   0x022C,
+	{0x00F5, 0x0304}:   0x022D,
+	{0x004F, 0x0307}:   0x022E,
+	{0x006F, 0x0307}:   0x022F,
+	{0x022E, 0x0304}:   0x0230,
+	{0x022F, 0x0304}:   0x0231,
+	{0x0059, 0x0304}:   0x0232,
+	{0x0079, 0x0304}:   0x0233,
+	{0x00A8, 0x0301}:   0x0385,
+	{0x0391, 0x0301}:   0x0386,
+	{0x0395, 0x0301}:   0x0388,
+	{0x0397, 0x0301}:   0x0389,
+	{0x0399, 0x0301}:   0x038A,
+	{0x039F, 0x0301}:   0x038C,
+	{0x03A5, 0x0301}:   0x038E,
+	{0x03A9, 0x0301}:   0x038F,
+	{0x03CA, 0x0301}:   0x0390,
+	{0x0399, 0x0308}:   0x03AA,
+	{0x03A5, 0x0308}:   0x03AB,
+	{0x03B1, 0x0301}:   0x03AC,
+	{0x03B5, 0x0301}:   0x03AD,
+	{0x03B7, 0x0301}:   0x03AE,
+	{0x03B9, 0x0301}:   0x03AF,
+	{0x03CB, 0x0301}:   0x03B0,
+	{0x03B9, 0x0308}:   0x03CA,
+	{0x03C5, 0x0308}:   0x03CB,
+	{0x03BF, 0x0301}:   0x03CC,
+	{0x03C5, 0x0301}:   0x03CD,
+	{0x03C9, 0x0301}:   0x03CE,
+	{0x03D2, 0x0301}:   0x03D3,
+	{0x03D2, 0x0308}:   0x03D4,
+	{0x0415, 0x0300}:   0x0400,
+	{0x0415, 0x0308}:   0x0401,
+	{0x0413, 0x0301}:   0x0403,
+	{0x0406, 0x0308}:   0x0407,
+	{0x041A, 0x0301}:   0x040C,
+	{0x0418, 0x0300}:   0x040D,
+	{0x0423, 0x0306}:   0x040E,
+	{0x0418, 0x0306}:   0x0419,
+	{0x0438, 0x0306}:   0x0439,
+	{0x0435, 0x0300}:   0x0450,
+	{0x0435, 0x0308}:   0x0451,
+	{0x0433, 0x0301}:   0x0453,
+	{0x0456, 0x0308}:   0x0457,
+	{0x043A, 0x0301}:   0x045C,
+	{0x0438, 0x0300}:   0x045D,
+	{0x0443, 0x0306}:   0x045E,
+	{0x0474, 0x030F}:   0x0476,
+	{0x0475, 0x030F}:   0x0477,
+	{0x0416, 0x0306}:   0x04C1,
+	{0x0436, 0x0306}:   0x04C2,
+	{0x0410, 0x0306}:   0x04D0,
+	{0x0430, 0x0306}:   0x04D1,
+	{0x0410, 0x0308}:   0x04D2,
+	{0x0430, 0x0308}:   0x04D3,
+	{0x0415, 0x0306}:   0x04D6,
+	{0x0435, 0x0306}:   0x04D7,
+	{0x04D8, 0x0308}:   0x04DA,
+	{0x04D9, 0x0308}:   0x04DB,
+	{0x0416, 0x0308}:   0x04DC,
+	{0x0436, 0x0308}:   0x04DD,
+	{0x0417, 0x0308}:   0x04DE,
+	{0x0437, 0x0308}:   0x04DF,
+	{0x0418, 0x0304}:   0x04E2,
+	{0x0438, 0x0304}:   0x04E3,
+	{0x0418, 0x0308}:   0x04E4,
+	{0x0438, 0x0308}:   0x04E5,
+	{0x041E, 0x0308}:   0x04E6,
+	{0x043E, 0x0308}:   0x04E7,
+	{0x04E8, 0x0308}:   0x04EA,
+	{0x04E9, 0x0308}:   0x04EB,
+	{0x042D, 0x0308}:   0x04EC,
+	{0x044D, 0x0308}:   0x04ED,
+	{0x0423, 0x0304}:   0x04EE,
+	{0x0443, 0x0304}:   0x04EF,
+	{0x0423, 0x0308}:   0x04F0,
+	{0x0443, 0x0308}:   0x04F1,
+	{0x0423, 0x030B}:   0x04F2,
+	{0x0443, 0x030B}:   0x04F3,
+	{0x0427, 0x0308}:   0x04F4,
+	{0x0447, 0x0308}:   0x04F5,
+	{0x042B, 0x0308}:   0x04F8,
+	{0x044B, 0x0308}:   0x04F9,
+	{0x0627, 0x0653}:   0x0622,
+	{0x0627, 0x0654}:   0x0623,
+	{0x0648, 0x0654}:   0x0624,
+	{0x0627, 0x0655}:   0x0625,
+	{0x064A, 0x0654}:   0x0626,
+	{0x06D5, 0x0654}:   0x06C0,
+	{0x06C1, 0x0654}:   0x06C2,
+	{0x06D2, 0x0654}:   0x06D3,
+	{0x0928, 0x093C}:   0x0929,
+	{0x0930, 0x093C}:   0x0931,
+	{0x0933, 0x093C}:   0x0934,
+	{0x09C7, 0x09BE}:   0x09CB,
+	{0x09C7, 0x09D7}:   0x09CC,
+	{0x0B47, 0x0B56}:   0x0B48,
+	{0x0B47, 0x0B3E}:   0x0B4B,
+	{0x0B47, 0x0B57}:   0x0B4C,
+	{0x0B92, 0x0BD7}:   0x0B94,
+	{0x0BC6, 0x0BBE}:   0x0BCA,
+	{0x0BC7, 0x0BBE}:   0x0BCB,
+	{0x0BC6, 0x0BD7}:   0x0BCC,
+	{0x0C46, 0x0C56}:   0x0C48,
+	{0x0CBF, 0x0CD5}:   0x0CC0,
+	{0x0CC6, 0x0CD5}:   0x0CC7,
+	{0x0CC6, 0x0CD6}:   0x0CC8,
+	{0x0CC6, 0x0CC2}:   0x0CCA,
+	{0x0CCA, 0x0CD5}:   0x0CCB,
+	{0x0D46, 0x0D3E}:   0x0D4A,
+	{0x0D47, 0x0D3E}:   0x0D4B,
+	{0x0D46, 0x0D57}:   0x0D4C,
+	{0x0DD9, 0x0DCA}:   0x0DDA,
+	{0x0DD9, 0x0DCF}:   0x0DDC,
+	{0x0DDC, 0x0DCA}:   0x0DDD,
+	{0x0DD9, 0x0DDF}:   0x0DDE,
+	{0x1025, 0x102E}:   0x1026,
+	{0x1B05, 0x1B35}:   0x1B06,
+	{0x1B07, 0x1B35}:   0x1B08,
+	{0x1B09, 0x1B35}:   0x1B0A,
+	{0x1B0B, 0x1B35}:   0x1B0C,
+	{0x1B0D, 0x1B35}:   0x1B0E,
+	{0x1B11, 0x1B35}:   0x1B12,
+	{0x1B3A, 0x1B35}:   0x1B3B,
+	{0x1B3C, 0x1B35}:   0x1B3D,
+	{0x1B3E, 0x1B35}:   0x1B40,
+	{0x1B3F, 0x1B35}:   0x1B41,
+	{0x1B42, 0x1B35}:   0x1B43,
+	{0x0041, 0x0325}:   0x1E00,
+	{0x0061, 0x0325}:   0x1E01,
+	{0x0042, 0x0307}:   0x1E02,
+	{0x0062, 0x0307}:   0x1E03,
+	{0x0042, 0x0323}:   0x1E04,
+	{0x0062, 0x0323}:   0x1E05,
+	{0x0042, 0x0331}:   0x1E06,
+	{0x0062, 0x0331}:   0x1E07,
+	{0x00C7, 0x0301}:   0x1E08,
+	{0x00E7, 0x0301}:   0x1E09,
+	{0x0044, 0x0307}:   0x1E0A,
+	{0x0064, 0x0307}:   0x1E0B,
+	{0x0044, 0x0323}:   0x1E0C,
+	{0x0064, 0x0323}:   0x1E0D,
+	{0x0044, 0x0331}:   0x1E0E,
+	{0x0064, 0x0331}:   0x1E0F,
+	{0x0044, 0x0327}:   0x1E10,
+	{0x0064, 0x0327}:   0x1E11,
+	{0x0044, 0x032D}:   0x1E12,
+	{0x0064, 0x032D}:   0x1E13,
+	{0x0112, 0x0300}:   0x1E14,
+	{0x0113, 0x0300}:   0x1E15,
+	{0x0112, 0x0301}:   0x1E16,
+	{0x0113, 0x0301}:   0x1E17,
+	{0x0045, 0x032D}:   0x1E18,
+	{0x0065, 0x032D}:   0x1E19,
+	{0x0045, 0x0330}:   0x1E1A,
+	{0x0065, 0x0330}:   0x1E1B,
+	{0x0228, 0x0306}:   0x1E1C,
+	{0x0229, 0x0306}:   0x1E1D,
+	{0x0046, 0x0307}:   0x1E1E,
+	{0x0066, 0x0307}:   0x1E1F,
+	{0x0047, 0x0304}:   0x1E20,
+	{0x0067, 0x0304}:   0x1E21,
+	{0x0048, 0x0307}:   0x1E22,
+	{0x0068, 0x0307}:   0x1E23,
+	{0x0048, 0x0323}:   0x1E24,
+	{0x0068, 0x0323}:   0x1E25,
+	{0x0048, 0x0308}:   0x1E26,
+	{0x0068, 0x0308}:   0x1E27,
+	{0x0048, 0x0327}:   0x1E28,
+	{0x0068, 0x0327}:   0x1E29,
+	{0x0048, 0x032E}:   0x1E2A,
+	{0x0068, 0x032E}:   0x1E2B,
+	{0x0049, 0x0330}:   0x1E2C,
+	{0x0069, 0x0330}:   0x1E2D,
+	{0x00CF, 0x0301}:   0x1E2E,
+	{0x00EF, 0x0301}:   0x1E2F,
+	{0x004B, 0x0301}:   0x1E30,
+	{0x006B, 0x0301}:   0x1E31,
+	{0x004B, 0x0323}:   0x1E32,
+	{0x006B, 0x0323}:   0x1E33,
+	{0x004B, 0x0331}:   0x1E34,
+	{0x006B, 0x0331}:   0x1E35,
+	{0x004C, 0x0323}:   0x1E36,
+	{0x006C, 0x0323}:   0x1E37,
+	{0x1E36, 0x0304}:   0x1E38,
+	{0x1E37, 0x0304}:   0x1E39,
+	{0x004C, 0x0331}:   0x1E3A,
+	{0x006C, 0x0331}:   0x1E3B,
+	{0x004C, 0x032D}:   0x1E3C,
+	{0x006C, 0x032D}:   0x1E3D,
+	{0x004D, 0x0301}:   0x1E3E,
+	{0x006D, 0x0301}:   0x1E3F,
+	{0x004D, 0x0307}:   0x1E40,
+	{0x006D, 0x0307}:   0x1E41,
+	{0x004D, 0x0323}:   0x1E42,
+	{0x006D, 0x0323}:   0x1E43,
+	{0x004E, 0x0307}:   0x1E44,
+	{0x006E, 0x0307}:   0x1E45,
+	{0x004E, 0x0323}:   0x1E46,
+	{0x006E, 0x0323}:   0x1E47,
+	{0x004E, 0x0331}:   0x1E48,
+	{0x006E, 0x0331}:   0x1E49,
+	{0x004E, 0x032D}:   0x1E4A,
+	{0x006E, 0x032D}:   0x1E4B,
+	{0x00D5, 0x0301}:   0x1E4C,
+	{0x00F5, 0x0301}:   0x1E4D,
+	{0x00D5, 0x0308}:   0x1E4E,
+	{0x00F5, 0x0308}:   0x1E4F,
+	{0x014C, 0x0300}:   0x1E50,
+	{0x014D, 0x0300}:   0x1E51,
+	{0x014C, 0x0301}:   0x1E52,
+	{0x014D, 0x0301}:   0x1E53,
+	{0x0050, 0x0301}:   0x1E54,
+	{0x0070, 0x0301}:   0x1E55,
+	{0x0050, 0x0307}:   0x1E56,
+	{0x0070, 0x0307}:   0x1E57,
+	{0x0052, 0x0307}:   0x1E58,
+	{0x0072, 0x0307}:   0x1E59,
+	{0x0052, 0x0323}:   0x1E5A,
+	{0x0072, 0x0323}:   0x1E5B,
+	{0x1E5A, 0x0304}:   0x1E5C,
+	{0x1E5B, 0x0304}:   0x1E5D,
+	{0x0052, 0x0331}:   0x1E5E,
+	{0x0072, 0x0331}:   0x1E5F,
+	{0x0053, 0x0307}:   0x1E60,
+	{0x0073, 0x0307}:   0x1E61,
+	{0x0053, 0x0323}:   0x1E62,
+	{0x0073, 0x0323}:   0x1E63,
+	{0x015A, 0x0307}:   0x1E64,
+	{0x015B, 0x0307}:   0x1E65,
+	{0x0160, 0x0307}:   0x1E66,
+	{0x0161, 0x0307}:   0x1E67,
+	{0x1E62, 0x0307}:   0x1E68,
+	{0x1E63, 0x0307}:   0x1E69,
+	{0x0054, 0x0307}:   0x1E6A,
+	{0x0074, 0x0307}:   0x1E6B,
+	{0x0054, 0x0323}:   0x1E6C,
+	{0x0074, 0x0323}:   0x1E6D,
+	{0x0054, 0x0331}:   0x1E6E,
+	{0x0074, 0x0331}:   0x1E6F,
+	{0x0054, 0x032D}:   0x1E70,
+	{0x0074, 0x032D}:   0x1E71,
+	{0x0055, 0x0324}:   0x1E72,
+	{0x0075, 0x0324}:   0x1E73,
+	{0x0055, 0x0330}:   0x1E74,
+	{0x0075, 0x0330}:   0x1E75,
+	{0x0055, 0x032D}:   0x1E76,
+	{0x0075, 0x032D}:   0x1E77,
+	{0x0168, 0x0301}:   0x1E78,
+	{0x0169, 0x0301}:   0x1E79,
+	{0x016A, 0x0308}:   0x1E7A,
+	{0x016B, 0x0308}:   0x1E7B,
+	{0x0056, 0x0303}:   0x1E7C,
+	{0x0076, 0x0303}:   0x1E7D,
+	{0x0056, 0x0323}:   0x1E7E,
+	{0x0076, 0x0323}:   0x1E7F,
+	{0x0057, 0x0300}:   0x1E80,
+	{0x0077, 0x0300}:   0x1E81,
+	{0x0057, 0x0301}:   0x1E82,
+	{0x0077, 0x0301}:   0x1E83,
+	{0x0057, 0x0308}:   0x1E84,
+	{0x0077, 0x0308}:   0x1E85,
+	{0x0057, 0x0307}:   0x1E86,
+	{0x0077, 0x0307}:   0x1E87,
+	{0x0057, 0x0323}:   0x1E88,
+	{0x0077, 0x0323}:   0x1E89,
+	{0x0058, 0x0307}:   0x1E8A,
+	{0x0078, 0x0307}:   0x1E8B,
+	{0x0058, 0x0308}:   0x1E8C,
+	{0x0078, 0x0308}:   0x1E8D,
+	{0x0059, 0x0307}:   0x1E8E,
+	{0x0079, 0x0307}:   0x1E8F,
+	{0x005A, 0x0302}:   0x1E90,
+	{0x007A, 0x0302}:   0x1E91,
+	{0x005A, 0x0323}:   0x1E92,
+	{0x007A, 0x0323}:   0x1E93,
+	{0x005A, 0x0331}:   0x1E94,
+	{0x007A, 0x0331}:   0x1E95,
+	{0x0068, 0x0331}:   0x1E96,
+	{0x0074, 0x0308}:   0x1E97,
+	{0x0077, 0x030A}:   0x1E98,
+	{0x0079, 0x030A}:   0x1E99,
+	{0x017F, 0x0307}:   0x1E9B,
+	{0x0041, 0x0323}:   0x1EA0,
+	{0x0061, 0x0323}:   0x1EA1,
+	{0x0041, 0x0309}:   0x1EA2,
+	{0x0061, 0x0309}:   0x1EA3,
+	{0x00C2, 0x0301}:   0x1EA4,
+	{0x00E2, 0x0301}:   0x1EA5,
+	{0x00C2, 0x0300}:   0x1EA6,
+	{0x00E2, 0x0300}:   0x1EA7,
+	{0x00C2, 0x0309}:   0x1EA8,
+	{0x00E2, 0x0309}:   0x1EA9,
+	{0x00C2, 0x0303}:   0x1EAA,
+	{0x00E2, 0x0303}:   0x1EAB,
+	{0x1EA0, 0x0302}:   0x1EAC,
+	{0x1EA1, 0x0302}:   0x1EAD,
+	{0x0102, 0x0301}:   0x1EAE,
+	{0x0103, 0x0301}:   0x1EAF,
+	{0x0102, 0x0300}:   0x1EB0,
+	{0x0103, 0x0300}:   0x1EB1,
+	{0x0102, 0x0309}:   0x1EB2,
+	{0x0103, 0x0309}:   0x1EB3,
+	{0x0102, 0x0303}:   0x1EB4,
+	{0x0103, 0x0303}:   0x1EB5,
+	{0x1EA0, 0x0306}:   0x1EB6,
+	{0x1EA1, 0x0306}:   0x1EB7,
+	{0x0045, 0x0323}:   0x1EB8,
+	{0x0065, 0x0323}:   0x1EB9,
+	{0x0045, 0x0309}:   0x1EBA,
+	{0x0065, 0x0309}:   0x1EBB,
+	{0x0045, 0x0303}:   0x1EBC,
+	{0x0065, 0x0303}:   0x1EBD,
+	{0x00CA, 0x0301}:   0x1EBE,
+	{0x00EA, 0x0301}:   0x1EBF,
+	{0x00CA, 0x0300}:   0x1EC0,
+	{0x00EA, 0x0300}:   0x1EC1,
+	{0x00CA, 0x0309}:   0x1EC2,
+	{0x00EA, 0x0309}:   0x1EC3,
+	{0x00CA, 0x0303}:   0x1EC4,
+	{0x00EA, 0x0303}:   0x1EC5,
+	{0x1EB8, 0x0302}:   0x1EC6,
+	{0x1EB9, 0x0302}:   0x1EC7,
+	{0x0049, 0x0309}:   0x1EC8,
+	{0x0069, 0x0309}:   0x1EC9,
+	{0x0049, 0x0323}:   0x1ECA,
+	{0x0069, 0x0323}:   0x1ECB,
+	{0x004F, 0x0323}:   0x1ECC,
+	{0x006F, 0x0323}:   0x1ECD,
+	{0x004F, 0x0309}:   0x1ECE,
+	{0x006F, 0x0309}:   0x1ECF,
+	{0x00D4, 0x0301}:   0x1ED0,
+	{0x00F4, 0x0301}:   0x1ED1,
+	{0x00D4, 0x0300}:   0x1ED2,
+	{0x00F4, 0x0300}:   0x1ED3,
+	{0x00D4, 0x0309}:   0x1ED4,
+	{0x00F4, 0x0309}:   0x1ED5,
+	{0x00D4, 0x0303}:   0x1ED6,
+	{0x00F4, 0x0303}:   0x1ED7,
+	{0x1ECC, 0x0302}:   0x1ED8,
+	{0x1ECD, 0x0302}:   0x1ED9,
+	{0x01A0, 0x0301}:   0x1EDA,
+	{0x01A1, 0x0301}:   0x1EDB,
+	{0x01A0, 0x0300}:   0x1EDC,
+	{0x01A1, 0x0300}:   0x1EDD,
+	{0x01A0, 0x0309}:   0x1EDE,
+	{0x01A1, 0x0309}:   0x1EDF,
+	{0x01A0, 0x0303}:   0x1EE0,
+	{0x01A1, 0x0303}:   0x1EE1,
+	{0x01A0, 0x0323}:   0x1EE2,
+	{0x01A1, 0x0323}:   0x1EE3,
+	{0x0055, 0x0323}:   0x1EE4,
+	{0x0075, 0x0323}:   0x1EE5,
+	{0x0055, 0x0309}:   0x1EE6,
+	{0x0075, 0x0309}:   0x1EE7,
+	{0x01AF, 0x0301}:   0x1EE8,
+	{0x01B0, 0x0301}:   0x1EE9,
+	{0x01AF, 0x0300}:   0x1EEA,
+	{0x01B0, 0x0300}:   0x1EEB,
+	{0x01AF, 0x0309}:   0x1EEC,
+	{0x01B0, 0x0309}:   0x1EED,
+	{0x01AF, 0x0303}:   0x1EEE,
+	{0x01B0, 0x0303}:   0x1EEF,
+	{0x01AF, 0x0323}:   0x1EF0,
+	{0x01B0, 0x0323}:   0x1EF1,
+	{0x0059, 0x0300}:   0x1EF2,
+	{0x0079, 0x0300}:   0x1EF3,
+	{0x0059, 0x0323}:   0x1EF4,
+	{0x0079, 0x0323}:   0x1EF5,
+	{0x0059, 0x0309}:   0x1EF6,
+	{0x0079, 0x0309}:   0x1EF7,
+	{0x0059, 0x0303}:   0x1EF8,
+	{0x0079, 0x0303}:   0x1EF9,
+	{0x03B1, 0x0313}:   0x1F00,
+	{0x03B1, 0x0314}:   0x1F01,
+	{0x1F00, 0x0300}:   0x1F02,
+	{0x1F01, 0x0300}:   0x1F03,
+	{0x1F00, 0x0301}:   0x1F04,
+	{0x1F01, 0x0301}:   0x1F05,
+	{0x1F00, 0x0342}:   0x1F06,
+	{0x1F01, 0x0342}:   0x1F07,
+	{0x0391, 0x0313}:   0x1F08,
+	{0x0391, 0x0314}:   0x1F09,
+	{0x1F08, 0x0300}:   0x1F0A,
+	{0x1F09, 0x0300}:   0x1F0B,
+	{0x1F08, 0x0301}:   0x1F0C,
+	{0x1F09, 0x0301}:   0x1F0D,
+	{0x1F08, 0x0342}:   0x1F0E,
+	{0x1F09, 0x0342}:   0x1F0F,
+	{0x03B5, 0x0313}:   0x1F10,
+	{0x03B5, 0x0314}:   0x1F11,
+	{0x1F10, 0x0300}:   0x1F12,
+	{0x1F11, 0x0300}:   0x1F13,
+	{0x1F10, 0x0301}:   0x1F14,
+	{0x1F11, 0x0301}:   0x1F15,
+	{0x0395, 0x0313}:   0x1F18,
+	{0x0395, 0x0314}:   0x1F19,
+	{0x1F18, 0x0300}:   0x1F1A,
+	{0x1F19, 0x0300}:   0x1F1B,
+	{0x1F18, 0x0301}:   0x1F1C,
+	{0x1F19, 0x0301}:   0x1F1D,
+	{0x03B7, 0x0313}:   0x1F20,
+	{0x03B7, 0x0314}:   0x1F21,
+	{0x1F20, 0x0300}:   0x1F22,
+	{0x1F21, 0x0300}:   0x1F23,
+	{0x1F20, 0x0301}:   0x1F24,
+	{0x1F21, 0x0301}:   0x1F25,
+	{0x1F20, 0x0342}:   0x1F26,
+	{0x1F21, 0x0342}:   0x1F27,
+	{0x0397, 0x0313}:   0x1F28,
+	{0x0397, 0x0314}:   0x1F29,
+	{0x1F28, 0x0300}:   0x1F2A,
+	{0x1F29, 0x0300}:   0x1F2B,
+	{0x1F28, 0x0301}:   0x1F2C,
+	{0x1F29, 0x0301}:   0x1F2D,
+	{0x1F28, 0x0342}:   0x1F2E,
+	{0x1F29, 0x0342}:   0x1F2F,
+	{0x03B9, 0x0313}:   0x1F30,
+	{0x03B9, 0x0314}:   0x1F31,
+	{0x1F30, 0x0300}:   0x1F32,
+	{0x1F31, 0x0300}:   0x1F33,
+	{0x1F30, 0x0301}:   0x1F34,
+	{0x1F31, 0x0301}:   0x1F35,
+	{0x1F30, 0x0342}:   0x1F36,
+	{0x1F31, 0x0342}:   0x1F37,
+	{0x0399, 0x0313}:   0x1F38,
+	{0x0399, 0x0314}:   0x1F39,
+	{0x1F38, 0x0300}:   0x1F3A,
+	{0x1F39, 0x0300}:   0x1F3B,
+	{0x1F38, 0x0301}:   0x1F3C,
+	{0x1F39, 0x0301}:   0x1F3D,
+	{0x1F38, 0x0342}:   0x1F3E,
+	{0x1F39, 0x0342}:   0x1F3F,
+	{0x03BF, 0x0313}:   0x1F40,
+	{0x03BF, 0x0314}:   0x1F41,
+	{0x1F40, 0x0300}:   0x1F42,
+	{0x1F41, 0x0300}:   0x1F43,
+	{0x1F40, 0x0301}:   0x1F44,
+	{0x1F41, 0x0301}:   0x1F45,
+	{0x039F, 0x0313}:   0x1F48,
+	{0x039F, 0x0314}:   0x1F49,
+	{0x1F48, 0x0300}:   0x1F4A,
+	{0x1F49, 0x0300}:   0x1F4B,
+	{0x1F48, 0x0301}:   0x1F4C,
+	{0x1F49, 0x0301}:   0x1F4D,
+	{0x03C5, 0x0313}:   0x1F50,
+	{0x03C5, 0x0314}:   0x1F51,
+	{0x1F50, 0x0300}:   0x1F52,
+	{0x1F51, 0x0300}:   0x1F53,
+	{0x1F50, 0x0301}:   0x1F54,
+	{0x1F51, 0x0301}:   0x1F55,
+	{0x1F50, 0x0342}:   0x1F56,
+	{0x1F51, 0x0342}:   0x1F57,
+	{0x03A5, 0x0314}:   0x1F59,
+	{0x1F59, 0x0300}:   0x1F5B,
+	{0x1F59, 0x0301}:   0x1F5D,
+	{0x1F59, 0x0342}:   0x1F5F,
+	{0x03C9, 0x0313}:   0x1F60,
+	{0x03C9, 0x0314}:   0x1F61,
+	{0x1F60, 0x0300}:   0x1F62,
+	{0x1F61, 0x0300}:   0x1F63,
+	{0x1F60, 0x0301}:   0x1F64,
+	{0x1F61, 0x0301}:   0x1F65,
+	{0x1F60, 0x0342}:   0x1F66,
+	{0x1F61, 0x0342}:   0x1F67,
+	{0x03A9, 0x0313}:   0x1F68,
+	{0x03A9, 0x0314}:   0x1F69,
+	{0x1F68, 0x0300}:   0x1F6A,
+	{0x1F69, 0x0300}:   0x1F6B,
+	{0x1F68, 0x0301}:   0x1F6C,
+	{0x1F69, 0x0301}:   0x1F6D,
+	{0x1F68, 0x0342}:   0x1F6E,
+	{0x1F69, 0x0342}:   0x1F6F,
+	{0x03B1, 0x0300}:   0x1F70,
+	{0x03B5, 0x0300}:   0x1F72,
+	{0x03B7, 0x0300}:   0x1F74,
+	{0x03B9, 0x0300}:   0x1F76,
+	{0x03BF, 0x0300}:   0x1F78,
+	{0x03C5, 0x0300}:   0x1F7A,
+	{0x03C9, 0x0300}:   0x1F7C,
+	{0x1F00, 0x0345}:   0x1F80,
+	{0x1F01, 0x0345}:   0x1F81,
+	{0x1F02, 0x0345}:   0x1F82,
+	{0x1F03, 0x0345}:   0x1F83,
+	{0x1F04, 0x0345}:   0x1F84,
+	{0x1F05, 0x0345}:   0x1F85,
+	{0x1F06, 0x0345}:   0x1F86,
+	{0x1F07, 0x0345}:   0x1F87,
+	{0x1F08, 0x0345}:   0x1F88,
+	{0x1F09, 0x0345}:   0x1F89,
+	{0x1F0A, 0x0345}:   0x1F8A,
+	{0x1F0B, 0x0345}:   0x1F8B,
+	{0x1F0C, 0x0345}:   0x1F8C,
+	{0x1F0D, 0x0345}:   0x1F8D,
+	{0x1F0E, 0x0345}:   0x1F8E,
+	{0x1F0F, 0x0345}:   0x1F8F,
+	{0x1F20, 0x0345}:   0x1F90,
+	{0x1F21, 0x0345}:   0x1F91,
+	{0x1F22, 0x0345}:   0x1F92,
+	{0x1F23, 0x0345}:   0x1F93,
+	{0x1F24, 0x0345}:   0x1F94,
+	{0x1F25, 0x0345}:   0x1F95,
+	{0x1F26, 0x0345}:   0x1F96,
+	{0x1F27, 0x0345}:   0x1F97,
+	{0x1F28, 0x0345}:   0x1F98,
+	{0x1F29, 0x0345}:   0x1F99,
+	{0x1F2A, 0x0345}:   0x1F9A,
+	{0x1F2B, 0x0345}:   0x1F9B,
+	{0x1F2C, 0x0345}:   0x1F9C,
+	{0x1F2D, 0x0345}:   0x1F9D,
+	{0x1F2E, 0x0345}:   0x1F9E,
+	{0x1F2F, 0x0345}:   0x1F9F,
+	{0x1F60, 0x0345}:   0x1FA0,
+	{0x1F61, 0x0345}:   0x1FA1,
+	{0x1F62, 0x0345}:   0x1FA2,
+	{0x1F63, 0x0345}:   0x1FA3,
+	{0x1F64, 0x0345}:   0x1FA4,
+	{0x1F65, 0x0345}:   0x1FA5,
+	{0x1F66, 0x0345}:   0x1FA6,
+	{0x1F67, 0x0345}:   0x1FA7,
+	{0x1F68, 0x0345}:   0x1FA8,
+	{0x1F69, 0x0345}:   0x1FA9,
+	{0x1F6A, 0x0345}:   0x1FAA,
+	{0x1F6B, 0x0345}:   0x1FAB,
+	{0x1F6C, 0x0345}:   0x1FAC,
+	{0x1F6D, 0x0345}:   0x1FAD,
+	{0x1F6E, 0x0345}:   0x1FAE,
+	{0x1F6F, 0x0345}:   0x1FAF,
+	{0x03B1, 0x0306}:   0x1FB0,
+	{0x03B1, 0x0304}:   0x1FB1,
+	{0x1F70, 0x0345}:   0x1FB2,
+	{0x03B1, 0x0345}:   0x1FB3,
+	{0x03AC, 0x0345}:   0x1FB4,
+	{0x03B1, 0x0342}:   0x1FB6,
+	{0x1FB6, 0x0345}:   0x1FB7,
+	{0x0391, 0x0306}:   0x1FB8,
+	{0x0391, 0x0304}:   0x1FB9,
+	{0x0391, 0x0300}:   0x1FBA,
+	{0x0391, 0x0345}:   0x1FBC,
+	{0x00A8, 0x0342}:   0x1FC1,
+	{0x1F74, 0x0345}:   0x1FC2,
+	{0x03B7, 0x0345}:   0x1FC3,
+	{0x03AE, 0x0345}:   0x1FC4,
+	{0x03B7, 0x0342}:   0x1FC6,
+	{0x1FC6, 0x0345}:   0x1FC7,
+	{0x0395, 0x0300}:   0x1FC8,
+	{0x0397, 0x0300}:   0x1FCA,
+	{0x0397, 0x0345}:   0x1FCC,
+	{0x1FBF, 0x0300}:   0x1FCD,
+	{0x1FBF, 0x0301}:   0x1FCE,
+	{0x1FBF, 0x0342}:   0x1FCF,
+	{0x03B9, 0x0306}:   0x1FD0,
+	{0x03B9, 0x0304}:   0x1FD1,
+	{0x03CA, 0x0300}:   0x1FD2,
+	{0x03B9, 0x0342}:   0x1FD6,
+	{0x03CA, 0x0342}:   0x1FD7,
+	{0x0399, 0x0306}:   0x1FD8,
+	{0x0399, 0x0304}:   0x1FD9,
+	{0x0399, 0x0300}:   0x1FDA,
+	{0x1FFE, 0x0300}:   0x1FDD,
+	{0x1FFE, 0x0301}:   0x1FDE,
+	{0x1FFE, 0x0342}:   0x1FDF,
+	{0x03C5, 0x0306}:   0x1FE0,
+	{0x03C5, 0x0304}:   0x1FE1,
+	{0x03CB, 0x0300}:   0x1FE2,
+	{0x03C1, 0x0313}:   0x1FE4,
+	{0x03C1, 0x0314}:   0x1FE5,
+	{0x03C5, 0x0342}:   0x1FE6,
+	{0x03CB, 0x0342}:   0x1FE7,
+	{0x03A5, 0x0306}:   0x1FE8,
+	{0x03A5, 0x0304}:   0x1FE9,
+	{0x03A5, 0x0300}:   0x1FEA,
+	{0x03A1, 0x0314}:   0x1FEC,
+	{0x00A8, 0x0300}:   0x1FED,
+	{0x1F7C, 0x0345}:   0x1FF2,
+	{0x03C9, 0x0345}:   0x1FF3,
+	{0x03CE, 0x0345}:   0x1FF4,
+	{0x03C9, 0x0342}:   0x1FF6,
+	{0x1FF6, 0x0345}:   0x1FF7,
+	{0x039F, 0x0300}:   0x1FF8,
+	{0x03A9, 0x0300}:   0x1FFA,
+	{0x03A9, 0x0345}:   0x1FFC,
+	{0x2190, 0x0338}:   0x219A,
+	{0x2192, 0x0338}:   0x219B,
+	{0x2194, 0x0338}:   0x21AE,
+	{0x21D0, 0x0338}:   0x21CD,
+	{0x21D4, 0x0338}:   0x21CE,
+	{0x21D2, 0x0338}:   0x21CF,
+	{0x2203, 0x0338}:   0x2204,
+	{0x2208, 0x0338}:   0x2209,
+	{0x220B, 0x0338}:   0x220C,
+	{0x2223, 0x0338}:   0x2224,
+	{0x2225, 0x0338}:   0x2226,
+	{0x223C, 0x0338}:   0x2241,
+	{0x2243, 0x0338}:   0x2244,
+	{0x2245, 0x0338}:   0x2247,
+	{0x2248, 0x0338}:   0x2249,
+	{0x003D, 0x0338}:   0x2260,
+	{0x2261, 0x0338}:   0x2262,
+	{0x224D, 0x0338}:   0x226D,
+	{0x003C, 0x0338}:   0x226E,
+	{0x003E, 0x0338}:   0x226F,
+	{0x2264, 0x0338}:   0x2270,
+	{0x2265, 0x0338}:   0x2271,
+	{0x2272, 0x0338}:   0x2274,
+	{0x2273, 0x0338}:   0x2275,
+	{0x2276, 0x0338}:   0x2278,
+	{0x2277, 0x0338}:   0x2279,
+	{0x227A, 0x0338}:   0x2280,
+	{0x227B, 0x0338}:   0x2281,
+	{0x2282, 0x0338}:   0x2284,
+	{0x2283, 0x0338}:   0x2285,
+	{0x2286, 0x0338}:   0x2288,
+	{0x2287, 0x0338}:   0x2289,
+	{0x22A2, 0x0338}:   0x22AC,
+	{0x22A8, 0x0338}:   0x22AD,
+	{0x22A9, 0x0338}:   0x22AE,
+	{0x22AB, 0x0338}:   0x22AF,
+	{0x227C, 0x0338}:   0x22E0,
+	{0x227D, 0x0338}:   0x22E1,
+	{0x2291, 0x0338}:   0x22E2,
+	{0x2292, 0x0338}:   0x22E3,
+	{0x22B2, 0x0338}:   0x22EA,
+	{0x22B3, 0x0338}:   0x22EB,
+	{0x22B4, 0x0338}:   0x22EC,
+	{0x22B5, 0x0338}:   0x22ED,
+	{0x304B, 0x3099}:   0x304C,
+	{0x304D, 0x3099}:   0x304E,
+	{0x304F, 0x3099}:   0x3050,
+	{0x3051, 0x3099}:   0x3052,
+	{0x3053, 0x3099}:   0x3054,
+	{0x3055, 0x3099}:   0x3056,
+	{0x3057, 0x3099}:   0x3058,
+	{0x3059, 0x3099}:   0x305A,
+	{0x305B, 0x3099}:   0x305C,
+	{0x305D, 0x3099}:   0x305E,
+	{0x305F, 0x3099}:   0x3060,
+	{0x3061, 0x3099}:   0x3062,
+	{0x3064, 0x3099}:   0x3065,
+	{0x3066, 0x3099}:   0x3067,
+	{0x3068, 0x3099}:   0x3069,
+	{0x306F, 0x3099}:   0x3070,
+	{0x306F, 0x309A}:   0x3071,
+	{0x3072, 0x3099}:   0x3073,
+	{0x3072, 0x309A}:   0x3074,
+	{0x3075, 0x3099}:   0x3076,
+	{0x3075, 0x309A}:   0x3077,
+	{0x3078, 0x3099}:   0x3079,
+	{0x3078, 0x309A}:   0x307A,
+	{0x307B, 0x3099}:   0x307C,
+	{0x307B, 0x309A}:   0x307D,
+	{0x3046, 0x3099}:   0x3094,
+	{0x309D, 0x3099}:   0x309E,
+	{0x30AB, 0x3099}:   0x30AC,
+	{0x30AD, 0x3099}:   0x30AE,
+	{0x30AF, 0x3099}:   0x30B0,
+	{0x30B1, 0x3099}:   0x30B2,
+	{0x30B3, 0x3099}:   0x30B4,
+	{0x30B5, 0x3099}:   0x30B6,
+	{0x30B7, 0x3099}:   0x30B8,
+	{0x30B9, 0x3099}:   0x30BA,
+	{0x30BB, 0x3099}:   0x30BC,
+	{0x30BD, 0x3099}:   0x30BE,
+	{0x30BF, 0x3099}:   0x30C0,
+	{0x30C1, 0x3099}:   0x30C2,
+	{0x30C4, 0x3099}:   0x30C5,
+	{0x30C6, 0x3099}:   0x30C7,
+	{0x30C8, 0x3099}:   0x30C9,
+	{0x30CF, 0x3099}:   0x30D0,
+	{0x30CF, 0x309A}:   0x30D1,
+	{0x30D2, 0x3099}:   0x30D3,
+	{0x30D2, 0x309A}:   0x30D4,
+	{0x30D5, 0x3099}:   0x30D6,
+	{0x30D5, 0x309A}:   0x30D7,
+	{0x30D8, 0x3099}:   0x30D9,
+	{0x30D8, 0x309A}:   0x30DA,
+	{0x30DB, 0x3099}:   0x30DC,
+	{0x30DB, 0x309A}:   0x30DD,
+	{0x30A6, 0x3099}:   0x30F4,
+	{0x30EF, 0x3099}:   0x30F7,
+	{0x30F0, 0x3099}:   0x30F8,
+	{0x30F1, 0x3099}:   0x30F9,
+	{0x30F2, 0x3099}:   0x30FA,
+	{0x30FD, 0x3099}:   0x30FE,
+	{0x11099, 0x110BA}: 0x1109A,
+	{0x1109B, 0x110BA}: 0x1109C,
+	{0x110A5, 0x110BA}: 0x110AB,
+	{0x11131, 0x11127}: 0x1112E,
+	{0x11132, 0x11127}: 0x1112F,
+	{0x11347, 0x1133E}: 0x1134B,
+	{0x11347, 0x11357}: 0x1134C,
+	{0x114B9, 0x114BA}: 0x114BB,
+	{0x114B9, 0x114B0}: 0x114BC,
+	{0x114B9, 0x114BD}: 0x114BE,
+	{0x115B8, 0x115AF}: 0x115BA,
+	{0x115B9, 0x115AF}: 0x115BB,
+	{0x11935, 0x11930}: 0x11938,
+}
+
+// blocks lists the Unicode blocks in increasing order.
+var blocks = []block{
+	{0x0000, 0x007F, "Basic Latin"},
+	{0x0080, 0x00FF, "Latin-1 Supplement"},
+	{0x0100, 0x017F, "Latin Extended-A"},
+	{0x0180, 0x024F, "Latin Extended-B"},
+	{0x0250, 0x02AF, "IPA Extensions"},
+	{0x02B0, 0x02FF, "Spacing Modifier Letters"},
+	{0x0300, 0x036F, "Combining Diacritical Marks"},
+	{0x0370, 0x03FF, "Greek and Coptic"},
+	{0x0400, 0x04FF, "Cyrillic"},
+	{0x0500, 0x052F, "Cyrillic Supplement"},
+	{0x0530, 0x058F, "Armenian"},
+	{0x0590, 0x05FF, "Hebrew"},
+	{0x0600, 0x06FF, "Arabic"},
+	{0x0700, 0x074F, "Syriac"},
+	{0x0750, 0x077F, "Arabic Supplement"},
+	{0x0780, 0x07BF, "Thaana"},
+	{0x07C0, 0x07FF, "NKo"},
+	{0x0800, 0x083F, "Samaritan"},
+	{0x0840, 0x085F, "Mandaic"},
+	{0x0860, 0x086F, "Syriac Supplement"},
+	{0x0870, 0x089F, "Arabic Extended-B"},
+	{0x08A0, 0x08FF, "Arabic Extended-A"},
+	{0x0900, 0x097F, "Devanagari"},
+	{0x0980, 0x09FF, "Bengali"},
+	{0x0A00, 0x0A7F, "Gurmukhi"},
+	{0x0A80, 0x0AFF, "Gujarati"},
+	{0x0B00, 0x0B7F, "Oriya"},
+	{0x0B80, 0x0BFF, "Tamil"},
+	{0x0C00, 0x0C7F, "Telugu"},
+	{0x0C80, 0x0CFF, "Kannada"},
+	{0x0D00, 0x0D7F, "Malayalam"},
+	{0x0D80, 0x0DFF, "Sinhala"},
+	{0x0E00, 0x0E7F, "Thai"},
+	{0x0E80, 0x0EFF, "Lao"},
+	{0x0F00, 0x0FFF, "Tibetan"},
+	{0x1000, 0x109F, "Myanmar"},
+	{0x10A0, 0x10FF, "Georgian"},
+	{0x1100, 0x11FF, "Hangul Jamo"},
+	{0x1200, 0x137F, "Ethiopic"},
+	{0x1380, 0x139F, "Ethiopic Supplement"},
+	{0x13A0, 0x13FF, "Cherokee"},
+	{0x1400, 0x167F, "Unified Canadian Aboriginal Syllabics"},
+	{0x1680, 0x169F, "Ogham"},
+	{0x16A0, 0x16FF, "Runic"},
+	{0x1700, 0x171F, "Tagalog"},
+	{0x1720, 0x173F, "Hanunoo"},
+	{0x1740, 0x175F, "Buhid"},
+	{0x1760, 0x177F, "Tagbanwa"},
+	{0x1780, 0x17FF, "Khmer"},
+	{0x1800, 0x18AF, "Mongolian"},
+	{0x18B0, 0x18FF, "Unified Canadian Aboriginal Syllabics Extended"},
+	{0x1900, 0x194F, "Limbu"},
+	{0x1950, 0x197F, "Tai Le"},
+	{0x1980, 0x19DF, "New Tai Lue"},
+	{0x19E0, 0x19FF, "Khmer Symbols"},
+	{0x1A00, 0x1A1F, "Buginese"},
+	{0x1A20, 0x1AAF, "Tai Tham"},
+	{0x1AB0, 0x1AFF, "Combining Diacritical Marks Extended"},
+	{0x1B00, 0x1B7F, "Balinese"},
+	{0x1B80, 0x1BBF, "Sundanese"},
+	{0x1BC0, 0x1BFF, "Batak"},
+	{0x1C00, 0x1C4F, "Lepcha"},
+	{0x1C50, 0x1C7F, "Ol Chiki"},
+	{0x1C80, 0x1C8F, "Cyrillic Extended-C"},
+	{0x1C90, 0x1CBF, "Georgian Extended"},
+	{0x1CC0, 0x1CCF, "Sundanese Supplement"},
+	{0x1CD0, 0x1CFF, "Vedic Extensions"},
+	{0x1D00, 0x1D7F, "Phonetic Extensions"},
+	{0x1D80, 0x1DBF, "Phonetic Extensions Supplement"},
+	{0x1DC0, 0x1DFF, "Combining Diacritical Marks Supplement"},
+	{0x1E00, 0x1EFF, "Latin Extended Additional"},
+	{0x1F00, 0x1FFF, "Greek Extended"},
+	{0x2000, 0x206F, "General Punctuation"},
+	{0x2070, 0x209F, "Superscripts and Subscripts"},
+	{0x20A0, 0x20CF, "Currency Symbols"},
+	{0x20D0, 0x20FF, "Combining Diacritical Marks for Symbols"},
+	{0x2100, 0x214F, "Letterlike Symbols"},
+	{0x2150, 0x218F, "Number Forms"},
+	{0x2190, 0x21FF, "Arrows"},
+	{0x2200, 0x22FF, "Mathematical Operators"},
+	{0x2300, 0x23FF, "Miscellaneous Technical"},
+	{0x2400, 0x243F, "Control Pictures"},
+	{0x2440, 0x245F, "Optical Character Recognition"},
+	{0x2460, 0x24FF, "Enclosed Alphanumerics"},
+	{0x2500, 0x257F, "Box Drawing"},
+	{0x2580, 0x259F, "Block Elements"},
+	{0x25A0, 0x25FF, "Geometric Shapes"},
+	{0x2600, 0x26FF, "Miscellaneous Symbols"},
+	{0x2700, 0x27BF, "Dingbats"},
+	{0x27C0, 0x27EF, "Miscellaneous Mathematical Symbols-A"},
+	{0x27F0, 0x27FF, "Supplemental Arrows-A"},
+	{0x2800, 0x28FF, "Braille Patterns"},
+	{0x2900, 0x297F, "Supplemental Arrows-B"},
+	{0x2980, 0x29FF, "Miscellaneous Mathematical Symbols-B"},
+	{0x2A00, 0x2AFF, "Supplemental Mathematical Operators"},
+	{0x2B00, 0x2BFF, "Miscellaneous Symbols and Arrows"},
+	{0x2C00, 0x2C5F, "Glagolitic"},
+	{0x2C60, 0x2C7F, "Latin Extended-C"},
+	{0x2C80, 0x2CFF, "Coptic"},
+	{0x2D00, 0x2D2F, "Georgian Supplement"},
+	{0x2D30, 0x2D7F, "Tifinagh"},
+	{0x2D80, 0x2DDF, "Ethiopic Extended"},
+	{0x2DE0, 0x2DFF, "Cyrillic Extended-A"},
+	{0x2E00, 0x2E7F, "Supplemental Punctuation"},
+	{0x2E80, 0x2EFF, "CJK Radicals Supplement"},
+	{0x2F00, 0x2FDF, "Kangxi Radicals"},
+	{0x2FF0, 0x2FFF, "Ideographic Description Characters"},
+	{0x3000, 0x303F, "CJK Symbols and Punctuation"},
+	{0x3040, 0x309F, "Hiragana"},
+	{0x30A0, 0x30FF, "Katakana"},
+	{0x3100, 0x312F, "Bopomofo"},
+	{0x3130, 0x318F, "Hangul Compatibility Jamo"},
+	{0x3190, 0x319F, "Kanbun"},
+	{0x31A0, 0x31BF, "Bopomofo Extended"},
+	{0x31C0, 0x31EF, "CJK Strokes"},
+	{0x31F0, 0x31FF, "Katakana Phonetic Extensions"},
+	{0x3200, 0x32FF, "Enclosed CJK Letters and Months"},
+	{0x3300, 0x33FF, "CJK Compatibility"},
+	{0x3400, 0x4DBF, "CJK Unified Ideographs Extension A"},
+	{0x4DC0, 0x4DFF, "Yijing Hexagram Symbols"},
+	{0x4E00, 0x9FFF, "CJK Unified Ideographs"},
+	{0xA000, 0xA48F, "Yi Syllables"},
+	{0xA490, 0xA4CF, "Yi Radicals"},
+	{0xA4D0, 0xA4FF, "Lisu"},
+	{0xA500, 0xA63F, "Vai"},
+	{0xA640, 0xA69F, "Cyrillic Extended-B"},
+	{0xA6A0, 0xA6FF, "Bamum"},
+	{0xA700, 0xA71F, "Modifier Tone Letters"},
+	{0xA720, 0xA7FF, "Latin Extended-D"},
+	{0xA800, 0xA82F, "Syloti Nagri"},
+	{0xA830, 0xA83F, "Common Indic Number Forms"},
+	{0xA840, 0xA87F, "Phags-pa"},
+	{0xA880, 0xA8DF, "Saurashtra"},
+	{0xA8E0, 0xA8FF, "Devanagari Extended"},
+	{0xA900, 0xA92F, "Kayah Li"},
+	{0xA930, 0xA95F, "Rejang"},
+	{0xA960, 0xA97F, "Hangul Jamo Extended-A"},
+	{0xA980, 0xA9DF, "Javanese"},
+	{0xA9E0, 0xA9FF, "Myanmar Extended-B"},
+	{0xAA00, 0xAA5F, "Cham"},
+	{0xAA60, 0xAA7F, "Myanmar Extended-A"},
+	{0xAA80, 0xAADF, "Tai Viet"},
+	{0xAAE0, 0xAAFF, "Meetei Mayek Extensions"},
+	{0xAB00, 0xAB2F, "Ethiopic Extended-A"},
+	{0xAB30, 0xAB6F, "Latin Extended-E"},
+	{0xAB70, 0xABBF, "Cherokee Supplement"},
+	{0xABC0, 0xABFF, "Meetei Mayek"},
+	{0xAC00, 0xD7AF, "Hangul Syllables"},
+	{0xD7B0, 0xD7FF, "Hangul Jamo Extended-B"},
+	{0xD800, 0xDB7F, "High Surrogates"},
+	{0xDB80, 0xDBFF, "High Private Use Surrogates"},
+	{0xDC00, 0xDFFF, "Low Surrogates"},
+	{0xE000, 0xF8FF, "Private Use Area"},
+	{0xF900, 0xFAFF, "CJK Compatibility Ideographs"},
+	{0xFB00, 0xFB4F, "Alphabetic Presentation Forms"},
+	{0xFB50, 0xFDFF, "Arabic Presentation Forms-A"},
+	{0xFE00, 0xFE0F, "Variation Selectors"},
+	{0xFE10, 0xFE1F, "Vertical Forms"},
+	{0xFE20, 0xFE2F, "Combining Half Marks"},
+	{0xFE30, 0xFE4F, "CJK Compatibility Forms"},
+	{0xFE50, 0xFE6F, "Small Form Variants"},
+	{0xFE70, 0xFEFF, "Arabic Presentation Forms-B"},
+	{0xFF00, 0xFFEF, "Halfwidth and Fullwidth Forms"},
+	{0xFFF0, 0xFFFF, "Specials"},
+	{0x10000, 0x1007F, "Linear B Syllabary"},
+	{0x10080, 0x100FF, "Linear B Ideograms"},
+	{0x10100, 0x1013F, "Aegean Numbers"},
+	{0x10140, 0x1018F, "Ancient Greek Numbers"},
+	{0x10190, 0x101CF, "Ancient Symbols"},
+	{0x101D0, 0x101FF, "Phaistos Disc"},
+	{0x10280, 0x1029F, "Lycian"},
+	{0x102A0, 0x102DF, "Carian"},
+	{0x102E0, 0x102FF, "Coptic Epact Numbers"},
+	{0x10300, 0x1032F, "Old Italic"},
+	{0x10330, 0x1034F, "Gothic"},
+	{0x10350, 0x1037F, "Old Permic"},
+	{0x10380, 0x1039F, "Ugaritic"},
+	{0x103A0, 0x103DF, "Old Persian"},
+	{0x10400, 0x1044F, "Deseret"},
+	{0x10450, 0x1047F, "Shavian"},
+	{0x10480, 0x104AF, "Osmanya"},
+	{0x104B0, 0x104FF, "Osage"},
+	{0x10500, 0x1052F, "Elbasan"},
+	{0x10530, 0x1056F, "Caucasian Albanian"},
+	{0x10570, 0x105BF, "Vithkuqi"},
+	{0x10600, 0x1077F, "Linear A"},
+	{0x10780, 0x107BF, "Latin Extended-F"},
+	{0x10800, 0x1083F, "Cypriot Syllabary"},
+	{0x10840, 0x1085F, "Imperial Aramaic"},
+	{0x10860, 0x1087F, "Palmyrene"},
+	{0x10880, 0x108AF, "Nabataean"},
+	{0x108E0, 0x108FF, "Hatran"},
+	{0x10900, 0x1091F, "Phoenician"},
+	{0x10920, 0x1093F, "Lydian"},
+	{0x10980, 0x1099F, "Meroitic Hieroglyphs"},
+	{0x109A0, 0x109FF, "Meroitic Cursive"},
+	{0x10A00, 0x10A5F, "Kharoshthi"},
+	{0x10A60, 0x10A7F, "Old South Arabian"},
+	{0x10A80, 0x10A9F, "Old North Arabian"},
+	{0x10AC0, 0x10AFF, "Manichaean"},
+	{0x10B00, 0x10B3F, "Avestan"},
+	{0x10B40, 0x10B5F, "Inscriptional Parthian"},
+	{0x10B60, 0x10B7F, "Inscriptional Pahlavi"},
+	{0x10B80, 0x10BAF, "Psalter Pahlavi"},
+	{0x10C00, 0x10C4F, "Old Turkic"},
+	{0x10C80, 0x10CFF, "Old Hungarian"},
+	{0x10D00, 0x10D3F, "Hanifi Rohingya"},
+	{0x10E60, 0x10E7F, "Rumi Numeral Symbols"},
+	{0x10E80, 0x10EBF, "Yezidi"},
+	{0x10F00, 0x10F2F, "Old Sogdian"},
+	{0x10F30, 0x10F6F, "Sogdian"},
+	{0x10F70, 0x10FAF, "Old Uyghur"},
+	{0x10FB0, 0x10FDF, "Chorasmian"},
+	{0x10FE0, 0x10FFF, "Elymaic"},
+	{0x11000, 0x1107F, "Brahmi"},
+	{0x11080, 0x110CF, "Kaithi"},
+	{0x110D0, 0x110FF, "Sora Sompeng"},
+	{0x11100, 0x1114F, "Chakma"},
+	{0x11150, 0x1117F, "Mahajani"},
+	{0x11180, 0x111DF, "Sharada"},
+	{0x111E0, 0x111FF, "Sinhala Archaic Numbers"},
+	{0x11200, 0x1124F, "Khojki"},
+	{0x11280, 0x112AF, "Multani"},
+	{0x112B0, 0x112FF, "Khudawadi"},
+	{0x11300, 0x1137F, "Grantha"},
+	{0x11400, 0x1147F, "Newa"},
+	{0x11480, 0x114DF, "Tirhuta"},
+	{0x11580, 0x115FF, "Siddham"},
+	{0x11600, 0x1165F, "Modi"},
+	{0x11660, 0x1167F, "Mongolian Supplement"},
+	{0x11680, 0x116CF, "Takri"},
+	{0x11700, 0x1174F, "Ahom"},
+	{0x11800, 0x1184F, "Dogra"},
+	{0x118A0, 0x118FF, "Warang Citi"},
+	{0x11900, 0x1195F, "Dives Akuru"},
+	{0x119A0, 0x119FF, "Nandinagari"},
+	{0x11A00, 0x11A4F, "Zanabazar Square"},
+	{0x11A50, 0x11AAF, "Soyombo"},
+	{0x11AB0, 0x11ABF, "Unified Canadian Aboriginal Syllabics Extended-A"},
+	{0x11AC0, 0x11AFF, "Pau Cin Hau"},
+	{0x11C00, 0x11C6F, "Bhaiksuki"},
+	{0x11C70, 0x11CBF, "Marchen"},
+	{0x11D00, 0x11D5F, "Masaram Gondi"},
+	{0x11D60, 0x11DAF, "Gunjala Gondi"},
+	{0x11EE0, 0x11EFF, "Makasar"},
+	{0x11FB0, 0x11FBF, "Lisu Supplement"},
+	{0x11FC0, 0x11FFF, "Tamil Supplement"},
+	{0x12000, 0x123FF, "Cuneiform"},
+	{0x12400, 0x1247F, "Cuneiform Numbers and Punctuation"},
+	{0x12480, 0x1254F, "Early Dynastic Cuneiform"},
+	{0x12F90, 0x12FFF, "Cypro-Minoan"},
+	{0x13000, 0x1342F, "Egyptian Hieroglyphs"},
+	{0x13430, 0x1343F, "Egyptian Hieroglyph Format Controls"},
+	{0x14400, 0x1467F, "Anatolian Hieroglyphs"},
+	{0x16800, 0x16A3F, "Bamum Supplement"},
+	{0x16A40, 0x16A6F, "Mro"},
+	{0x16A70, 0x16ACF, "Tangsa"},
+	{0x16AD0, 0x16AFF, "Bassa Vah"},
+	{0x16B00, 0x16B8F, "Pahawh Hmong"},
+	{0x16E40, 0x16E9F, "Medefaidrin"},
+	{0x16F00, 0x16F9F, "Miao"},
+	{0x16FE0, 0x16FFF, "Ideographic Symbols and Punctuation"},
+	{0x17000, 0x187FF, "Tangut"},
+	{0x18800, 0x18AFF, "Tangut Components"},
+	{0x18B00, 0x18CFF, "Khitan Small Script"},
+	{0x18D00, 0x18D7F, "Tangut Supplement"},
+	{0x1AFF0, 0x1AFFF, "Kana Extended-B"},
+	{0x1B000, 0x1B0FF, "Kana Supplement"},
+	{0x1B100, 0x1B12F, "Kana Extended-A"},
+	{0x1B130, 0x1B16F, "Small Kana Extension"},
+	{0x1B170, 0x1B2FF, "Nushu"},
+	{0x1BC00, 0x1BC9F, "Duployan"},
+	{0x1BCA0, 0x1BCAF, "Shorthand Format Controls"},
+	{0x1CF00, 0x1CFCF, "Znamenny Musical Notation"},
+	{0x1D000, 0x1D0FF, "Byzantine Musical Symbols"},
+	{0x1D100, 0x1D1FF, "Musical Symbols"},
+	{0x1D200, 0x1D24F, "Ancient Greek Musical Notation"},
+	{0x1D2E0, 0x1D2FF, "Mayan Numerals"},
+	{0x1D300, 0x1D35F, "Tai Xuan Jing Symbols"},
+	{0x1D360, 0x1D37F, "Counting Rod Numerals"},
+	{0x1D400, 0x1D7FF, "Mathematical Alphanumeric Symbols"},
+	{0x1D800, 0x1DAAF, "Sutton SignWriting"},
+	{0x1DF00, 0x1DFFF, "Latin Extended-G"},
+	{0x1E000, 0x1E02F, "Glagolitic Supplement"},
+	{0x1E100, 0x1E14F, "Nyiakeng Puachue Hmong"},
+	{0x1E290, 0x1E2BF, "Toto"},
+	{0x1E2C0, 0x1E2FF, "Wancho"},
+	{0x1E7E0, 0x1E7FF, "Ethiopic Extended-B"},
+	{0x1E800, 0x1E8DF, "Mende Kikakui"},
+	{0x1E900, 0x1E95F, "Adlam"},
+	{0x1EC70, 0x1ECBF, "Indic Siyaq Numbers"},
+	{0x1ED00, 0x1ED4F, "Ottoman Siyaq Numbers"},
+	{0x1EE00, 0x1EEFF, "Arabic Mathematical Alphabetic Symbols"},
+	{0x1F000, 0x1F02F, "Mahjong Tiles"},
+	{0x1F030, 0x1F09F, "Domino Tiles"},
+	{0x1F0A0, 0x1F0FF, "Playing Cards"},
+	{0x1F100, 0x1F1FF, "Enclosed Alphanumeric Supplement"},
+	{0x1F200, 0x1F2FF, "Enclosed Ideographic Supplement"},
+	{0x1F300, 0x1F5FF, "Miscellaneous Symbols and Pictographs"},
+	{0x1F600, 0x1F64F, "Emoticons"},
+	{0x1F650, 0x1F67F, "Ornamental Dingbats"},
+	{0x1F680, 0x1F6FF, "Transport and Map Symbols"},
+	{0x1F700, 0x1F77F, "Alchemical Symbols"},
+	{0x1F780, 0x1F7FF, "Geometric Shapes Extended"},
+	{0x1F800, 0x1F8FF, "Supplemental Arrows-C"},
+	{0x1F900, 0x1F9FF, "Supplemental Symbols and Pictographs"},
+	{0x1FA00, 0x1FA6F, "Chess Symbols"},
+	{0x1FA70, 0x1FAFF, "Symbols and Pictographs Extended-A"},
+	{0x1FB00, 0x1FBFF, "Symbols for Legacy Computing"},
+	{0x20000, 0x2A6DF, "CJK Unified Ideographs Extension B"},
+	{0x2A700, 0x2B73F, "CJK Unified Ideographs Extension C"},
+	{0x2B740, 0x2B81F, "CJK Unified Ideographs Extension D"},
+	{0x2B820, 0x2CEAF, "CJK Unified Ideographs Extension E"},
+	{0x2CEB0, 0x2EBEF, "CJK Unified Ideographs Extension F"},
+	{0x2F800, 0x2FA1F, "CJK Compatibility Ideographs Supplement"},
+	{0x30000, 0x3134F, "CJK Unified Ideographs Extension G"},
+	{0xE0000, 0xE007F, "Tags"},
+	{0xE0100, 0xE01EF, "Variation Selectors Supplement"},
+	{0xF0000, 0xFFFFF, "Supplementary Private Use Area-A"},
+	{0x100000, 0x10FFFF, "Supplementary Private Use Area-B"},
+}
+
+// graphemeBreaks lists the ranges of code points by Grapheme_Cluster_Break
+// property, in increasing order, with Extended_Pictographic characters as
+// gbExtPict. Code points not listed are gbOther.
+var graphemeBreaks = []gbRange{
+	{0x0000, 0x0009, gbControl},
+	{0x000A, 0x000A, gbLF},
+	{0x000B, 0x000C, gbControl},
+	{0x000D, 0x000D, gbCR},
+	{0x000E, 0x001F, gbControl},
+	{0x007F, 0x009F, gbControl},
+	{0x00A9, 0x00A9, gbExtPict},
+	{0x00AD, 0x00AD, gbControl},
+	{0x00AE, 0x00AE, gbExtPict},
+	{0x0300, 0x036F, gbExtend},
+	{0x0483, 0x0489, gbExtend},
+	{0x0591, 0x05BD, gbExtend},
+	{0x05BF, 0x05BF, gbExtend},
+	{0x05C1, 0x05C2, gbExtend},
+	{0x05C4, 0x05C5, gbExtend},
+	{0x05C7, 0x05C7, gbExtend},
+	{0x0600, 0x0605, gbPrepend},
+	{0x0610, 0x061A, gbExtend},
+	{0x061C, 0x061C, gbControl},
+	{0x064B, 0x065F, gbExtend},
+	{0x0670, 0x0670, gbExtend},
+	{0x06D6, 0x06DC, gbExtend},
+	{0x06DD, 0x06DD, gbPrepend},
+	{0x06DF, 0x06E4, gbExtend},
+	{0x06E7, 0x06E8, gbExtend},
+	{0x06EA, 0x06ED, gbExtend},
+	{0x070F, 0x070F, gbPrepend},
+	{0x0711, 0x0711, gbExtend},
+	{0x0730, 0x074A, gbExtend},
+	{0x07A6, 0x07B0, gbExtend},
+	{0x07EB, 0x07F3, gbExtend},
+	{0x07FD, 0x07FD, gbExtend},
+	{0x0816, 0x0819, gbExtend},
+	{0x081B, 0x0823, gbExtend},
+	{0x0825, 0x0827, gbExtend},
+	{0x0829, 0x082D, gbExtend},
+	{0x0859, 0x085B, gbExtend},
+	{0x0890, 0x0891, gbPrepend},
+	{0x0898, 0x089F, gbExtend},
+	{0x08CA, 0x08E1, gbExtend},
+	{0x08E2, 0x08E2, gbPrepend},
+	{0x08E3, 0x0902, gbExtend},
+	{0x0903, 0x0903, gbSpacingMark},
+	{0x093A, 0x093A, gbExtend},
+	{0x093B, 0x093B, gbSpacingMark},
+	{0x093C, 0x093C, gbExtend},
+	{0x093E, 0x0940, gbSpacingMark},
+	{0x0941, 0x0948, gbExtend},
+	{0x0949, 0x094C, gbSpacingMark},
+	{0x094D, 0x094D, gbExtend},
+	{0x094E, 0x094F, gbSpacingMark},
+	{0x0951, 0x0957, gbExtend},
+	{0x0962, 0x0963, gbExtend},
+	{0x0981, 0x0981, gbExtend},
+	{0x0982, 0x0983, gbSpacingMark},
+	{0x09BC, 0x09BC, gbExtend},
+	{0x09BE, 0x09BE, gbExtend},
+	{0x09BF, 0x09C0, gbSpacingMark},
+	{0x09C1, 0x09C4, gbExtend},
+	{0x09C7, 0x09C8, gbSpacingMark},
+	{0x09CB, 0x09CC, gbSpacingMark},
+	{0x09CD, 0x09CD, gbExtend},
+	{0x09D7, 0x09D7, gbExtend},
+	{0x09E2, 0x09E3, gbExtend},
+	{0x09FE, 0x09FE, gbExtend},
+	{0x0A01, 0x0A02, gbExtend},
+	{0x0A03, 0x0A03, gbSpacingMark},
+	{0x0A3C, 0x0A3C, gbExtend},
+	{0x0A3E, 0x0A40, gbSpacingMark},
+	{0x0A41, 0x0A42, gbExtend},
+	{0x0A47, 0x0A48, gbExtend},
+	{0x0A4B, 0x0A4D, gbExtend},
+	{0x0A51, 0x0A51, gbExtend},
+	{0x0A70, 0x0A71, gbExtend},
+	{0x0A75, 0x0A75, gbExtend},
+	{0x0A81, 0x0A82, gbExtend},
+	{0x0A83, 0x0A83, gbSpacingMark},
+	{0x0ABC, 0x0ABC, gbExtend},
+	{0x0ABE, 0x0AC0, gbSpacingMark},
+	{0x0AC1, 0x0AC5, gbExtend},
+	{0x0AC7, 0x0AC8, gbExtend},
+	{0x0AC9, 0x0AC9, gbSpacingMark},
+	{0x0ACB, 0x0ACC, gbSpacingMark},
+	{0x0ACD, 0x0ACD, gbExtend},
+	{0x0AE2, 0x0AE3, gbExtend},
+	{0x0AFA, 0x0AFF, gbExtend},
+	{0x0B01, 0x0B01, gbExtend},
+	{0x0B02, 0x0B03, gbSpacingMark},
+	{0x0B3C, 0x0B3C, gbExtend},
+	{0x0B3E, 0x0B3F, gbExtend},
+	{0x0B40, 0x0B40, gbSpacingMark},
+	{0x0B41, 0x0B44, gbExtend},
+	{0x0B47, 0x0B48, gbSpacingMark},
+	{0x0B4B, 0x0B4C, gbSpacingMark},
+	{0x0B4D, 0x0B4D, gbExtend},
+	{0x0B55, 0x0B57, gbExtend},
+	{0x0B62, 0x0B63, gbExtend},
+	{0x0B82, 0x0B82, gbExtend},
+	{0x0BBE, 0x0BBE, gbExtend},
+	{0x0BBF, 0x0BBF, gbSpacingMark},
+	{0x0BC0, 0x0BC0, gbExtend},
+	{0x0BC1, 0x0BC2, gbSpacingMark},
+	{0x0BC6, 0x0BC8, gbSpacingMark},
+	{0x0BCA, 0x0BCC, gbSpacingMark},
+	{0x0BCD, 0x0BCD, gbExtend},
+	{0x0BD7, 0x0BD7, gbExtend},
+	{0x0C00, 0x0C00, gbExtend},
+	{0x0C01, 0x0C03, gbSpacingMark},
+	{0x0C04, 0x0C04, gbExtend},
+	{0x0C3C, 0x0C3C, gbExtend},
+	{0x0C3E, 0x0C40, gbExtend},
+	{0x0C41, 0x0C44, gbSpacingMark},
+	{0x0C46, 0x0C48, gbExtend},
+	{0x0C4A, 0x0C4D, gbExtend},
+	{0x0C55, 0x0C56, gbExtend},
+	{0x0C62, 0x0C63, gbExtend},
+	{0x0C81, 0x0C81, gbExtend},
+	{0x0C82, 0x0C83, gbSpacingMark},
+	{0x0CBC, 0x0CBC, gbExtend},
+	{0x0CBE, 0x0CBE, gbSpacingMark},
+	{0x0CBF, 0x0CBF, gbExtend},
+	{0x0CC0, 0x0CC1, gbSpacingMark},
+	{0x0CC2, 0x0CC2, gbExtend},
+	{0x0CC3, 0x0CC4, gbSpacingMark},
+	{0x0CC6, 0x0CC6, gbExtend},
+	{0x0CC7, 0x0CC8, gbSpacingMark},
+	{0x0CCA, 0x0CCB, gbSpacingMark},
+	{0x0CCC, 0x0CCD, gbExtend},
+	{0x0CD5, 0x0CD6, gbExtend},
+	{0x0CE2, 0x0CE3, gbExtend},
+	{0x0D00, 0x0D01, gbExtend},
+	{0x0D02, 0x0D03, gbSpacingMark},
+	{0x0D3B, 0x0D3C, gbExtend},
+	{0x0D3E, 0x0D3E, gbExtend},
+	{0x0D3F, 0x0D40, gbSpacingMark},
+	{0x0D41, 0x0D44, gbExtend},
+	{0x0D46, 0x0D48, gbSpacingMark},
+	{0x0D4A, 0x0D4C, gbSpacingMark},
+	{0x0D4D, 0x0D4D, gbExtend},
+	{0x0D4E, 0x0D4E, gbPrepend},
+	{0x0D57, 0x0D57, gbExtend},
+	{0x0D62, 0x0D63, gbExtend},
+	{0x0D81, 0x0D81, gbExtend},
+	{0x0D82, 0x0D83, gbSpacingMark},
+	{0x0DCA, 0x0DCA, gbExtend},
+	{0x0DCF, 0x0DCF, gbExtend},
+	{0x0DD0, 0x0DD1, gbSpacingMark},
+	{0x0DD2, 0x0DD4, gbExtend},
+	{0x0DD6, 0x0DD6, gbExtend},
+	{0x0DD8, 0x0DDE, gbSpacingMark},
+	{0x0DDF, 0x0DDF, gbExtend},
+	{0x0DF2, 0x0DF3, gbSpacingMark},
+	{0x0E31, 0x0E31, gbExtend},
+	{0x0E33, 0x0E33, gbSpacingMark},
+	{0x0E34, 0x0E3A, gbExtend},
+	{0x0E47, 0x0E4E, gbExtend},
+	{0x0EB1, 0x0EB1, gbExtend},
+	{0x0EB3, 0x0EB3, gbSpacingMark},
+	{0x0EB4, 0x0EBC, gbExtend},
+	{0x0EC8, 0x0ECD, gbExtend},
+	{0x0F18, 0x0F19, gbExtend},
+	{0x0F35, 0x0F35, gbExtend},
+	{0x0F37, 0x0F37, gbExtend},
+	{0x0F39, 0x0F39, gbExtend},
+	{0x0F3E, 0x0F3F, gbSpacingMark},
+	{0x0F71, 0x0F7E, gbExtend},
+	{0x0F7F, 0x0F7F, gbSpacingMark},
+	{0x0F80, 0x0F84, gbExtend},
+	{0x0F86, 0x0F87, gbExtend},
+	{0x0F8D, 0x0F97, gbExtend},
+	{0x0F99, 0x0FBC, gbExtend},
+	{0x0FC6, 0x0FC6, gbExtend},
+	{0x102D, 0x1030, gbExtend},
+	{0x1031, 0x1031, gbSpacingMark},
+	{0x1032, 0x1037, gbExtend},
+	{0x1039, 0x103A, gbExtend},
+	{0x103B, 0x103C, gbSpacingMark},
+	{0x103D, 0x103E, gbExtend},
+	{0x1056, 0x1057, gbSpacingMark},
+	{0x1058, 0x1059, gbExtend},
+	{0x105E, 0x1060, gbExtend},
+	{0x1071, 0x1074, gbExtend},
+	{0x1082, 0x1082, gbExtend},
+	{0x1084, 0x1084, gbSpacingMark},
+	{0x1085, 0x1086, gbExtend},
+	{0x108D, 0x108D, gbExtend},
+	{0x109D, 0x109D, gbExtend},
+	{0x1100, 0x115F, gbL},
+	{0x1160, 0x11A7, gbV},
+	{0x11A8, 0x11FF, gbT},
+	{0x135D, 0x135F, gbExtend},
+	{0x1712, 0x1714, gbExtend},
+	{0x1715, 0x1715, gbSpacingMark},
+	{0x1732, 0x1733, gbExtend},
+	{0x1734, 0x1734, gbSpacingMark},
+	{0x1752, 0x1753, gbExtend},
+	{0x1772, 0x1773, gbExtend},
+	{0x17B4, 0x17B5, gbExtend},
+	{0x17B6, 0x17B6, gbSpacingMark},
+	{0x17B7, 0x17BD, gbExtend},
+	{0x17BE, 0x17C5, gbSpacingMark},
+	{0x17C6, 0x17C6, gbExtend},
+	{0x17C7, 0x17C8, gbSpacingMark},
+	{0x17C9, 0x17D3, gbExtend},
+	{0x17DD, 0x17DD, gbExtend},
+	{0x180B, 0x180D, gbExtend},
+	{0x180E, 0x180E, gbControl},
+	{0x180F, 0x180F, gbExtend},
+	{0x1885, 0x1886, gbExtend},
+	{0x18A9, 0x18A9, gbExtend},
+	{0x1920, 0x1922, gbExtend},
+	{0x1923, 0x1926, gbSpacingMark},
+	{0x1927, 0x1928, gbExtend},
+	{0x1929, 0x192B, gbSpacingMark},
+	{0x1930, 0x1931, gbSpacingMark},
+	{0x1932, 0x1932, gbExtend},
+	{0x1933, 0x1938, gbSpacingMark},
+	{0x1939, 0x193B, gbExtend},
+	{0x1A17, 0x1A18, gbExtend},
+	{0x1A19, 0x1A1A, gbSpacingMark},
+	{0x1A1B, 0x1A1B, gbExtend},
+	{0x1A55, 0x1A55, gbSpacingMark},
+	{0x1A56, 0x1A56, gbExtend},
+	{0x1A57, 0x1A57, gbSpacingMark},
+	{0x1A58, 0x1A5E, gbExtend},
+	{0x1A60, 0x1A60, gbExtend},
+	{0x1A62, 0x1A62, gbExtend},
+	{0x1A65, 0x1A6C, gbExtend},
+	{0x1A6D, 0x1A72, gbSpacingMark},
+	{0x1A73, 0x1A7C, gbExtend},
+	{0x1A7F, 0x1A7F, gbExtend},
+	{0x1AB0, 0x1ACE, gbExtend},
+	{0x1B00, 0x1B03, gbExtend},
+	{0x1B04, 0x1B04, gbSpacingMark},
+	{0x1B34, 0x1B3A, gbExtend},
+	{0x1B3B, 0x1B3B, gbSpacingMark},
+	{0x1B3C, 0x1B3C, gbExtend},
+	{0x1B3D, 0x1B41, gbSpacingMark},
+	{0x1B42, 0x1B42, gbExtend},
+	{0x1B43, 0x1B44, gbSpacingMark},
+	{0x1B6B, 0x1B73, gbExtend},
+	{0x1B80, 0x1B81, gbExtend},
+	{0x1B82, 0x1B82, gbSpacingMark},
+	{0x1BA1, 0x1BA1, gbSpacingMark},
+	{0x1BA2, 0x1BA5, gbExtend},
+	{0x1BA6, 0x1BA7, gbSpacingMark},
+	{0x1BA8, 0x1BA9, gbExtend},
+	{0x1BAA, 0x1BAA, gbSpacingMark},
+	{0x1BAB, 0x1BAD, gbExtend},
+	{0x1BE6, 0x1BE6, gbExtend},
+	{0x1BE7, 0x1BE7, gbSpacingMark},
+	{0x1BE8, 0x1BE9, gbExtend},
+	{0x1BEA, 0x1BEC, gbSpacingMark},
+	{0x1BED, 0x1BED, gbExtend},
+	{0x1BEE, 0x1BEE, gbSpacingMark},
+	{0x1BEF, 0x1BF1, gbExtend},
+	{0x1BF2, 0x1BF3, gbSpacingMark},
+	{0x1C24, 0x1C2B, gbSpacingMark},
+	{0x1C2C, 0x1C33, gbExtend},
+	{0x1C34, 0x1C35, gbSpacingMark},
+	{0x1C36, 0x1C37, gbExtend},
+	{0x1CD0, 0x1CD2, gbExtend},
+	{0x1CD4, 0x1CE0, gbExtend},
+	{0x1CE1, 0x1CE1, gbSpacingMark},
+	{0x1CE2, 0x1CE8, gbExtend},
+	{0x1CED, 0x1CED, gbExtend},
+	{0x1CF4, 0x1CF4, gbExtend},
+	{0x1CF7, 0x1CF7, gbSpacingMark},
+	{0x1CF8, 0x1CF9, gbExtend},
+	{0x1DC0, 0x1DFF, gbExtend},
+	{0x200B, 0x200B, gbControl},
+	{0x200C, 0x200C, gbExtend},
+	{0x200D, 0x200D, gbZWJ},
+	{0x200E, 0x200F, gbControl},
+	{0x2028, 0x202E, gbControl},
+	{0x203C, 0x203C, gbExtPict},
+	{0x2049, 0x2049, gbExtPict},
+	{0x2060, 0x206F, gbControl},
+	{0x20D0, 0x20F0, gbExtend},
+	{0x2122, 0x2122, gbExtPict},
+	{0x2139, 0x2139, gbExtPict},
+	{0x2194, 0x2199, gbExtPict},
+	{0x21A9, 0x21AA, gbExtPict},
+	{0x231A, 0x231B, gbExtPict},
+	{0x2328, 0x2328, gbExtPict},
+	{0x2388, 0x2388, gbExtPict},
+	{0x23CF, 0x23CF, gbExtPict},
+	{0x23E9, 0x23F3, gbExtPict},
+	{0x23F8, 0x23FA, gbExtPict},
+	{0x24C2, 0x24C2, gbExtPict},
+	{0x25AA, 0x25AB, gbExtPict},
+	{0x25B6, 0x25B6, gbExtPict},
+	{0x25C0, 0x25C0, gbExtPict},
+	{0x25FB, 0x25FE, gbExtPict},
+	{0x2600, 0x2605, gbExtPict},
+	{0x2607, 0x2612, gbExtPict},
+	{0x2614, 0x2685, gbExtPict},
+	{0x2690, 0x2705, gbExtPict},
+	{0x2708, 0x2712, gbExtPict},
+	{0x2714, 0x2714, gbExtPict},
+	{0x2716, 0x2716, gbExtPict},
+	{0x271D, 0x271D, gbExtPict},
+	{0x2721, 0x2721, gbExtPict},
+	{0x2728, 0x2728, gbExtPict},
+	{0x2733, 0x2734, gbExtPict},
+	{0x2744, 0x2744, gbExtPict},
+	{0x2747, 0x2747, gbExtPict},
+	{0x274C, 0x274C, gbExtPict},
+	{0x274E, 0x274E, gbExtPict},
+	{0x2753, 0x2755, gbExtPict},
+	{0x2757, 0x2757, gbExtPict},
+	{0x2763, 0x2767, gbExtPict},
+	{0x2795, 0x2797, gbExtPict},
+	{0x27A1, 0x27A1, gbExtPict},
+	{0x27B0, 0x27B0, gbExtPict},
+	{0x27BF, 0x27BF, gbExtPict},
+	{0x2934, 0x2935, gbExtPict},
+	{0x2B05, 0x2B07, gbExtPict},
+	{0x2B1B, 0x2B1C, gbExtPict},
+	{0x2B50, 0x2B50, gbExtPict},
+	{0x2B55, 0x2B55, gbExtPict},
+	{0x2CEF, 0x2CF1, gbExtend},
+	{0x2D7F, 0x2D7F, gbExtend},
+	{0x2DE0, 0x2DFF, gbExtend},
+	{0x302A, 0x302F, gbExtend},
+	{0x3030, 0x3030, gbExtPict},
+	{0x303D, 0x303D, gbExtPict},
+	{0x3099, 0x309A, gbExtend},
+	{0x3297, 0x3297, gbExtPict},
+	{0x3299, 0x3299, gbExtPict},
+	{0xA66F, 0xA672, gbExtend},
+	{0xA674, 0xA67D, gbExtend},
+	{0xA69E, 0xA69F, gbExtend},
+	{0xA6F0, 0xA6F1, gbExtend},
+	{0xA802, 0xA802, gbExtend},
+	{0xA806, 0xA806, gbExtend},
+	{0xA80B, 0xA80B, gbExtend},
+	{0xA823, 0xA824, gbSpacingMark},
+	{0xA825, 0xA826, gbExtend},
+	{0xA827, 0xA827, gbSpacingMark},
+	{0xA82C, 0xA82C, gbExtend},
+	{0xA880, 0xA881, gbSpacingMark},
+	{0xA8B4, 0xA8C3, gbSpacingMark},
+	{0xA8C4, 0xA8C5, gbExtend},
+	{0xA8E0, 0xA8F1, gbExtend},
+	{0xA8FF, 0xA8FF, gbExtend},
+	{0xA926, 0xA92D, gbExtend},
+	{0xA947, 0xA951, gbExtend},
+	{0xA952, 0xA953, gbSpacingMark},
+	{0xA960, 0xA97C, gbL},
+	{0xA980, 0xA982, gbExtend},
+	{0xA983, 0xA983, gbSpacingMark},
+	{0xA9B3, 0xA9B3, gbExtend},
+	{0xA9B4, 0xA9B5, gbSpacingMark},
+	{0xA9B6, 0xA9B9, gbExtend},
+	{0xA9BA, 0xA9BB, gbSpacingMark},
+	{0xA9BC, 0xA9BD, gbExtend},
+	{0xA9BE, 0xA9C0, gbSpacingMark},
+	{0xA9E5, 0xA9E5, gbExtend},
+	{0xAA29, 0xAA2E, gbExtend},
+	{0xAA2F, 0xAA30, gbSpacingMark},
+	{0xAA31, 0xAA32, gbExtend},
+	{0xAA33, 0xAA34, gbSpacingMark},
+	{0xAA35, 0xAA36, gbExtend},
+	{0xAA43, 0xAA43, gbExtend},
+	{0xAA4C, 0xAA4C, gbExtend},
+	{0xAA4D, 0xAA4D, gbSpacingMark},
+	{0xAA7C, 0xAA7C, gbExtend},
+	{0xAAB0, 0xAAB0, gbExtend},
+	{0xAAB2, 0xAAB4, gbExtend},
+	{0xAAB7, 0xAAB8, gbExtend},
+	{0xAABE, 0xAABF, gbExtend},
+	{0xAAC1, 0xAAC1, gbExtend},
+	{0xAAEB, 0xAAEB, gbSpacingMark},
+	{0xAAEC, 0xAAED, gbExtend},
+	{0xAAEE, 0xAAEF, gbSpacingMark},
+	{0xAAF5, 0xAAF5, gbSpacingMark},
+	{0xAAF6, 0xAAF6, gbExtend},
+	{0xABE3, 0xABE4, gbSpacingMark},
+	{0xABE5, 0xABE5, gbExtend},
+	{0xABE6, 0xABE7, gbSpacingMark},
+	{0xABE8, 0xABE8, gbExtend},
+	{0xABE9, 0xABEA, gbSpacingMark},
+	{0xABEC, 0xABEC, gbSpacingMark},
+	{0xABED, 0xABED, gbExtend},
+	{0xAC00, 0xAC00, gbLV},
+	{0xAC01, 0xAC1B, gbLVT},
+	{0xAC1C, 0xAC1C, gbLV},
+	{0xAC1D, 0xAC37, gbLVT},
+	{0xAC38, 0xAC38, gbLV},
+	{0xAC39, 0xAC53, gbLVT},
+	{0xAC54, 0xAC54, gbLV},
+	{0xAC55, 0xAC6F, gbLVT},
+	{0xAC70, 0xAC70, gbLV},
+	{0xAC71, 0xAC8B, gbLVT},
+	{0xAC8C, 0xAC8C, gbLV},
+	{0xAC8D, 0xACA7, gbLVT},
+	{0xACA8, 0xACA8, gbLV},
+	{0xACA9, 0xACC3, gbLVT},
+	{0xACC4, 0xACC4, gbLV},
+	{0xACC5, 0xACDF, gbLVT},
+	{0xACE0, 0xACE0, gbLV},
+	{0xACE1, 0xACFB, gbLVT},
+	{0xACFC, 0xACFC, gbLV},
+	{0xACFD, 0xAD17, gbLVT},
+	{0xAD18, 0xAD18, gbLV},
+	{0xAD19, 0xAD33, gbLVT},
+	{0xAD34, 0xAD34, gbLV},
+	{0xAD35, 0xAD4F, gbLVT},
+	{0xAD50, 0xAD50, gbLV},
+	{0xAD51, 0xAD6B, gbLVT},
+	{0xAD6C, 0xAD6C, gbLV},
+	{0xAD6D, 0xAD87, gbLVT},
+	{0xAD88, 0xAD88, gbLV},
+	{0xAD89, 0xADA3, gbLVT},
+	{0xADA4, 0xADA4, gbLV},
+	{0xADA5, 0xADBF, gbLVT},
+	{0xADC0, 0xADC0, gbLV},
+	{0xADC1, 0xADDB, gbLVT},
+	{0xADDC, 0xADDC, gbLV},
+	{0xADDD, 0xADF7, gbLVT},
+	{0xADF8, 0xADF8, gbLV},
+	{0xADF9, 0xAE13, gbLVT},
+	{0xAE14, 0xAE14, gbLV},
+	{0xAE15, 0xAE2F, gbLVT},
+	{0xAE30, 0xAE30, gbLV},
+	{0xAE31, 0xAE4B, gbLVT},
+	{0xAE4C, 0xAE4C, gbLV},
+	{0xAE4D, 0xAE67, gbLVT},
+	{0xAE68, 0xAE68, gbLV},
+	{0xAE69, 0xAE83, gbLVT},
+	{0xAE84, 0xAE84, gbLV},
+	{0xAE85, 0xAE9F, gbLVT},
+	{0xAEA0, 0xAEA0, gbLV},
+	{0xAEA1, 0xAEBB, gbLVT},
+	{0xAEBC, 0xAEBC, gbLV},
+	{0xAEBD, 0xAED7, gbLVT},
+	{0xAED8, 0xAED8, gbLV},
+	{0xAED9, 0xAEF3, gbLVT},
+	{0xAEF4, 0xAEF4, gbLV},
+	{0xAEF5, 0xAF0F, gbLVT},
+	{0xAF10, 0xAF10, gbLV},
+	{0xAF11, 0xAF2B, gbLVT},
+	{0xAF2C, 0xAF2C, gbLV},
+	{0xAF2D, 0xAF47, gbLVT},
+	{0xAF48, 0xAF48, gbLV},
+	{0xAF49, 0xAF63, gbLVT},
+	{0xAF64, 0xAF64, gbLV},
+	{0xAF65, 0xAF7F, gbLVT},
+	{0xAF80, 0xAF80, gbLV},
+	{0xAF81, 0xAF9B, gbLVT},
+	{0xAF9C, 0xAF9C, gbLV},
+	{0xAF9D, 0xAFB7, gbLVT},
+	{0xAFB8, 0xAFB8, gbLV},
+	{0xAFB9, 0xAFD3, gbLVT},
+	{0xAFD4, 0xAFD4, gbLV},
+	{0xAFD5, 0xAFEF, gbLVT},
+	{0xAFF0, 0xAFF0, gbLV},
+	{0xAFF1, 0xB00B, gbLVT},
+	{0xB00C, 0xB00C, gbLV},
+	{0xB00D, 0xB027, gbLVT},
+	{0xB028, 0xB028, gbLV},
+	{0xB029, 0xB043, gbLVT},
+	{0xB044, 0xB044, gbLV},
+	{0xB045, 0xB05F, gbLVT},
+	{0xB060, 0xB060, gbLV},
+	{0xB061, 0xB07B, gbLVT},
+	{0xB07C, 0xB07C, gbLV},
+	{0xB07D, 0xB097, gbLVT},
+	{0xB098, 0xB098, gbLV},
+	{0xB099, 0xB0B3, gbLVT},
+	{0xB0B4, 0xB0B4, gbLV},
+	{0xB0B5, 0xB0CF, gbLVT},
+	{0xB0D0, 0xB0D0, gbLV},
+	{0xB0D1, 0xB0EB, gbLVT},
+	{0xB0EC, 0xB0EC, gbLV},
+	{0xB0ED, 0xB107, gbLVT},
+	{0xB108, 0xB108, gbLV},
+	{0xB109, 0xB123, gbLVT},
+	{0xB124, 0xB124, gbLV},
+	{0xB125, 0xB13F, gbLVT},
+	{0xB140, 0xB140, gbLV},
+	{0xB141, 0xB15B, gbLVT},
+	{0xB15C, 0xB15C, gbLV},
+	{0xB15D, 0xB177, gbLVT},
+	{0xB178, 0xB178, gbLV},
+	{0xB179, 0xB193, gbLVT},
+	{0xB194, 0xB194, gbLV},
+	{0xB195, 0xB1AF, gbLVT},
+	{0xB1B0, 0xB1B0, gbLV},
+	{0xB1B1, 0xB1CB, gbLVT},
+	{0xB1CC, 0xB1CC, gbLV},
+	{0xB1CD, 0xB1E7, gbLVT},
+	{0xB1E8, 0xB1E8, gbLV},
+	{0xB1E9, 0xB203, gbLVT},
+	{0xB204, 0xB204, gbLV},
+	{0xB205, 0xB21F, gbLVT},
+	{0xB220, 0xB220, gbLV},
+	{0xB221, 0xB23B, gbLVT},
+	{0xB23C, 0xB23C, gbLV},
+	{0xB23D, 0xB257, gbLVT},
+	{0xB258, 0xB258, gbLV},
+	{0xB259, 0xB273, gbLVT},
+	{0xB274, 0xB274, gbLV},
+	{0xB275, 0xB28F, gbLVT},
+	{0xB290, 0xB290, gbLV},
+	{0xB291, 0xB2AB, gbLVT},
+	{0xB2AC, 0xB2AC, gbLV},
+	{0xB2AD, 0xB2C7, gbLVT},
+	{0xB2C8, 0xB2C8, gbLV},
+	{0xB2C9, 0xB2E3, gbLVT},
+	{0xB2E4, 0xB2E4, gbLV},
+	{0xB2E5, 0xB2FF, gbLVT},
+	{0xB300, 0xB300, gbLV},
+	{0xB301, 0xB31B, gbLVT},
+	{0xB31C, 0xB31C, gbLV},
+	{0xB31D, 0xB337, gbLVT},
+	{0xB338, 0xB338, gbLV},
+	{0xB339, 0xB353, gbLVT},
+	{0xB354, 0xB354, gbLV},
+	{0xB355, 0xB36F, gbLVT},
+	{0xB370, 0xB370, gbLV},
+	{0xB371, 0xB38B, gbLVT},
+	{0xB38C, 0xB38C, gbLV},
+	{0xB38D, 0xB3A7, gbLVT},
+	{0xB3A8, 0xB3A8, gbLV},
+	{0xB3A9, 0xB3C3, gbLVT},
+	{0xB3C4, 0xB3C4, gbLV},
+	{0xB3C5, 0xB3DF, gbLVT},
+	{0xB3E0, 0xB3E0, gbLV},
+	{0xB3E1, 0xB3FB, gbLVT},
+	{0xB3FC, 0xB3FC, gbLV},
+	{0xB3FD, 0xB417, gbLVT},
+	{0xB418, 0xB418, gbLV},
+	{0xB419, 0xB433, gbLVT},
+	{0xB434, 0xB434, gbLV},
+	{0xB435, 0xB44F, gbLVT},
+	{0xB450, 0xB450, gbLV},
+	{0xB451, 0xB46B, gbLVT},
+	{0xB46C, 0xB46C, gbLV},
+	{0xB46D, 0xB487, gbLVT},
+	{0xB488, 0xB488, gbLV},
+	{0xB489, 0xB4A3, gbLVT},
+	{0xB4A4, 0xB4A4, gbLV},
+	{0xB4A5, 0xB4BF, gbLVT},
+	{0xB4C0, 0xB4C0, gbLV},
+	{0xB4C1, 0xB4DB, gbLVT},
+	{0xB4DC, 0xB4DC, gbLV},
+	{0xB4DD, 0xB4F7, gbLVT},
+	{0xB4F8, 0xB4F8, gbLV},
+	{0xB4F9, 0xB513, gbLVT},
+	{0xB514, 0xB514, gbLV},
+	{0xB515, 0xB52F, gbLVT},
+	{0xB530, 0xB530, gbLV},
+	{0xB531, 0xB54B, gbLVT},
+	{0xB54C, 0xB54C, gbLV},
+	{0xB54D, 0xB567, gbLVT},
+	{0xB568, 0xB568, gbLV},
+	{0xB569, 0xB583, gbLVT},
+	{0xB584, 0xB584, gbLV},
+	{0xB585, 0xB59F, gbLVT},
+	{0xB5A0, 0xB5A0, gbLV},
+	{0xB5A1, 0xB5BB, gbLVT},
+	{0xB5BC, 0xB5BC, gbLV},
+	{0xB5BD, 0xB5D7, gbLVT},
+	{0xB5D8, 0xB5D8, gbLV},
+	{0xB5D9, 0xB5F3, gbLVT},
+	{0xB5F4, 0xB5F4, gbLV},
+	{0xB5F5, 0xB60F, gbLVT},
+	{0xB610, 0xB610, gbLV},
+	{0xB611, 0xB62B, gbLVT},
+	{0xB62C, 0xB62C, gbLV},
+	{0xB62D, 0xB647, gbLVT},
+	{0xB648, 0xB648, gbLV},
+	{0xB649, 0xB663, gbLVT},
+	{0xB664, 0xB664, gbLV},
+	{0xB665, 0xB67F, gbLVT},
+	{0xB680, 0xB680, gbLV},
+	{0xB681, 0xB69B, gbLVT},
+	{0xB69C, 0xB69C, gbLV},
+	{0xB69D, 0xB6B7, gbLVT},
+	{0xB6B8, 0xB6B8, gbLV},
+	{0xB6B9, 0xB6D3, gbLVT},
+	{0xB6D4, 0xB6D4, gbLV},
+	{0xB6D5, 0xB6EF, gbLVT},
+	{0xB6F0, 0xB6F0, gbLV},
+	{0xB6F1, 0xB70B, gbLVT},
+	{0xB70C, 0xB70C, gbLV},
+	{0xB70D, 0xB727, gbLVT},
+	{0xB728, 0xB728, gbLV},
+	{0xB729, 0xB743, gbLVT},
+	{0xB744, 0xB744, gbLV},
+	{0xB745, 0xB75F, gbLVT},
+	{0xB760, 0xB760, gbLV},
+	{0xB761, 0xB77B, gbLVT},
+	{0xB77C, 0xB77C, gbLV},
+	{0xB77D, 0xB797, gbLVT},
+	{0xB798, 0xB798, gbLV},
+	{0xB799, 0xB7B3, gbLVT},
+	{0xB7B4, 0xB7B4, gbLV},
+	{0xB7B5, 0xB7CF, gbLVT},
+	{0xB7D0, 0xB7D0, gbLV},
+	{0xB7D1, 0xB7EB, gbLVT},
+	{0xB7EC, 0xB7EC, gbLV},
+	{0xB7ED, 0xB807, gbLVT},
+	{0xB808, 0xB808, gbLV},
+	{0xB809, 0xB823, gbLVT},
+	{0xB824, 0xB824, gbLV},
+	{0xB825, 0xB83F, gbLVT},
+	{0xB840, 0xB840, gbLV},
+	{0xB841, 0xB85B, gbLVT},
+	{0xB85C, 0xB85C, gbLV},
+	{0xB85D, 0xB877, gbLVT},
+	{0xB878, 0xB878, gbLV},
+	{0xB879, 0xB893, gbLVT},
+	{0xB894, 0xB894, gbLV},
+	{0xB895, 0xB8AF, gbLVT},
+	{0xB8B0, 0xB8B0, gbLV},
+	{0xB8B1, 0xB8CB, gbLVT},
+	{0xB8CC, 0xB8CC, gbLV},
+	{0xB8CD, 0xB8E7, gbLVT},
+	{0xB8E8, 0xB8E8, gbLV},
+	{0xB8E9, 0xB903, gbLVT},
+	{0xB904, 0xB904, gbLV},
+	{0xB905, 0xB91F, gbLVT},
+	{0xB920, 0xB920, gbLV},
+	{0xB921, 0xB93B, gbLVT},
+	{0xB93C, 0xB93C, gbLV},
+	{0xB93D, 0xB957, gbLVT},
+	{0xB958, 0xB958, gbLV},
+	{0xB959, 0xB973, gbLVT},
+	{0xB974, 0xB974, gbLV},
+	{0xB975, 0xB98F, gbLVT},
+	{0xB990, 0xB990, gbLV},
+	{0xB991, 0xB9AB, gbLVT},
+	{0xB9AC, 0xB9AC, gbLV},
+	{0xB9AD, 0xB9C7, gbLVT},
+	{0xB9C8, 0xB9C8, gbLV},
+	{0xB9C9, 0xB9E3, gbLVT},
+	{0xB9E4, 0xB9E4, gbLV},
+	{0xB9E5, 0xB9FF, gbLVT},
+	{0xBA00, 0xBA00, gbLV},
+	{0xBA01, 0xBA1B, gbLVT},
+	{0xBA1C, 0xBA1C, gbLV},
+	{0xBA1D, 0xBA37, gbLVT},
+	{0xBA38, 0xBA38, gbLV},
+	{0xBA39, 0xBA53, gbLVT},
+	{0xBA54, 0xBA54, gbLV},
+	{0xBA55, 0xBA6F, gbLVT},
+	{0xBA70, 0xBA70, gbLV},
+	{0xBA71, 0xBA8B, gbLVT},
+	{0xBA8C, 0xBA8C, gbLV},
+	{0xBA8D, 0xBAA7, gbLVT},
+	{0xBAA8, 0xBAA8, gbLV},
+	{0xBAA9, 0xBAC3, gbLVT},
+	{0xBAC4, 0xBAC4, gbLV},
+	{0xBAC5, 0xBADF, gbLVT},
+	{0xBAE0, 0xBAE0, gbLV},
+	{0xBAE1, 0xBAFB, gbLVT},
+	{0xBAFC, 0xBAFC, gbLV},
+	{0xBAFD, 0xBB17, gbLVT},
+	{0xBB18, 0xBB18, gbLV},
+	{0xBB19, 0xBB33, gbLVT},
+	{0xBB34, 0xBB34, gbLV},
+	{0xBB35, 0xBB4F, gbLVT},
+	{0xBB50, 0xBB50, gbLV},
+	{0xBB51, 0xBB6B, gbLVT},
+	{0xBB6C, 0xBB6C, gbLV},
+	{0xBB6D, 0xBB87, gbLVT},
+	{0xBB88, 0xBB88, gbLV},
+	{0xBB89, 0xBBA3, gbLVT},
+	{0xBBA4, 0xBBA4, gbLV},
+	{0xBBA5, 0xBBBF, gbLVT},
+	{0xBBC0, 0xBBC0, gbLV},
+	{0xBBC1, 0xBBDB, gbLVT},
+	{0xBBDC, 0xBBDC, gbLV},
+	{0xBBDD, 0xBBF7, gbLVT},
+	{0xBBF8, 0xBBF8, gbLV},
+	{0xBBF9, 0xBC13, gbLVT},
+	{0xBC14, 0xBC14, gbLV},
+	{0xBC15, 0xBC2F, gbLVT},
+	{0xBC30, 0xBC30, gbLV},
+	{0xBC31, 0xBC4B, gbLVT},
+	{0xBC4C, 0xBC4C, gbLV},
+	{0xBC4D, 0xBC67, gbLVT},
+	{0xBC68, 0xBC68, gbLV},
+	{0xBC69, 0xBC83, gbLVT},
+	{0xBC84, 0xBC84, gbLV},
+	{0xBC85, 0xBC9F, gbLVT},
+	{0xBCA0, 0xBCA0, gbLV},
+	{0xBCA1, 0xBCBB, gbLVT},
+	{0xBCBC, 0xBCBC, gbLV},
+	{0xBCBD, 0xBCD7, gbLVT},
+	{0xBCD8, 0xBCD8, gbLV},
+	{0xBCD9, 0xBCF3, gbLVT},
+	{0xBCF4, 0xBCF4, gbLV},
+	{0xBCF5, 0xBD0F, gbLVT},
+	{0xBD10, 0xBD10, gbLV},
+	{0xBD11, 0xBD2B, gbLVT},
+	{0xBD2C, 0xBD2C, gbLV},
+	{0xBD2D, 0xBD47, gbLVT},
+	{0xBD48, 0xBD48, gbLV},
+	{0xBD49, 0xBD63, gbLVT},
+	{0xBD64, 0xBD64, gbLV},
+	{0xBD65, 0xBD7F, gbLVT},
+	{0xBD80, 0xBD80, gbLV},
+	{0xBD81, 0xBD9B, gbLVT},
+	{0xBD9C, 0xBD9C, gbLV},
+	{0xBD9D, 0xBDB7, gbLVT},
+	{0xBDB8, 0xBDB8, gbLV},
+	{0xBDB9, 0xBDD3, gbLVT},
+	{0xBDD4, 0xBDD4, gbLV},
+	{0xBDD5, 0xBDEF, gbLVT},
+	{0xBDF0, 0xBDF0, gbLV},
+	{0xBDF1, 0xBE0B, gbLVT},
+	{0xBE0C, 0xBE0C, gbLV},
+	{0xBE0D, 0xBE27, gbLVT},
+	{0xBE28, 0xBE28, gbLV},
+	{0xBE29, 0xBE43, gbLVT},
+	{0xBE44, 0xBE44, gbLV},
+	{0xBE45, 0xBE5F, gbLVT},
+	{0xBE60, 0xBE60, gbLV},
+	{0xBE61, 0xBE7B, gbLVT},
+	{0xBE7C, 0xBE7C, gbLV},
+	{0xBE7D, 0xBE97, gbLVT},
+	{0xBE98, 0xBE98, gbLV},
+	{0xBE99, 0xBEB3, gbLVT},
+	{0xBEB4, 0xBEB4, gbLV},
+	{0xBEB5, 0xBECF, gbLVT},
+	{0xBED0, 0xBED0, gbLV},
+	{0xBED1, 0xBEEB, gbLVT},
+	{0xBEEC, 0xBEEC, gbLV},
+	{0xBEED, 0xBF07, gbLVT},
+	{0xBF08, 0xBF08, gbLV},
+	{0xBF09, 0xBF23, gbLVT},
+	{0xBF24, 0xBF24, gbLV},
+	{0xBF25, 0xBF3F, gbLVT},
+	{0xBF40, 0xBF40, gbLV},
+	{0xBF41, 0xBF5B, gbLVT},
+	{0xBF5C, 0xBF5C, gbLV},
+	{0xBF5D, 0xBF77, gbLVT},
+	{0xBF78, 0xBF78, gbLV},
+	{0xBF79, 0xBF93, gbLVT},
+	{0xBF94, 0xBF94, gbLV},
+	{0xBF95, 0xBFAF, gbLVT},
+	{0xBFB0, 0xBFB0, gbLV},
+	{0xBFB1, 0xBFCB, gbLVT},
+	{0xBFCC, 0xBFCC, gbLV},
+	{0xBFCD, 0xBFE7, gbLVT},
+	{0xBFE8, 0xBFE8, gbLV},
+	{0xBFE9, 0xC003, gbLVT},
+	{0xC004, 0xC004, gbLV},
+	{0xC005, 0xC01F, gbLVT},
+	{0xC020, 0xC020, gbLV},
+	{0xC021, 0xC03B, gbLVT},
+	{0xC03C, 0xC03C, gbLV},
+	{0xC03D, 0xC057, gbLVT},
+	{0xC058, 0xC058, gbLV},
+	{0xC059, 0xC073, gbLVT},
+	{0xC074, 0xC074, gbLV},
+	{0xC075, 0xC08F, gbLVT},
+	{0xC090, 0xC090, gbLV},
+	{0xC091, 0xC0AB, gbLVT},
+	{0xC0AC, 0xC0AC, gbLV},
+	{0xC0AD, 0xC0C7, gbLVT},
+	{0xC0C8, 0xC0C8, gbLV},
+	{0xC0C9, 0xC0E3, gbLVT},
+	{0xC0E4, 0xC0E4, gbLV},
+	{0xC0E5, 0xC0FF, gbLVT},
+	{0xC100, 0xC100, gbLV},
+	{0xC101, 0xC11B, gbLVT},
+	{0xC11C, 0xC11C, gbLV},
+	{0xC11D, 0xC137, gbLVT},
+	{0xC138, 0xC138, gbLV},
+	{0xC139, 0xC153, gbLVT},
+	{0xC154, 0xC154, gbLV},
+	{0xC155, 0xC16F, gbLVT},
+	{0xC170, 0xC170, gbLV},
+	{0xC171, 0xC18B, gbLVT},
+	{0xC18C, 0xC18C, gbLV},
+	{0xC18D, 0xC1A7, gbLVT},
+	{0xC1A8, 0xC1A8, gbLV},
+	{0xC1A9, 0xC1C3, gbLVT},
+	{0xC1C4, 0xC1C4, gbLV},
+	{0xC1C5, 0xC1DF, gbLVT},
+	{0xC1E0, 0xC1E0, gbLV},
+	{0xC1E1, 0xC1FB, gbLVT},
+	{0xC1FC, 0xC1FC, gbLV},
+	{0xC1FD, 0xC217, gbLVT},
+	{0xC218, 0xC218, gbLV},
+	{0xC219, 0xC233, gbLVT},
+	{0xC234, 0xC234, gbLV},
+	{0xC235, 0xC24F, gbLVT},
+	{0xC250, 0xC250, gbLV},
+	{0xC251, 0xC26B, gbLVT},
+	{0xC26C, 0xC26C, gbLV},
+	{0xC26D, 0xC287, gbLVT},
+	{0xC288, 0xC288, gbLV},
+	{0xC289, 0xC2A3, gbLVT},
+	{0xC2A4, 0xC2A4, gbLV},
+	{0xC2A5, 0xC2BF, gbLVT},
+	{0xC2C0, 0xC2C0, gbLV},
+	{0xC2C1, 0xC2DB, gbLVT},
+	{0xC2DC, 0xC2DC, gbLV},
+	{0xC2DD, 0xC2F7, gbLVT},
+	{0xC2F8, 0xC2F8, gbLV},
+	{0xC2F9, 0xC313, gbLVT},
+	{0xC314, 0xC314, gbLV},
+	{0xC315, 0xC32F, gbLVT},
+	{0xC330, 0xC330, gbLV},
+	{0xC331, 0xC34B, gbLVT},
+	{0xC34C, 0xC34C, gbLV},
+	{0xC34D, 0xC367, gbLVT},
+	{0xC368, 0xC368, gbLV},
+	{0xC369, 0xC383, gbLVT},
+	{0xC384, 0xC384, gbLV},
+	{0xC385, 0xC39F, gbLVT},
+	{0xC3A0, 0xC3A0, gbLV},
+	{0xC3A1, 0xC3BB, gbLVT},
+	{0xC3BC, 0xC3BC, gbLV},
+	{0xC3BD, 0xC3D7, gbLVT},
+	{0xC3D8, 0xC3D8, gbLV},
+	{0xC3D9, 0xC3F3, gbLVT},
+	{0xC3F4, 0xC3F4, gbLV},
+	{0xC3F5, 0xC40F, gbLVT},
+	{0xC410, 0xC410, gbLV},
+	{0xC411, 0xC42B, gbLVT},
+	{0xC42C, 0xC42C, gbLV},
+	{0xC42D, 0xC447, gbLVT},
+	{0xC448, 0xC448, gbLV},
+	{0xC449, 0xC463, gbLVT},
+	{0xC464, 0xC464, gbLV},
+	{0xC465, 0xC47F, gbLVT},
+	{0xC480, 0xC480, gbLV},
+	{0xC481, 0xC49B, gbLVT},
+	{0xC49C, 0xC49C, gbLV},
+	{0xC49D, 0xC4B7, gbLVT},
+	{0xC4B8, 0xC4B8, gbLV},
+	{0xC4B9, 0xC4D3, gbLVT},
+	{0xC4D4, 0xC4D4, gbLV},
+	{0xC4D5, 0xC4EF, gbLVT},
+	{0xC4F0, 0xC4F0, gbLV},
+	{0xC4F1, 0xC50B, gbLVT},
+	{0xC50C, 0xC50C, gbLV},
+	{0xC50D, 0xC527, gbLVT},
+	{0xC528, 0xC528, gbLV},
+	{0xC529, 0xC543, gbLVT},
+	{0xC544, 0xC544, gbLV},
+	{0xC545, 0xC55F, gbLVT},
+	{0xC560, 0xC560, gbLV},
+	{0xC561, 0xC57B, gbLVT},
+	{0xC57C, 0xC57C, gbLV},
+	{0xC57D, 0xC597, gbLVT},
+	{0xC598, 0xC598, gbLV},
+	{0xC599, 0xC5B3, gbLVT},
+	{0xC5B4, 0xC5B4, gbLV},
+	{0xC5B5, 0xC5CF, gbLVT},
+	{0xC5D0, 0xC5D0, gbLV},
+	{0xC5D1, 0xC5EB, gbLVT},
+	{0xC5EC, 0xC5EC, gbLV},
+	{0xC5ED, 0xC607, gbLVT},
+	{0xC608, 0xC608, gbLV},
+	{0xC609, 0xC623, gbLVT},
+	{0xC624, 0xC624, gbLV},
+	{0xC625, 0xC63F, gbLVT},
+	{0xC640, 0xC640, gbLV},
+	{0xC641, 0xC65B, gbLVT},
+	{0xC65C, 0xC65C, gbLV},
+	{0xC65D, 0xC677, gbLVT},
+	{0xC678, 0xC678, gbLV},
+	{0xC679, 0xC693, gbLVT},
+	{0xC694, 0xC694, gbLV},
+	{0xC695, 0xC6AF, gbLVT},
+	{0xC6B0, 0xC6B0, gbLV},
+	{0xC6B1, 0xC6CB, gbLVT},
+	{0xC6CC, 0xC6CC, gbLV},
+	{0xC6CD, 0xC6E7, gbLVT},
+	{0xC6E8, 0xC6E8, gbLV},
+	{0xC6E9, 0xC703, gbLVT},
+	{0xC704, 0xC704, gbLV},
+	{0xC705, 0xC71F, gbLVT},
+	{0xC720, 0xC720, gbLV},
+	{0xC721, 0xC73B, gbLVT},
+	{0xC73C, 0xC73C, gbLV},
+	{0xC73D, 0xC757, gbLVT},
+	{0xC758, 0xC758, gbLV},
+	{0xC759, 0xC773, gbLVT},
+	{0xC774, 0xC774, gbLV},
+	{0xC775, 0xC78F, gbLVT},
+	{0xC790, 0xC790, gbLV},
+	{0xC791, 0xC7AB, gbLVT},
+	{0xC7AC, 0xC7AC, gbLV},
+	{0xC7AD, 0xC7C7, gbLVT},
+	{0xC7C8, 0xC7C8, gbLV},
+	{0xC7C9, 0xC7E3, gbLVT},
+	{0xC7E4, 0xC7E4, gbLV},
+	{0xC7E5, 0xC7FF, gbLVT},
+	{0xC800, 0xC800, gbLV},
+	{0xC801, 0xC81B, gbLVT},
+	{0xC81C, 0xC81C, gbLV},
+	{0xC81D, 0xC837, gbLVT},
+	{0xC838, 0xC838, gbLV},
+	{0xC839, 0xC853, gbLVT},
+	{0xC854, 0xC854, gbLV},
+	{0xC855, 0xC86F, gbLVT},
+	{0xC870, 0xC870, gbLV},
+	{0xC871, 0xC88B, gbLVT},
+	{0xC88C, 0xC88C, gbLV},
+	{0xC88D, 0xC8A7, gbLVT},
+	{0xC8A8, 0xC8A8, gbLV},
+	{0xC8A9, 0xC8C3, gbLVT},
+	{0xC8C4, 0xC8C4, gbLV},
+	{0xC8C5, 0xC8DF, gbLVT},
+	{0xC8E0, 0xC8E0, gbLV},
+	{0xC8E1, 0xC8FB, gbLVT},
+	{0xC8FC, 0xC8FC, gbLV},
+	{0xC8FD, 0xC917, gbLVT},
+	{0xC918, 0xC918, gbLV},
+	{0xC919, 0xC933, gbLVT},
+	{0xC934, 0xC934, gbLV},
+	{0xC935, 0xC94F, gbLVT},
+	{0xC950, 0xC950, gbLV},
+	{0xC951, 0xC96B, gbLVT},
+	{0xC96C, 0xC96C, gbLV},
+	{0xC96D, 0xC987, gbLVT},
+	{0xC988, 0xC988, gbLV},
+	{0xC989, 0xC9A3, gbLVT},
+	{0xC9A4, 0xC9A4, gbLV},
+	{0xC9A5, 0xC9BF, gbLVT},
+	{0xC9C0, 0xC9C0, gbLV},
+	{0xC9C1, 0xC9DB, gbLVT},
+	{0xC9DC, 0xC9DC, gbLV},
+	{0xC9DD, 0xC9F7, gbLVT},
+	{0xC9F8, 0xC9F8, gbLV},
+	{0xC9F9, 0xCA13, gbLVT},
+	{0xCA14, 0xCA14, gbLV},
+	{0xCA15, 0xCA2F, gbLVT},
+	{0xCA30, 0xCA30, gbLV},
+	{0xCA31, 0xCA4B, gbLVT},
+	{0xCA4C, 0xCA4C, gbLV},
+	{0xCA4D, 0xCA67, gbLVT},
+	{0xCA68, 0xCA68, gbLV},
+	{0xCA69, 0xCA83, gbLVT},
+	{0xCA84, 0xCA84, gbLV},
+	{0xCA85, 0xCA9F, gbLVT},
+	{0xCAA0, 0xCAA0, gbLV},
+	{0xCAA1, 0xCABB, gbLVT},
+	{0xCABC, 0xCABC, gbLV},
+	{0xCABD, 0xCAD7, gbLVT},
+	{0xCAD8, 0xCAD8, gbLV},
+	{0xCAD9, 0xCAF3, gbLVT},
+	{0xCAF4, 0xCAF4, gbLV},
+	{0xCAF5, 0xCB0F, gbLVT},
+	{0xCB10, 0xCB10, gbLV},
+	{0xCB11, 0xCB2B, gbLVT},
+	{0xCB2C, 0xCB2C, gbLV},
+	{0xCB2D, 0xCB47, gbLVT},
+	{0xCB48, 0xCB48, gbLV},
+	{0xCB49, 0xCB63, gbLVT},
+	{0xCB64, 0xCB64, gbLV},
+	{0xCB65, 0xCB7F, gbLVT},
+	{0xCB80, 0xCB80, gbLV},
+	{0xCB81, 0xCB9B, gbLVT},
+	{0xCB9C, 0xCB9C, gbLV},
+	{0xCB9D, 0xCBB7, gbLVT},
+	{0xCBB8, 0xCBB8, gbLV},
+	{0xCBB9, 0xCBD3, gbLVT},
+	{0xCBD4, 0xCBD4, gbLV},
+	{0xCBD5, 0xCBEF, gbLVT},
+	{0xCBF0, 0xCBF0, gbLV},
+	{0xCBF1, 0xCC0B, gbLVT},
+	{0xCC0C, 0xCC0C, gbLV},
+	{0xCC0D, 0xCC27, gbLVT},
+	{0xCC28, 0xCC28, gbLV},
+	{0xCC29, 0xCC43, gbLVT},
+	{0xCC44, 0xCC44, gbLV},
+	{0xCC45, 0xCC5F, gbLVT},
+	{0xCC60, 0xCC60, gbLV},
+	{0xCC61, 0xCC7B, gbLVT},
+	{0xCC7C, 0xCC7C, gbLV},
+	{0xCC7D, 0xCC97, gbLVT},
+	{0xCC98, 0xCC98, gbLV},
+	{0xCC99, 0xCCB3, gbLVT},
+	{0xCCB4, 0xCCB4, gbLV},
+	{0xCCB5, 0xCCCF, gbLVT},
+	{0xCCD0, 0xCCD0, gbLV},
+	{0xCCD1, 0xCCEB, gbLVT},
+	{0xCCEC, 0xCCEC, gbLV},
+	{0xCCED, 0xCD07, gbLVT},
+	{0xCD08, 0xCD08, gbLV},
+	{0xCD09, 0xCD23, gbLVT},
+	{0xCD24, 0xCD24, gbLV},
+	{0xCD25, 0xCD3F, gbLVT},
+	{0xCD40, 0xCD40, gbLV},
+	{0xCD41, 0xCD5B, gbLVT},
+	{0xCD5C, 0xCD5C, gbLV},
+	{0xCD5D, 0xCD77, gbLVT},
+	{0xCD78, 0xCD78, gbLV},
+	{0xCD79, 0xCD93, gbLVT},
+	{0xCD94, 0xCD94, gbLV},
+	{0xCD95, 0xCDAF, gbLVT},
+	{0xCDB0, 0xCDB0, gbLV},
+	{0xCDB1, 0xCDCB, gbLVT},
+	{0xCDCC, 0xCDCC, gbLV},
+	{0xCDCD, 0xCDE7, gbLVT},
+	{0xCDE8, 0xCDE8, gbLV},
+	{0xCDE9, 0xCE03, gbLVT},
+	{0xCE04, 0xCE04, gbLV},
+	{0xCE05, 0xCE1F, gbLVT},
+	{0xCE20, 0xCE20, gbLV},
+	{0xCE21, 0xCE3B, gbLVT},
+	{0xCE3C, 0xCE3C, gbLV},
+	{0xCE3D, 0xCE57, gbLVT},
+	{0xCE58, 0xCE58, gbLV},
+	{0xCE59, 0xCE73, gbLVT},
+	{0xCE74, 0xCE74, gbLV},
+	{0xCE75, 0xCE8F, gbLVT},
+	{0xCE90, 0xCE90, gbLV},
+	{0xCE91, 0xCEAB, gbLVT},
+	{0xCEAC, 0xCEAC, gbLV},
+	{0xCEAD, 0xCEC7, gbLVT},
+	{0xCEC8, 0xCEC8, gbLV},
+	{0xCEC9, 0xCEE3, gbLVT},
+	{0xCEE4, 0xCEE4, gbLV},
+	{0xCEE5, 0xCEFF, gbLVT},
+	{0xCF00, 0xCF00, gbLV},
+	{0xCF01, 0xCF1B, gbLVT},
+	{0xCF1C, 0xCF1C, gbLV},
+	{0xCF1D, 0xCF37, gbLVT},
+	{0xCF38, 0xCF38, gbLV},
+	{0xCF39, 0xCF53, gbLVT},
+	{0xCF54, 0xCF54, gbLV},
+	{0xCF55, 0xCF6F, gbLVT},
+	{0xCF70, 0xCF70, gbLV},
+	{0xCF71, 0xCF8B, gbLVT},
+	{0xCF8C, 0xCF8C, gbLV},
+	{0xCF8D, 0xCFA7, gbLVT},
+	{0xCFA8, 0xCFA8, gbLV},
+	{0xCFA9, 0xCFC3, gbLVT},
+	{0xCFC4, 0xCFC4, gbLV},
+	{0xCFC5, 0xCFDF, gbLVT},
+	{0xCFE0, 0xCFE0, gbLV},
+	{0xCFE1, 0xCFFB, gbLVT},
+	{0xCFFC, 0xCFFC, gbLV},
+	{0xCFFD, 0xD017, gbLVT},
+	{0xD018, 0xD018, gbLV},
+	{0xD019, 0xD033, gbLVT},
+	{0xD034, 0xD034, gbLV},
+	{0xD035, 0xD04F, gbLVT},
+	{0xD050, 0xD050, gbLV},
+	{0xD051, 0xD06B, gbLVT},
+	{0xD06C, 0xD06C, gbLV},
+	{0xD06D, 0xD087, gbLVT},
+	{0xD088, 0xD088, gbLV},
+	{0xD089, 0xD0A3, gbLVT},
+	{0xD0A4, 0xD0A4, gbLV},
+	{0xD0A5, 0xD0BF, gbLVT},
+	{0xD0C0, 0xD0C0, gbLV},
+	{0xD0C1, 0xD0DB, gbLVT},
+	{0xD0DC, 0xD0DC, gbLV},
+	{0xD0DD, 0xD0F7, gbLVT},
+	{0xD0F8, 0xD0F8, gbLV},
+	{0xD0F9, 0xD113, gbLVT},
+	{0xD114, 0xD114, gbLV},
+	{0xD115, 0xD12F, gbLVT},
+	{0xD130, 0xD130, gbLV},
+	{0xD131, 0xD14B, gbLVT},
+	{0xD14C, 0xD14C, gbLV},
+	{0xD14D, 0xD167, gbLVT},
+	{0xD168, 0xD168, gbLV},
+	{0xD169, 0xD183, gbLVT},
+	{0xD184, 0xD184, gbLV},
+	{0xD185, 0xD19F, gbLVT},
+	{0xD1A0, 0xD1A0, gbLV},
+	{0xD1A1, 0xD1BB, gbLVT},
+	{0xD1BC, 0xD1BC, gbLV},
+	{0xD1BD, 0xD1D7, gbLVT},
+	{0xD1D8, 0xD1D8, gbLV},
+	{0xD1D9, 0xD1F3, gbLVT},
+	{0xD1F4, 0xD1F4, gbLV},
+	{0xD1F5, 0xD20F, gbLVT},
+	{0xD210, 0xD210, gbLV},
+	{0xD211, 0xD22B, gbLVT},
+	{0xD22C, 0xD22C, gbLV},
+	{0xD22D, 0xD247, gbLVT},
+	{0xD248, 0xD248, gbLV},
+	{0xD249, 0xD263, gbLVT},
+	{0xD264, 0xD264, gbLV},
+	{0xD265, 0xD27F, gbLVT},
+	{0xD280, 0xD280, gbLV},
+	{0xD281, 0xD29B, gbLVT},
+	{0xD29C, 0xD29C, gbLV},
+	{0xD29D, 0xD2B7, gbLVT},
+	{0xD2B8, 0xD2B8, gbLV},
+	{0xD2B9, 0xD2D3, gbLVT},
+	{0xD2D4, 0xD2D4, gbLV},
+	{0xD2D5, 0xD2EF, gbLVT},
+	{0xD2F0, 0xD2F0, gbLV},
+	{0xD2F1, 0xD30B, gbLVT},
+	{0xD30C, 0xD30C, gbLV},
+	{0xD30D, 0xD327, gbLVT},
+	{0xD328, 0xD328, gbLV},
+	{0xD329, 0xD343, gbLVT},
+	{0xD344, 0xD344, gbLV},
+	{0xD345, 0xD35F, gbLVT},
+	{0xD360, 0xD360, gbLV},
+	{0xD361, 0xD37B, gbLVT},
+	{0xD37C, 0xD37C, gbLV},
+	{0xD37D, 0xD397, gbLVT},
+	{0xD398, 0xD398, gbLV},
+	{0xD399, 0xD3B3, gbLVT},
+	{0xD3B4, 0xD3B4, gbLV},
+	{0xD3B5, 0xD3CF, gbLVT},
+	{0xD3D0, 0xD3D0, gbLV},
+	{0xD3D1, 0xD3EB, gbLVT},
+	{0xD3EC, 0xD3EC, gbLV},
+	{0xD3ED, 0xD407, gbLVT},
+	{0xD408, 0xD408, gbLV},
+	{0xD409, 0xD423, gbLVT},
+	{0xD424, 0xD424, gbLV},
+	{0xD425, 0xD43F, gbLVT},
+	{0xD440, 0xD440, gbLV},
+	{0xD441, 0xD45B, gbLVT},
+	{0xD45C, 0xD45C, gbLV},
+	{0xD45D, 0xD477, gbLVT},
+	{0xD478, 0xD478, gbLV},
+	{0xD479, 0xD493, gbLVT},
+	{0xD494, 0xD494, gbLV},
+	{0xD495, 0xD4AF, gbLVT},
+	{0xD4B0, 0xD4B0, gbLV},
+	{0xD4B1, 0xD4CB, gbLVT},
+	{0xD4CC, 0xD4CC, gbLV},
+	{0xD4CD, 0xD4E7, gbLVT},
+	{0xD4E8, 0xD4E8, gbLV},
+	{0xD4E9, 0xD503, gbLVT},
+	{0xD504, 0xD504, gbLV},
+	{0xD505, 0xD51F, gbLVT},
+	{0xD520, 0xD520, gbLV},
+	{0xD521, 0xD53B, gbLVT},
+	{0xD53C, 0xD53C, gbLV},
+	{0xD53D, 0xD557, gbLVT},
+	{0xD558, 0xD558, gbLV},
+	{0xD559, 0xD573, gbLVT},
+	{0xD574, 0xD574, gbLV},
+	{0xD575, 0xD58F, gbLVT},
+	{0xD590, 0xD590, gbLV},
+	{0xD591, 0xD5AB, gbLVT},
+	{0xD5AC, 0xD5AC, gbLV},
+	{0xD5AD, 0xD5C7, gbLVT},
+	{0xD5C8, 0xD5C8, gbLV},
+	{0xD5C9, 0xD5E3, gbLVT},
+	{0xD5E4, 0xD5E4, gbLV},
+	{0xD5E5, 0xD5FF, gbLVT},
+	{0xD600, 0xD600, gbLV},
+	{0xD601, 0xD61B, gbLVT},
+	{0xD61C, 0xD61C, gbLV},
+	{0xD61D, 0xD637, gbLVT},
+	{0xD638, 0xD638, gbLV},
+	{0xD639, 0xD653, gbLVT},
+	{0xD654, 0xD654, gbLV},
+	{0xD655, 0xD66F, gbLVT},
+	{0xD670, 0xD670, gbLV},
+	{0xD671, 0xD68B, gbLVT},
+	{0xD68C, 0xD68C, gbLV},
+	{0xD68D, 0xD6A7, gbLVT},
+	{0xD6A8, 0xD6A8, gbLV},
+	{0xD6A9, 0xD6C3, gbLVT},
+	{0xD6C4, 0xD6C4, gbLV},
+	{0xD6C5, 0xD6DF, gbLVT},
+	{0xD6E0, 0xD6E0, gbLV},
+	{0xD6E1, 0xD6FB, gbLVT},
+	{0xD6FC, 0xD6FC, gbLV},
+	{0xD6FD, 0xD717, gbLVT},
+	{0xD718, 0xD718, gbLV},
+	{0xD719, 0xD733, gbLVT},
+	{0xD734, 0xD734, gbLV},
+	{0xD735, 0xD74F, gbLVT},
+	{0xD750, 0xD750, gbLV},
+	{0xD751, 0xD76B, gbLVT},
+	{0xD76C, 0xD76C, gbLV},
+	{0xD76D, 0xD787, gbLVT},
+	{0xD788, 0xD788, gbLV},
+	{0xD789, 0xD7A3, gbLVT},
+	{0xD7B0, 0xD7C6, gbV},
+	{0xD7CB, 0xD7FB, gbT},
+	{0xFB1E, 0xFB1E, gbExtend},
+	{0xFE00, 0xFE0F, gbExtend},
+	{0xFE20, 0xFE2F, gbExtend},
+	{0xFEFF, 0xFEFF, gbControl},
+	{0xFF9E, 0xFF9F, gbExtend},
+	{0xFFF0, 0xFFFB, gbControl},
+	{0x101FD, 0x101FD, gbExtend},
+	{0x102E0, 0x102E0, gbExtend},
+	{0x10376, 0x1037A, gbExtend},
+	{0x10A01, 0x10A03, gbExtend},
+	{0x10A05, 0x10A06, gbExtend},
+	{0x10A0C, 0x10A0F, gbExtend},
+	{0x10A38, 0x10A3A, gbExtend},
+	{0x10A3F, 0x10A3F, gbExtend},
+	{0x10AE5, 0x10AE6, gbExtend},
+	{0x10D24, 0x10D27, gbExtend},
+	{0x10EAB, 0x10EAC, gbExtend},
+	{0x10F46, 0x10F50, gbExtend},
+	{0x10F82, 0x10F85, gbExtend},
+	{0x11000, 0x11000, gbSpacingMark},
+	{0x11001, 0x11001, gbExtend},
+	{0x11002, 0x11002, gbSpacingMark},
+	{0x11038, 0x11046, gbExtend},
+	{0x11070, 0x11070, gbExtend},
+	{0x11073, 0x11074, gbExtend},
+	{0x1107F, 0x11081, gbExtend},
+	{0x11082, 0x11082, gbSpacingMark},
+	{0x110B0, 0x110B2, gbSpacingMark},
+	{0x110B3, 0x110B6, gbExtend},
+	{0x110B7, 0x110B8, gbSpacingMark},
+	{0x110B9, 0x110BA, gbExtend},
+	{0x110BD, 0x110BD, gbPrepend},
+	{0x110C2, 0x110C2, gbExtend},
+	{0x110CD, 0x110CD, gbPrepend},
+	{0x11100, 0x11102, gbExtend},
+	{0x11127, 0x1112B, gbExtend},
+	{0x1112C, 0x1112C, gbSpacingMark},
+	{0x1112D, 0x11134, gbExtend},
+	{0x11145, 0x11146, gbSpacingMark},
+	{0x11173, 0x11173, gbExtend},
+	{0x11180, 0x11181, gbExtend},
+	{0x11182, 0x11182, gbSpacingMark},
+	{0x111B3, 0x111B5, gbSpacingMark},
+	{0x111B6, 0x111BE, gbExtend},
+	{0x111BF, 0x111C0, gbSpacingMark},
+	{0x111C2, 0x111C3, gbPrepend},
+	{0x111C9, 0x111CC, gbExtend},
+	{0x111CE, 0x111CE, gbSpacingMark},
+	{0x111CF, 0x111CF, gbExtend},
+	{0x1122C, 0x1122E, gbSpacingMark},
+	{0x1122F, 0x11231, gbExtend},
+	{0x11232, 0x11233, gbSpacingMark},
+	{0x11234, 0x11234, gbExtend},
+	{0x11235, 0x11235, gbSpacingMark},
+	{0x11236, 0x11237, gbExtend},
+	{0x1123E, 0x1123E, gbExtend},
+	{0x112DF, 0x112DF, gbExtend},
+	{0x112E0, 0x112E2, gbSpacingMark},
+	{0x112E3, 0x112EA, gbExtend},
+	{0x11300, 0x11301, gbExtend},
+	{0x11302, 0x11303, gbSpacingMark},
+	{0x1133B, 0x1133C, gbExtend},
+	{0x1133E, 0x1133E, gbExtend},
+	{0x1133F, 0x1133F, gbSpacingMark},
+	{0x11340, 0x11340, gbExtend},
+	{0x11341, 0x11344, gbSpacingMark},
+	{0x11347, 0x11348, gbSpacingMark},
+	{0x1134B, 0x1134D, gbSpacingMark},
+	{0x11357, 0x11357, gbExtend},
+	{0x11362, 0x11363, gbSpacingMark},
+	{0x11366, 0x1136C, gbExtend},
+	{0x11370, 0x11374, gbExtend},
+	{0x11435, 0x11437, gbSpacingMark},
+	{0x11438, 0x1143F, gbExtend},
+	{0x11440, 0x11441, gbSpacingMark},
+	{0x11442, 0x11444, gbExtend},
+	{0x11445, 0x11445, gbSpacingMark},
+	{0x11446, 0x11446, gbExtend},
+	{0x1145E, 0x1145E, gbExtend},
+	{0x114B0, 0x114B0, gbExtend},
+	{0x114B1, 0x114B2, gbSpacingMark},
+	{0x114B3, 0x114B8, gbExtend},
+	{0x114B9, 0x114B9, gbSpacingMark},
+	{0x114BA, 0x114BA, gbExtend},
+	{0x114BB, 0x114BC, gbSpacingMark},
+	{0x114BD, 0x114BD, gbExtend},
+	{0x114BE, 0x114BE, gbSpacingMark},
+	{0x114BF, 0x114C0, gbExtend},
+	{0x114C1, 0x114C1, gbSpacingMark},
+	{0x114C2, 0x114C3, gbExtend},
+	{0x115AF, 0x115AF, gbExtend},
+	{0x115B0, 0x115B1, gbSpacingMark},
+	{0x115B2, 0x115B5, gbExtend},
+	{0x115B8, 0x115BB, gbSpacingMark},
+	{0x115BC, 0x115BD, gbExtend},
+	{0x115BE, 0x115BE, gbSpacingMark},
+	{0x115BF, 0x115C0, gbExtend},
+	{0x115DC, 0x115DD, gbExtend},
+	{0x11630, 0x11632, gbSpacingMark},
+	{0x11633, 0x1163A, gbExtend},
+	{0x1163B, 0x1163C, gbSpacingMark},
+	{0x1163D, 0x1163D, gbExtend},
+	{0x1163E, 0x1163E, gbSpacingMark},
+	{0x1163F, 0x11640, gbExtend},
+	{0x116AB, 0x116AB, gbExtend},
+	{0x116AC, 0x116AC, gbSpacingMark},
+	{0x116AD, 0x116AD, gbExtend},
+	{0x116AE, 0x116AF, gbSpacingMark},
+	{0x116B0, 0x116B5, gbExtend},
+	{0x116B6, 0x116B6, gbSpacingMark},
+	{0x116B7, 0x116B7, gbExtend},
+	{0x1171D, 0x1171F, gbExtend},
+	{0x11722, 0x11725, gbExtend},
+	{0x11726, 0x11726, gbSpacingMark},
+	{0x11727, 0x1172B, gbExtend},
+	{0x1182C, 0x1182E, gbSpacingMark},
+	{0x1182F, 0x11837, gbExtend},
+	{0x11838, 0x11838, gbSpacingMark},
+	{0x11839, 0x1183A, gbExtend},
+	{0x11930, 0x11930, gbExtend},
+	{0x11931, 0x11935, gbSpacingMark},
+	{0x11937, 0x11938, gbSpacingMark},
+	{0x1193B, 0x1193C, gbExtend},
+	{0x1193D, 0x1193D, gbSpacingMark},
+	{0x1193E, 0x1193E, gbExtend},
+	{0x1193F, 0x1193F, gbPrepend},
+	{0x11940, 0x11940, gbSpacingMark},
+	{0x11941, 0x11941, gbPrepend},
+	{0x11942, 0x11942, gbSpacingMark},
+	{0x11943, 0x11943, gbExtend},
+	{0x119D1, 0x119D3, gbSpacingMark},
+	{0x119D4, 0x119D7, gbExtend},
+	{0x119DA, 0x119DB, gbExtend},
+	{0x119DC, 0x119DF, gbSpacingMark},
+	{0x119E0, 0x119E0, gbExtend},
+	{0x119E4, 0x119E4, gbSpacingMark},
+	{0x11A01, 0x11A0A, gbExtend},
+	{0x11A33, 0x11A38, gbExtend},
+	{0x11A39, 0x11A39, gbSpacingMark},
+	{0x11A3A, 0x11A3A, gbPrepend},
+	{0x11A3B, 0x11A3E, gbExtend},
+	{0x11A47, 0x11A47, gbExtend},
+	{0x11A51, 0x11A56, gbExtend},
+	{0x11A57, 0x11A58, gbSpacingMark},
+	{0x11A59, 0x11A5B, gbExtend},
+	{0x11A84, 0x11A89, gbPrepend},
+	{0x11A8A, 0x11A96, gbExtend},
+	{0x11A97, 0x11A97, gbSpacingMark},
+	{0x11A98, 0x11A99, gbExtend},
+	{0x11C2F, 0x11C2F, gbSpacingMark},
+	{0x11C30, 0x11C36, gbExtend},
+	{0x11C38, 0x11C3D, gbExtend},
+	{0x11C3E, 0x11C3E, gbSpacingMark},
+	{0x11C3F, 0x11C3F, gbExtend},
+	{0x11C92, 0x11CA7, gbExtend},
+	{0x11CA9, 0x11CA9, gbSpacingMark},
+	{0x11CAA, 0x11CB0, gbExtend},
+	{0x11CB1, 0x11CB1, gbSpacingMark},
+	{0x11CB2, 0x11CB3, gbExtend},
+	{0x11CB4, 0x11CB4, gbSpacingMark},
+	{0x11CB5, 0x11CB6, gbExtend},
+	{0x11D31, 0x11D36, gbExtend},
+	{0x11D3A, 0x11D3A, gbExtend},
+	{0x11D3C, 0x11D3D, gbExtend},
+	{0x11D3F, 0x11D45, gbExtend},
+	{0x11D46, 0x11D46, gbPrepend},
+	{0x11D47, 0x11D47, gbExtend},
+	{0x11D8A, 0x11D8E, gbSpacingMark},
+	{0x11D90, 0x11D91, gbExtend},
+	{0x11D93, 0x11D94, gbSpacingMark},
+	{0x11D95, 0x11D95, gbExtend},
+	{0x11D96, 0x11D96, gbSpacingMark},
+	{0x11D97, 0x11D97, gbExtend},
+	{0x11EF3, 0x11EF4, gbExtend},
+	{0x11EF5, 0x11EF6, gbSpacingMark},
+	{0x13430, 0x13438, gbControl},
+	{0x16AF0, 0x16AF4, gbExtend},
+	{0x16B30, 0x16B36, gbExtend},
+	{0x16F4F, 0x16F4F, gbExtend},
+	{0x16F51, 0x16F87, gbSpacingMark},
+	{0x16F8F, 0x16F92, gbExtend},
+	{0x16FE4, 0x16FE4, gbExtend},
+	{0x16FF0, 0x16FF1, gbSpacingMark},
+	{0x1BC9D, 0x1BC9E, gbExtend},
+	{0x1BCA0, 0x1BCA3, gbControl},
+	{0x1CF00, 0x1CF2D, gbExtend},
+	{0x1CF30, 0x1CF46, gbExtend},
+	{0x1D165, 0x1D165, gbExtend},
+	{0x1D166, 0x1D166, gbSpacingMark},
+	{0x1D167, 0x1D169, gbExtend},
+	{0x1D16D, 0x1D16D, gbSpacingMark},
+	{0x1D16E, 0x1D172, gbExtend},
+	{0x1D173, 0x1D17A, gbControl},
+	{0x1D17B, 0x1D182, gbExtend},
+	{0x1D185, 0x1D18B, gbExtend},
+	{0x1D1AA, 0x1D1AD, gbExtend},
+	{0x1D242, 0x1D244, gbExtend},
+	{0x1DA00, 0x1DA36, gbExtend},
+	{0x1DA3B, 0x1DA6C, gbExtend},
+	{0x1DA75, 0x1DA75, gbExtend},
+	{0x1DA84, 0x1DA84, gbExtend},
+	{0x1DA9B, 0x1DA9F, gbExtend},
+	{0x1DAA1, 0x1DAAF, gbExtend},
+	{0x1E000, 0x1E006, gbExtend},
+	{0x1E008, 0x1E018, gbExtend},
+	{0x1E01B, 0x1E021, gbExtend},
+	{0x1E023, 0x1E024, gbExtend},
+	{0x1E026, 0x1E02A, gbExtend},
+	{0x1E130, 0x1E136, gbExtend},
+	{0x1E2AE, 0x1E2AE, gbExtend},
+	{0x1E2EC, 0x1E2EF, gbExtend},
+	{0x1E8D0, 0x1E8D6, gbExtend},
+	{0x1E944, 0x1E94A, gbExtend},
+	{0x1F000, 0x1F0FF, gbExtPict},
+	{0x1F10D, 0x1F10F, gbExtPict},
+	{0x1F12F, 0x1F12F, gbExtPict},
+	{0x1F16C, 0x1F171, gbExtPict},
+	{0x1F17E, 0x1F17F, gbExtPict},
+	{0x1F18E, 0x1F18E, gbExtPict},
+	{0x1F191, 0x1F19A, gbExtPict},
+	{0x1F1AD, 0x1F1E5, gbExtPict},
+	{0x1F1E6, 0x1F1FF, gbRI},
+	{0x1F201, 0x1F20F, gbExtPict},
+	{0x1F21A, 0x1F21A, gbExtPict},
+	{0x1F22F, 0x1F22F, gbExtPict},
+	{0x1F232, 0x1F23A, gbExtPict},
+	{0x1F23C, 0x1F23F, gbExtPict},
+	{0x1F249, 0x1F3FA, gbExtPict},
+	{0x1F3FB, 0x1F3FF, gbExtend},
+	{0x1F400, 0x1F53D, gbExtPict},
+	{0x1F546, 0x1F64F, gbExtPict},
+	{0x1F680, 0x1F6FF, gbExtPict},
+	{0x1F774, 0x1F77F, gbExtPict},
+	{0x1F7D5, 0x1F7FF, gbExtPict},
+	{0x1F80C, 0x1F80F, gbExtPict},
+	{0x1F848, 0x1F84F, gbExtPict},
+	{0x1F85A, 0x1F85F, gbExtPict},
+	{0x1F888, 0x1F88F, gbExtPict},
+	{0x1F8AE, 0x1F8FF, gbExtPict},
+	{0x1F90C, 0x1F93A, gbExtPict},
+	{0x1F93C, 0x1F945, gbExtPict},
+	{0x1F947, 0x1FAFF, gbExtPict},
+	{0x1FC00, 0x1FFFD, gbExtPict},
+	{0xE0000, 0xE001F, gbControl},
+	{0xE0020, 0xE007F, gbExtend},
+	{0xE0080, 0xE00FF, gbControl},
+	{0xE0100, 0xE01EF, gbExtend},
+	{0xE01F0, 0xE0FFF, gbControl},
+}
+
+// emojiRanges lists the ranges of code points with the Emoji property,
+// in increasing order.
+var emojiRanges = []runeRange{
+	{0x0023, 0x0023},
+	{0x002A, 0x002A},
+	{0x0030, 0x0039},
+	{0x00A9, 0x00A9},
+	{0x00AE, 0x00AE},
+	{0x203C, 0x203C},
+	{0x2049, 0x2049},
+	{0x2122, 0x2122},
+	{0x2139, 0x2139},
+	{0x2194, 0x2199},
+	{0x21A9, 0x21AA},
+	{0x231A, 0x231B},
+	{0x2328, 0x2328},
+	{0x23CF, 0x23CF},
+	{0x23E9, 0x23F3},
+	{0x23F8, 0x23FA},
+	{0x24C2, 0x24C2},
+	{0x25AA, 0x25AB},
+	{0x25B6, 0x25B6},
+	{0x25C0, 0x25C0},
+	{0x25FB, 0x25FE},
+	{0x2600, 0x2604},
+	{0x260E, 0x260E},
+	{0x2611, 0x2611},
+	{0x2614, 0x2615},
+	{0x2618, 0x2618},
+	{0x261D, 0x261D},
+	{0x2620, 0x2620},
+	{0x2622, 0x2623},
+	{0x2626, 0x2626},
+	{0x262A, 0x262A},
+	{0x262E, 0x262F},
+	{0x2638, 0x263A},
+	{0x2640, 0x2640},
+	{0x2642, 0x2642},
+	{0x2648, 0x2653},
+	{0x265F, 0x2660},
+	{0x2663, 0x2663},
+	{0x2665, 0x2666},
+	{0x2668, 0x2668},
+	{0x267B, 0x267B},
+	{0x267E, 0x267F},
+	{0x2692, 0x2697},
+	{0x2699, 0x2699},
+	{0x269B, 0x269C},
+	{0x26A0, 0x26A1},
+	{0x26A7, 0x26A7},
+	{0x26AA, 0x26AB},
+	{0x26B0, 0x26B1},
+	{0x26BD, 0x26BE},
+	{0x26C4, 0x26C5},
+	{0x26C8, 0x26C8},
+	{0x26CE, 0x26CF},
+	{0x26D1, 0x26D1},
+	{0x26D3, 0x26D4},
+	{0x26E9, 0x26EA},
+	{0x26F0, 0x26F5},
+	{0x26F7, 0x26FA},
+	{0x26FD, 0x26FD},
+	{0x2702, 0x2702},
+	{0x2705, 0x2705},
+	{0x2708, 0x270D},
+	{0x270F, 0x270F},
+	{0x2712, 0x2712},
+	{0x2714, 0x2714},
+	{0x2716, 0x2716},
+	{0x271D, 0x271D},
+	{0x2721, 0x2721},
+	{0x2728, 0x2728},
+	{0x2733, 0x2734},
+	{0x2744, 0x2744},
+	{0x2747, 0x2747},
+	{0x274C, 0x274C},
+	{0x274E, 0x274E},
+	{0x2753, 0x2755},
+	{0x2757, 0x2757},
+	{0x2763, 0x2764},
+	{0x2795, 0x2797},
+	{0x27A1, 0x27A1},
+	{0x27B0, 0x27B0},
+	{0x27BF, 0x27BF},
+	{0x2934, 0x2935},
+	{0x2B05, 0x2B07},
+	{0x2B1B, 0x2B1C},
+	{0x2B50, 0x2B50},
+	{0x2B55, 0x2B55},
+	{0x3030, 0x3030},
+	{0x303D, 0x303D},
+	{0x3297, 0x3297},
+	{0x3299, 0x3299},
+	{0x1F004, 0x1F004},
+	{0x1F0CF, 0x1F0CF},
+	{0x1F170, 0x1F171},
+	{0x1F17E, 0x1F17F},
+	{0x1F18E, 0x1F18E},
+	{0x1F191, 0x1F19A},
+	{0x1F1E6, 0x1F1FF},
+	{0x1F201, 0x1F202},
+	{0x1F21A, 0x1F21A},
+	{0x1F22F, 0x1F22F},
+	{0x1F232, 0x1F23A},
+	{0x1F250, 0x1F251},
+	{0x1F300, 0x1F321},
+	{0x1F324, 0x1F393},
+	{0x1F396, 0x1F397},
+	{0x1F399, 0x1F39B},
+	{0x1F39E, 0x1F3F0},
+	{0x1F3F3, 0x1F3F5},
+	{0x1F3F7, 0x1F4FD},
+	{0x1F4FF, 0x1F53D},
+	{0x1F549, 0x1F54E},
+	{0x1F550, 0x1F567},
+	{0x1F56F, 0x1F570},
+	{0x1F573, 0x1F57A},
+	{0x1F587, 0x1F587},
+	{0x1F58A, 0x1F58D},
+	{0x1F590, 0x1F590},
+	{0x1F595, 0x1F596},
+	{0x1F5A4, 0x1F5A5},
+	{0x1F5A8, 0x1F5A8},
+	{0x1F5B1, 0x1F5B2},
+	{0x1F5BC, 0x1F5BC},
+	{0x1F5C2, 0x1F5C4},
+	{0x1F5D1, 0x1F5D3},
+	{0x1F5DC, 0x1F5DE},
+	{0x1F5E1, 0x1F5E1},
+	{0x1F5E3, 0x1F5E3},
+	{0x1F5E8, 0x1F5E8},
+	{0x1F5EF, 0x1F5EF},
+	{0x1F5F3, 0x1F5F3},
+	{0x1F5FA, 0x1F64F},
+	{0x1F680, 0x1F6C5},
+	{0x1F6CB, 0x1F6D2},
+	{0x1F6D5, 0x1F6D7},
+	{0x1F6DD, 0x1F6E5},
+	{0x1F6E9, 0x1F6E9},
+	{0x1F6EB, 0x1F6EC},
+	{0x1F6F0, 0x1F6F0},
+	{0x1F6F3, 0x1F6FC},
+	{0x1F7E0, 0x1F7EB},
+	{0x1F7F0, 0x1F7F0},
+	{0x1F90C, 0x1F93A},
+	{0x1F93C, 0x1F945},
+	{0x1F947, 0x1F9FF},
+	{0x1FA70, 0x1FA74},
+	{0x1FA78, 0x1FA7C},
+	{0x1FA80, 0x1FA86},
+	{0x1FA90, 0x1FAAC},
+	{0x1FAB0, 0x1FABA},
+	{0x1FAC0, 0x1FAC5},
+	{0x1FAD0, 0x1FAD9},
+	{0x1FAE0, 0x1FAE7},
+	{0x1FAF0, 0x1FAF6},
+}
+
+// combiningClasses holds the nonzero canonical combining classes.
+var combiningClasses = map[rune]uint8{
+	0x0300:  230,
+	0x0301:  230,
+	0x0302:  230,
+	0x0303:  230,
+	0x0304:  230,
+	0x0305:  230,
+	0x0306:  230,
+	0x0307:  230,
+	0x0308:  230,
+	0x0309:  230,
+	0x030A:  230,
+	0x030B:  230,
+	0x030C:  230,
+	0x030D:  230,
+	0x030E:  230,
+	0x030F:  230,
+	0x0310:  230,
+	0x0311:  230,
+	0x0312:  230,
+	0x0313:  230,
+	0x0314:  230,
+	0x0315:  232,
+	0x0316:  220,
+	0x0317:  220,
+	0x0318:  220,
+	0x0319:  220,
+	0x031A:  232,
+	0x031B:  216,
+	0x031C:  220,
+	0x031D:  220,
+	0x031E:  220,
+	0x031F:  220,
+	0x0320:  220,
+	0x0321:  202,
+	0x0322:  202,
+	0x0323:  220,
+	0x0324:  220,
+	0x0325:  220,
+	0x0326:  220,
+	0x0327:  202,
+	0x0328:  202,
+	0x0329:  220,
+	0x032A:  220,
+	0x032B:  220,
+	0x032C:  220,
+	0x032D:  220,
+	0x032E:  220,
+	0x032F:  220,
+	0x0330:  220,
+	0x0331:  220,
+	0x0332:  220,
+	0x0333:  220,
+	0x0334:  1,
+	0x0335:  1,
+	0x0336:  1,
+	0x0337:  1,
+	0x0338:  1,
+	0x0339:  220,
+	0x033A:  220,
+	0x033B:  220,
+	0x033C:  220,
+	0x033D:  230,
+	0x033E:  230,
+	0x033F:  230,
+	0x0340:  230,
+	0x0341:  230,
+	0x0342:  230,
+	0x0343:  230,
+	0x0344:  230,
+	0x0345:  240,
+	0x0346:  230,
+	0x0347:  220,
+	0x0348:  220,
+	0x0349:  220,
+	0x034A:  230,
+	0x034B:  230,
+	0x034C:  230,
+	0x034D:  220,
+	0x034E:  220,
+	0x0350:  230,
+	0x0351:  230,
+	0x0352:  230,
+	0x0353:  220,
+	0x0354:  220,
+	0x0355:  220,
+	0x0356:  220,
+	0x0357:  230,
+	0x0358:  232,
+	0x0359:  220,
+	0x035A:  220,
+	0x035B:  230,
+	0x035C:  233,
+	0x035D:  234,
+	0x035E:  234,
+	0x035F:  233,
+	0x0360:  234,
+	0x0361:  234,
+	0x0362:  233,
+	0x0363:  230,
+	0x0364:  230,
+	0x0365:  230,
+	0x0366:  230,
+	0x0367:  230,
+	0x0368:  230,
+	0x0369:  230,
+	0x036A:  230,
+	0x036B:  230,
+	0x036C:  230,
+	0x036D:  230,
+	0x036E:  230,
+	0x036F:  230,
+	0x0483:  230,
+	0x0484:  230,
+	0x0485:  230,
+	0x0486:  230,
+	0x0487:  230,
+	0x0591:  220,
+	0x0592:  230,
+	0x0593:  230,
+	0x0594:  230,
+	0x0595:  230,
+	0x0596:  220,
+	0x0597:  230,
+	0x0598:  230,
+	0x0599:  230,
+	0x059A:  222,
+	0x059B:  220,
+	0x059C:  230,
+	0x059D:  230,
+	0x059E:  230,
+	0x059F:  230,
+	0x05A0:  230,
+	0x05A1:  230,
+	0x05A2:  220,
+	0x05A3:  220,
+	0x05A4:  220,
+	0x05A5:  220,
+	0x05A6:  220,
+	0x05A7:  220,
+	0x05A8:  230,
+	0x05A9:  230,
+	0x05AA:  220,
+	0x05AB:  230,
+	0x05AC:  230,
+	0x05AD:  222,
+	0x05AE:  228,
+	0x05AF:  230,
+	0x05B0:  10,
+	0x05B1:  11,
+	0x05B2:  12,
+	0x05B3:  13,
+	0x05B4:  14,
+	0x05B5:  15,
+	0x05B6:  16,
+	0x05B7:  17,
+	0x05B8:  18,
+	0x05B9:  19,
+	0x05BA:  19,
+	0x05BB:  20,
+	0x05BC:  21,
+	0x05BD:  22,
+	0x05BF:  23,
+	0x05C1:  24,
+	0x05C2:  25,
+	0x05C4:  230,
+	0x05C5:  220,
+	0x05C7:  18,
+	0x0610:  230,
+	0x0611:  230,
+	0x0612:  230,
+	0x0613:  230,
+	0x0614:  230,
+	0x0615:  230,
+	0x0616:  230,
+	0x0617:  230,
+	0x0618:  30,
+	0x0619:  31,
+	0x061A:  32,
+	0x064B:  27,
+	0x064C:  28,
+	0x064D:  29,
+	0x064E:  30,
+	0x064F:  31,
+	0x0650:  32,
+	0x0651:  33,
+	0x0652:  34,
+	0x0653:  230,
+	0x0654:  230,
+	0x0655:  220,
+	0x0656:  220,
+	0x0657:  230,
+	0x0658:  230,
+	0x0659:  230,
+	0x065A:  230,
+	0x065B:  230,
+	0x065C:  220,
+	0x065D:  230,
+	0x065E:  230,
+	0x065F:  220,
+	0x0670:  35,
+	0x06D6:  230,
+	0x06D7:  230,
+	0x06D8:  230,
+	0x06D9:  230,
+	0x06DA:  230,
+	0x06DB:  230,
+	0x06DC:  230,
+	0x06DF:  230,
+	0x06E0:  230,
+	0x06E1:  230,
+	0x06E2:  230,
+	0x06E3:  220,
+	0x06E4:  230,
+	0x06E7:  230,
+	0x06E8:  230,
+	0x06EA:  220,
+	0x06EB:  230,
+	0x06EC:  230,
+	0x06ED:  220,
+	0x0711:  36,
+	0x0730:  230,
+	0x0731:  220,
+	0x0732:  230,
+	0x0733:  230,
+	0x0734:  220,
+	0x0735:  230,
+	0x0736:  230,
+	0x0737:  220,
+	0x0738:  220,
+	0x0739:  220,
+	0x073A:  230,
+	0x073B:  220,
+	0x073C:  220,
+	0x073D:  230,
+	0x073E:  220,
+	0x073F:  230,
+	0x0740:  230,
+	0x0741:  230,
+	0x0742:  220,
+	0x0743:  230,
+	0x0744:  220,
+	0x0745:  230,
+	0x0746:  220,
+	0x0747:  230,
+	0x0748:  220,
+	0x0749:  230,
+	0x074A:  230,
+	0x07EB:  230,
+	0x07EC:  230,
+	0x07ED:  230,
+	0x07EE:  230,
+	0x07EF:  230,
+	0x07F0:  230,
+	0x07F1:  230,
+	0x07F2:  220,
+	0x07F3:  230,
+	0x07FD:  220,
+	0x0816:  230,
+	0x0817:  230,
+	0x0818:  230,
+	0x0819:  230,
+	0x081B:  230,
+	0x081C:  230,
+	0x081D:  230,
+	0x081E:  230,
+	0x081F:  230,
+	0x0820:  230,
+	0x0821:  230,
+	0x0822:  230,
+	0x0823:  230,
+	0x0825:  230,
+	0x0826:  230,
+	0x0827:  230,
+	0x0829:  230,
+	0x082A:  230,
+	0x082B:  230,
+	0x082C:  230,
+	0x082D:  230,
+	0x0859:  220,
+	0x085A:  220,
+	0x085B:  220,
+	0x0898:  230,
+	0x0899:  220,
+	0x089A:  220,
+	0x089B:  220,
+	0x089C:  230,
+	0x089D:  230,
+	0x089E:  230,
+	0x089F:  230,
+	0x08CA:  230,
+	0x08CB:  230,
+	0x08CC:  230,
+	0x08CD:  230,
+	0x08CE:  230,
+	0x08CF:  220,
+	0x08D0:  220,
+	0x08D1:  220,
+	0x08D2:  220,
+	0x08D3:  220,
+	0x08D4:  230,
+	0x08D5:  230,
+	0x08D6:  230,
+	0x08D7:  230,
+	0x08D8:  230,
+	0x08D9:  230,
+	0x08DA:  230,
+	0x08DB:  230,
+	0x08DC:  230,
+	0x08DD:  230,
+	0x08DE:  230,
+	0x08DF:  230,
+	0x08E0:  230,
+	0x08E1:  230,
+	0x08E3:  220,
+	0x08E4:  230,
+	0x08E5:  230,
+	0x08E6:  220,
+	0x08E7:  230,
+	0x08E8:  230,
+	0x08E9:  220,
+	0x08EA:  230,
+	0x08EB:  230,
+	0x08EC:  230,
+	0x08ED:  220,
+	0x08EE:  220,
+	0x08EF:  220,
+	0x08F0:  27,
+	0x08F1:  28,
+	0x08F2:  29,
+	0x08F3:  230,
+	0x08F4:  230,
+	0x08F5:  230,
+	0x08F6:  220,
+	0x08F7:  230,
+	0x08F8:  230,
+	0x08F9:  220,
+	0x08FA:  220,
+	0x08FB:  230,
+	0x08FC:  230,
+	0x08FD:  230,
+	0x08FE:  230,
+	0x08FF:  230,
+	0x093C:  7,
+	0x094D:  9,
+	0x0951:  230,
+	0x0952:  220,
+	0x0953:  230,
+	0x0954:  230,
+	0x09BC:  7,
+	0x09CD:  9,
+	0x09FE:  230,
+	0x0A3C:  7,
+	0x0A4D:  9,
+	0x0ABC:  7,
+	0x0ACD:  9,
+	0x0B3C:  7,
+	0x0B4D:  9,
+	0x0BCD:  9,
+	0x0C3C:  7,
+	0x0C4D:  9,
+	0x0C55:  84,
+	0x0C56:  91,
+	0x0CBC:  7,
+	0x0CCD:  9,
+	0x0D3B:  9,
+	0x0D3C:  9,
+	0x0D4D:  9,
+	0x0DCA:  9,
+	0x0E38:  103,
+	0x0E39:  103,
+	0x0E3A:  9,
+	0x0E48:  107,
+	0x0E49:  107,
+	0x0E4A:  107,
+	0x0E4B:  107,
+	0x0EB8:  118,
+	0x0EB9:  118,
+	0x0EBA:  9,
+	0x0EC8:  122,
+	0x0EC9:  122,
+	0x0ECA:  122,
+	0x0ECB:  122,
+	0x0F18:  220,
+	0x0F19:  220,
+	0x0F35:  220,
+	0x0F37:  220,
+	0x0F39:  216,
+	0x0F71:  129,
+	0x0F72:  130,
+	0x0F74:  132,
+	0x0F7A:  130,
+	0x0F7B:  130,
+	0x0F7C:  130,
+	0x0F7D:  130,
+	0x0F80:  130,
+	0x0F82:  230,
+	0x0F83:  230,
+	0x0F84:  9,
+	0x0F86:  230,
+	0x0F87:  230,
+	0x0FC6:  220,
+	0x1037:  7,
+	0x1039:  9,
+	0x103A:  9,
+	0x108D:  220,
+	0x135D:  230,
+	0x135E:  230,
+	0x135F:  230,
+	0x1714:  9,
+	0x1715:  9,
+	0x1734:  9,
+	0x17D2:  9,
+	0x17DD:  230,
+	0x18A9:  228,
+	0x1939:  222,
+	0x193A:  230,
+	0x193B:  220,
+	0x1A17:  230,
+	0x1A18:  220,
+	0x1A60:  9,
+	0x1A75:  230,
+	0x1A76:  230,
+	0x1A77:  230,
+	0x1A78:  230,
+	0x1A79:  230,
+	0x1A7A:  230,
+	0x1A7B:  230,
+	0x1A7C:  230,
+	0x1A7F:  220,
+	0x1AB0:  230,
+	0x1AB1:  230,
+	0x1AB2:  230,
+	0x1AB3:  230,
+	0x1AB4:  230,
+	0x1AB5:  220,
+	0x1AB6:  220,
+	0x1AB7:  220,
+	0x1AB8:  220,
+	0x1AB9:  220,
+	0x1ABA:  220,
+	0x1ABB:  230,
+	0x1ABC:  230,
+	0x1ABD:  220,
+	0x1ABF:  220,
+	0x1AC0:  220,
+	0x1AC1:  230,
+	0x1AC2:  230,
+	0x1AC3:  220,
+	0x1AC4:  220,
+	0x1AC5:  230,
+	0x1AC6:  230,
+	0x1AC7:  230,
+	0x1AC8:  230,
+	0x1AC9:  230,
+	0x1ACA:  220,
+	0x1ACB:  230,
+	0x1ACC:  230,
+	0x1ACD:  230,
+	0x1ACE:  230,
+	0x1B34:  7,
+	0x1B44:  9,
+	0x1B6B:  230,
+	0x1B6C:  220,
+	0x1B6D:  230,
+	0x1B6E:  230,
+	0x1B6F:  230,
+	0x1B70:  230,
+	0x1B71:  230,
+	0x1B72:  230,
+	0x1B73:  230,
+	0x1BAA:  9,
+	0x1BAB:  9,
+	0x1BE6:  7,
+	0x1BF2:  9,
+	0x1BF3:  9,
+	0x1C37:  7,
+	0x1CD0:  230,
+	0x1CD1:  230,
+	0x1CD2:  230,
+	0x1CD4:  1,
+	0x1CD5:  220,
+	0x1CD6:  220,
+	0x1CD7:  220,
+	0x1CD8:  220,
+	0x1CD9:  220,
+	0x1CDA:  230,
+	0x1CDB:  230,
+	0x1CDC:  220,
+	0x1CDD:  220,
+	0x1CDE:  220,
+	0x1CDF:  220,
+	0x1CE0:  230,
+	0x1CE2:  1,
+	0x1CE3:  1,
+	0x1CE4:  1,
+	0x1CE5:  1,
+	0x1CE6:  1,
+	0x1CE7:  1,
+	0x1CE8:  1,
+	0x1CED:  220,
+	0x1CF4:  230,
+	0x1CF8:  230,
+	0x1CF9:  230,
+	0x1DC0:  230,
+	0x1DC1:  230,
+	0x1DC2:  220,
+	0x1DC3:  230,
+	0x1DC4:  230,
+	0x1DC5:  230,
+	0x1DC6:  230,
+	0x1DC7:  230,
+	0x1DC8:  230,
+	0x1DC9:  230,
+	0x1DCA:  220,
+	0x1DCB:  230,
+	0x1DCC:  230,
+	0x1DCD:  234,
+	0x1DCE:  214,
+	0x1DCF:  220,
+	0x1DD0:  202,
+	0x1DD1:  230,
+	0x1DD2:  230,
+	0x1DD3:  230,
+	0x1DD4:  230,
+	0x1DD5:  230,
+	0x1DD6:  230,
+	0x1DD7:  230,
+	0x1DD8:  230,
+	0x1DD9:  230,
+	0x1DDA:  230,
+	0x1DDB:  230,
+	0x1DDC:  230,
+	0x1DDD:  230,
+	0x1DDE:  230,
+	0x1DDF:  230,
+	0x1DE0:  230,
+	0x1DE1:  230,
+	0x1DE2:  230,
+	0x1DE3:  230,
+	0x1DE4:  230,
+	0x1DE5:  230,
+	0x1DE6:  230,
+	0x1DE7:  230,
+	0x1DE8:  230,
+	0x1DE9:  230,
+	0x1DEA:  230,
+	0x1DEB:  230,
+	0x1DEC:  230,
+	0x1DED:  230,
+	0x1DEE:  230,
+	0x1DEF:  230,
+	0x1DF0:  230,
+	0x1DF1:  230,
+	0x1DF2:  230,
+	0x1DF3:  230,
+	0x1DF4:  230,
+	0x1DF5:  230,
+	0x1DF6:  232,
+	0x1DF7:  228,
+	0x1DF8:  228,
+	0x1DF9:  220,
+	0x1DFA:  218,
+	0x1DFB:  230,
+	0x1DFC:  233,
+	0x1DFD:  220,
+	0x1DFE:  230,
+	0x1DFF:  220,
+	0x20D0:  230,
+	0x20D1:  230,
+	0x20D2:  1,
+	0x20D3:  1,
+	0x20D4:  230,
+	0x20D5:  230,
+	0x20D6:  230,
+	0x20D7:  230,
+	0x20D8:  1,
+	0x20D9:  1,
+	0x20DA:  1,
+	0x20DB:  230,
+	0x20DC:  230,
+	0x20E1:  230,
+	0x20E5:  1,
+	0x20E6:  1,
+	0x20E7:  230,
+	0x20E8:  220,
+	0x20E9:  230,
+	0x20EA:  1,
+	0x20EB:  1,
+	0x20EC:  220,
+	0x20ED:  220,
+	0x20EE:  220,
+	0x20EF:  220,
+	0x20F0:  230,
+	0x2CEF:  230,
+	0x2CF0:  230,
+	0x2CF1:  230,
+	0x2D7F:  9,
+	0x2DE0:  230,
+	0x2DE1:  230,
+	0x2DE2:  230,
+	0x2DE3:  230,
+	0x2DE4:  230,
+	0x2DE5:  230,
+	0x2DE6:  230,
+	0x2DE7:  230,
+	0x2DE8:  230,
+	0x2DE9:  230,
+	0x2DEA:  230,
+	0x2DEB:  230,
+	0x2DEC:  230,
+	0x2DED:  230,
+	0x2DEE:  230,
+	0x2DEF:  230,
+	0x2DF0:  230,
+	0x2DF1:  230,
+	0x2DF2:  230,
+	0x2DF3:  230,
+	0x2DF4:  230,
+	0x2DF5:  230,
+	0x2DF6:  230,
+	0x2DF7:  230,
+	0x2DF8:  230,
+	0x2DF9:  230,
+	0x2DFA:  230,
+	0x2DFB:  230,
+	0x2DFC:  230,
+	0x2DFD:  230,
+	0x2DFE:  230,
+	0x2DFF:  230,
+	0x302A:  218,
+	0x302B:  228,
+	0x302C:  232,
+	0x302D:  222,
+	0x302E:  224,
+	0x302F:  224,
+	0x3099:  8,
+	0x309A:  8,
+	0xA66F:  230,
+	0xA674:  230,
+	0xA675:  230,
+	0xA676:  230,
+	0xA677:  230,
+	0xA678:  230,
+	0xA679:  230,
+	0xA67A:  230,
+	0xA67B:  230,
+	0xA67C:  230,
+	0xA67D:  230,
+	0xA69E:  230,
+	0xA69F:  230,
+	0xA6F0:  230,
+	0xA6F1:  230,
+	0xA806:  9,
+	0xA82C:  9,
+	0xA8C4:  9,
+	0xA8E0:  230,
+	0xA8E1:  230,
+	0xA8E2:  230,
+	0xA8E3:  230,
+	0xA8E4:  230,
+	0xA8E5:  230,
+	0xA8E6:  230,
+	0xA8E7:  230,
+	0xA8E8:  230,
+	0xA8E9:  230,
+	0xA8EA:  230,
+	0xA8EB:  230,
+	0xA8EC:  230,
+	0xA8ED:  230,
+	0xA8EE:  230,
+	0xA8EF:  230,
+	0xA8F0:  230,
+	0xA8F1:  230,
+	0xA92B:  220,
+	0xA92C:  220,
+	0xA92D:  220,
+	0xA953:  9,
+	0xA9B3:  7,
+	0xA9C0:  9,
+	0xAAB0:  230,
+	0xAAB2:  230,
+	0xAAB3:  230,
+	0xAAB4:  220,
+	0xAAB7:  230,
+	0xAAB8:  230,
+	0xAABE:  230,
+	0xAABF:  230,
+	0xAAC1:  230,
+	0xAAF6:  9,
+	0xABED:  9,
+	0xFB1E:  26,
+	0xFE20:  230,
+	0xFE21:  230,
+	0xFE22:  230,
+	0xFE23:  230,
+	0xFE24:  230,
+	0xFE25:  230,
+	0xFE26:  230,
+	0xFE27:  220,
+	0xFE28:  220,
+	0xFE29:  220,
+	0xFE2A:  220,
+	0xFE2B:  220,
+	0xFE2C:  220,
+	0xFE2D:  220,
+	0xFE2E:  230,
+	0xFE2F:  230,
+	0x101FD: 220,
+	0x102E0: 220,
+	0x10376: 230,
+	0x10377: 230,
+	0x10378: 230,
+	0x10379: 230,
+	0x1037A: 230,
+	0x10A0D: 220,
+	0x10A0F: 230,
+	0x10A38: 230,
+	0x10A39: 1,
+	0x10A3A: 220,
+	0x10A3F: 9,
+	0x10AE5: 230,
+	0x10AE6: 220,
+	0x10D24: 230,
+	0x10D25: 230,
+	0x10D26: 230,
+	0x10D27: 230,
+	0x10EAB: 230,
+	0x10EAC: 230,
+	0x10F46: 220,
+	0x10F47: 220,
+	0x10F48: 230,
+	0x10F49: 230,
+	0x10F4A: 230,
+	0x10F4B: 220,
+	0x10F4C: 230,
+	0x10F4D: 220,
+	0x10F4E: 220,
+	0x10F4F: 220,
+	0x10F50: 220,
+	0x10F82: 230,
+	0x10F83: 220,
+	0x10F84: 230,
+	0x10F85: 220,
+	0x11046: 9,
+	0x11070: 9,
+	0x1107F: 9,
+	0x110B9: 9,
+	0x110BA: 7,
+	0x11100: 230,
+	0x11101: 230,
+	0x11102: 230,
+	0x11133: 9,
+	0x11134: 9,
+	0x11173: 7,
+	0x111C0: 9,
+	0x111CA: 7,
+	0x11235: 9,
+	0x11236: 7,
+	0x112E9: 7,
+	0x112EA: 9,
+	0x1133B: 7,
+	0x1133C: 7,
+	0x1134D: 9,
+	0x11366: 230,
+	0x11367: 230,
+	0x11368: 230,
+	0x11369: 230,
+	0x1136A: 230,
+	0x1136B: 230,
+	0x1136C: 230,
+	0x11370: 230,
+	0x11371: 230,
+	0x11372: 230,
+	0x11373: 230,
+	0x11374: 230,
+	0x11442: 9,
+	0x11446: 7,
+	0x1145E: 230,
+	0x114C2: 9,
+	0x114C3: 7,
+	0x115BF: 9,
+	0x115C0: 7,
+	0x1163F: 9,
+	0x116B6: 9,
+	0x116B7: 7,
+	0x1172B: 9,
+	0x11839: 9,
+	0x1183A: 7,
+	0x1193D: 9,
+	0x1193E: 9,
+	0x11943: 7,
+	0x119E0: 9,
+	0x11A34: 9,
+	0x11A47: 9,
+	0x11A99: 9,
+	0x11C3F: 9,
+	0x11D42: 7,
+	0x11D44: 9,
+	0x11D45: 9,
+	0x11D97: 9,
+	0x16AF0: 1,
+	0x16AF1: 1,
+	0x16AF2: 1,
+	0x16AF3: 1,
+	0x16AF4: 1,
+	0x16B30: 230,
+	0x16B31: 230,
+	0x16B32: 230,
+	0x16B33: 230,
+	0x16B34: 230,
+	0x16B35: 230,
+	0x16B36: 230,
+	0x16FF0: 6,
+	0x16FF1: 6,
+	0x1BC9E: 1,
+	0x1D165: 216,
+	0x1D166: 216,
+	0x1D167: 1,
+	0x1D168: 1,
+	0x1D169: 1,
+	0x1D16D: 226,
+	0x1D16E: 216,
+	0x1D16F: 216,
+	0x1D170: 216,
+	0x1D171: 216,
+	0x1D172: 216,
+	0x1D17B: 220,
+	0x1D17C: 220,
+	0x1D17D: 220,
+	0x1D17E: 220,
+	0x1D17F: 220,
+	0x1D180: 220,
+	0x1D181: 220,
+	0x1D182: 220,
+	0x1D185: 230,
+	0x1D186: 230,
+	0x1D187: 230,
+	0x1D188: 230,
+	0x1D189: 230,
+	0x1D18A: 220,
+	0x1D18B: 220,
+	0x1D1AA: 230,
+	0x1D1AB: 230,
+	0x1D1AC: 230,
+	0x1D1AD: 230,
+	0x1D242: 230,
+	0x1D243: 230,
+	0x1D244: 230,
+	0x1E000: 230,
+	0x1E001: 230,
+	0x1E002: 230,
+	0x1E003: 230,
+	0x1E004: 230,
+	0x1E005: 230,
+	0x1E006: 230,
+	0x1E008: 230,
+	0x1E009: 230,
+	0x1E00A: 230,
+	0x1E00B: 230,
+	0x1E00C: 230,
+	0x1E00D: 230,
+	0x1E00E: 230,
+	0x1E00F: 230,
+	0x1E010: 230,
+	0x1E011: 230,
+	0x1E012: 230,
+	0x1E013: 230,
+	0x1E014: 230,
+	0x1E015: 230,
+	0x1E016: 230,
+	0x1E017: 230,
+	0x1E018: 230,
+	0x1E01B: 230,
+	0x1E01C: 230,
+	0x1E01D: 230,
+	0x1E01E: 230,
+	0x1E01F: 230,
+	0x1E020: 230,
+	0x1E021: 230,
+	0x1E023: 230,
+	0x1E024: 230,
+	0x1E026: 230,
+	0x1E027: 230,
+	0x1E028: 230,
+	0x1E029: 230,
+	0x1E02A: 230,
+	0x1E130: 230,
+	0x1E131: 230,
+	0x1E132: 230,
+	0x1E133: 230,
+	0x1E134: 230,
+	0x1E135: 230,
+	0x1E136: 230,
+	0x1E2AE: 230,
+	0x1E2EC: 230,
+	0x1E2ED: 230,
+	0x1E2EE: 230,
+	0x1E2EF: 230,
+	0x1E8D0: 220,
+	0x1E8D1: 220,
+	0x1E8D2: 220,
+	0x1E8D3: 220,
+	0x1E8D4: 220,
+	0x1E8D5: 220,
+	0x1E8D6: 220,
+	0x1E944: 230,
+	0x1E945: 230,
+	0x1E946: 230,
+	0x1E947: 230,
+	0x1E948: 230,
+	0x1E949: 230,
+	0x1E94A: 7,
+}
+
+// decompositions holds the decomposition mappings of the characters
+// that have them, one level deep.
+var decompositions = map[rune]decomposition{
+	0x00A0:  {true, " "},
+	0x00A8:  {true, " \u0308"},
+	0x00AA:  {true, "a"},
+	0x00AF:  {true, " \u0304"},
+	0x00B2:  {true, "2"},
+	0x00B3:  {true, "3"},
+	0x00B4:  {true, " \u0301"},
+	0x00B5:  {true, "\u03bc"},
+	0x00B8:  {true, " \u0327"},
+	0x00B9:  {true, "1"},
+	0x00BA:  {true, "o"},
+	0x00BC:  {true, "1\u20444"},
+	0x00BD:  {true, "1\u20442"},
+	0x00BE:  {true, "3\u20444"},
+	0x00C0:  {false, "A\u0300"},
+	0x00C1:  {false, "A\u0301"},
+	0x00C2:  {false, "A\u0302"},
+	0x00C3:  {false, "A\u0303"},
+	0x00C4:  {false, "A\u0308"},
+	0x00C5:  {false, "A\u030a"},
+	0x00C7:  {false, "C\u0327"},
+	0x00C8:  {false, "E\u0300"},
+	0x00C9:  {false, "E\u0301"},
+	0x00CA:  {false, "E\u0302"},
+	0x00CB:  {false, "E\u0308"},
+	0x00CC:  {false, "I\u0300"},
+	0x00CD:  {false, "I\u0301"},
+	0x00CE:  {false, "I\u0302"},
+	0x00CF:  {false, "I\u0308"},
+	0x00D1:  {false, "N\u0303"},
+	0x00D2:  {false, "O\u0300"},
+	0x00D3:  {false, "O\u0301"},
+	0x00D4:  {false, "O\u0302"},
+	0x00D5:  {false, "O\u0303"},
+	0x00D6:  {false, "O\u0308"},
+	0x00D9:  {false, "U\u0300"},
+	0x00DA:  {false, "U\u0301"},
+	0x00DB:  {false, "U\u0302"},
+	0x00DC:  {false, "U\u0308"},
+	0x00DD:  {false, "Y\u0301"},
+	0x00E0:  {false, "a\u0300"},
+	0x00E1:  {false, "a\u0301"},
+	0x00E2:  {false, "a\u0302"},
+	0x00E3:  {false, "a\u0303"},
+	0x00E4:  {false, "a\u0308"},
+	0x00E5:  {false, "a\u030a"},
+	0x00E7:  {false, "c\u0327"},
+	0x00E8:  {false, "e\u0300"},
+	0x00E9:  {false, "e\u0301"},
+	0x00EA:  {false, "e\u0302"},
+	0x00EB:  {false, "e\u0308"},
+	0x00EC:  {false, "i\u0300"},
+	0x00ED:  {false, "i\u0301"},
+	0x00EE:  {false, "i\u0302"},
+	0x00EF:  {false, "i\u0308"},
+	0x00F1:  {false, "n\u0303"},
+	0x00F2:  {false, "o\u0300"},
+	0x00F3:  {false, "o\u0301"},
+	0x00F4:  {false, "o\u0302"},
+	0x00F5:  {false, "o\u0303"},
+	0x00F6:  {false, "o\u0308"},
+	0x00F9:  {false, "u\u0300"},
+	0x00FA:  {false, "u\u0301"},
+	0x00FB:  {false, "u\u0302"},
+	0x00FC:  {false, "u\u0308"},
+	0x00FD:  {false, "y\u0301"},
+	0x00FF:  {false, "y\u0308"},
+	0x0100:  {false, "A\u0304"},
+	0x0101:  {false, "a\u0304"},
+	0x0102:  {false, "A\u0306"},
+	0x0103:  {false, "a\u0306"},
+	0x0104:  {false, "A\u0328"},
+	0x0105:  {false, "a\u0328"},
+	0x0106:  {false, "C\u0301"},
+	0x0107:  {false, "c\u0301"},
+	0x0108:  {false, "C\u0302"},
+	0x0109:  {false, "c\u0302"},
+	0x010A:  {false, "C\u0307"},
+	0x010B:  {false, "c\u0307"},
+	0x010C:  {false, "C\u030c"},
+	0x010D:  {false, "c\u030c"},
+	0x010E:  {false, "D\u030c"},
+	0x010F:  {false, "d\u030c"},
+	0x0112:  {false, "E\u0304"},
+	0x0113:  {false, "e\u0304"},
+	0x0114:  {false, "E\u0306"},
+	0x0115:  {false, "e\u0306"},
+	0x0116:  {false, "E\u0307"},
+	0x0117:  {false, "e\u0307"},
+	0x0118:  {false, "E\u0328"},
+	0x0119:  {false, "e\u0328"},
+	0x011A:  {false, "E\u030c"},
+	0x011B:  {false, "e\u030c"},
+	0x011C:  {false, "G\u0302"},
+	0x011D:  {false, "g\u0302"},
+	0x011E:  {false, "G\u0306"},
+	0x011F:  {false, "g\u0306"},
+	0x0120:  {false, "G\u0307"},
+	0x0121:  {false, "g\u0307"},
+	0x0122:  {false, "G\u0327"},
+	0x0123:  {false, "g\u0327"},
+	0x0124:  {false, "H\u0302"},
+	0x0125:  {false, "h\u0302"},
+	0x0128:  {false, "I\u0303"},
+	0x0129:  {false, "i\u0303"},
+	0x012A:  {false, "I\u0304"},
+	0x012B:  {false, "i\u0304"},
+	0x012C:  {false, "I\u0306"},
+	0x012D:  {false, "i\u0306"},
+	0x012E:  {false, "I\u0328"},
+	0x012F:  {false, "i\u0328"},
+	0x0130:  {false, "I\u0307"},
+	0x0132:  {true, "IJ"},
+	0x0133:  {true, "ij"},
+	0x0134:  {false, "J\u0302"},
+	0x0135:  {false, "j\u0302"},
+	0x0136:  {false, "K\u0327"},
+	0x0137:  {false, "k\u0327"},
+	0x0139:  {false, "L\u0301"},
+	0x013A:  {false, "l\u0301"},
+	0x013B:  {false, "L\u0327"},
+	0x013C:  {false, "l\u0327"},
+	0x013D:  {false, "L\u030c"},
+	0x013E:  {false, "l\u030c"},
+	0x013F:  {true, "L\u00b7"},
+	0x0140:  {true, "l\u00b7"},
+	0x0143:  {false, "N\u0301"},
+	0x0144:  {false, "n\u0301"},
+	0x0145:  {false, "N\u0327"},
+	0x0146:  {false, "n\u0327"},
+	0x0147:  {false, "N\u030c"},
+	0x0148:  {false, "n\u030c"},
+	0x0149:  {true, "\u02bcn"},
+	0x014C:  {false, "O\u0304"},
+	0x014D:  {false, "o\u0304"},
+	0x014E:  {false, "O\u0306"},
+	0x014F:  {false, "o\u0306"},
+	0x0150:  {false, "O\u030b"},
+	0x0151:  {false, "o\u030b"},
+	0x0154:  {false, "R\u0301"},
+	0x0155:  {false, "r\u0301"},
+	0x0156:  {false, "R\u0327"},
+	0x0157:  {false, "r\u0327"},
+	0x0158:  {false, "R\u030c"},
+	0x0159:  {false, "r\u030c"},
+	0x015A:  {false, "S\u0301"},
+	0x015B:  {false, "s\u0301"},
+	0x015C:  {false, "S\u0302"},
+	0x015D:  {false, "s\u0302"},
+	0x015E:  {false, "S\u0327"},
+	0x015F:  {false, "s\u0327"},
+	0x0160:  {false, "S\u030c"},
+	0x0161:  {false, "s\u030c"},
+	0x0162:  {false, "T\u0327"},
+	0x0163:  {false, "t\u0327"},
+	0x0164:  {false, "T\u030c"},
+	0x0165:  {false, "t\u030c"},
+	0x0168:  {false, "U\u0303"},
+	0x0169:  {false, "u\u0303"},
+	0x016A:  {false, "U\u0304"},
+	0x016B:  {false, "u\u0304"},
+	0x016C:  {false, "U\u0306"},
+	0x016D:  {false, "u\u0306"},
+	0x016E:  {false, "U\u030a"},
+	0x016F:  {false, "u\u030a"},
+	0x0170:  {false, "U\u030b"},
+	0x0171:  {false, "u\u030b"},
+	0x0172:  {false, "U\u0328"},
+	0x0173:  {false, "u\u0328"},
+	0x0174:  {false, "W\u0302"},
+	0x0175:  {false, "w\u0302"},
+	0x0176:  {false, "Y\u0302"},
+	0x0177:  {false, "y\u0302"},
+	0x0178:  {false, "Y\u0308"},
+	0x0179:  {false, "Z\u0301"},
+	0x017A:  {false, "z\u0301"},
+	0x017B:  {false, "Z\u0307"},
+	0x017C:  {false, "z\u0307"},
+	0x017D:  {false, "Z\u030c"},
+	0x017E:  {false, "z\u030c"},
+	0x017F:  {true, "s"},
+	0x01A0:  {false, "O\u031b"},
+	0x01A1:  {false, "o\u031b"},
+	0x01AF:  {false, "U\u031b"},
+	0x01B0:  {false, "u\u031b"},
+	0x01C4:  {true, "D\u017d"},
+	0x01C5:  {true, "D\u017e"},
+	0x01C6:  {true, "d\u017e"},
+	0x01C7:  {true, "LJ"},
+	0x01C8:  {true, "Lj"},
+	0x01C9:  {true, "lj"},
+	0x01CA:  {true, "NJ"},
+	0x01CB:  {true, "Nj"},
+	0x01CC:  {true, "nj"},
+	0x01CD:  {false, "A\u030c"},
+	0x01CE:  {false, "a\u030c"},
+	0x01CF:  {false, "I\u030c"},
+	0x01D0:  {false, "i\u030c"},
+	0x01D1:  {false, "O\u030c"},
+	0x01D2:  {false, "o\u030c"},
+	0x01D3:  {false, "U\u030c"},
+	0x01D4:  {false, "u\u030c"},
+	0x01D5:  {false, "\u00dc\u0304"},
+	0x01D6:  {false, "\u00fc\u0304"},
+	0x01D7:  {false, "\u00dc\u0301"},
+	0x01D8:  {false, "\u00fc\u0301"},
+	0x01D9:  {false, "\u00dc\u030c"},
+	0x01DA:  {false, "\u00fc\u030c"},
+	0x01DB:  {false, "\u00dc\u0300"},
+	0x01DC:  {false, "\u00fc\u0300"},
+	0x01DE:  {false, "\u00c4\u0304"},
+	0x01DF:  {false, "\u00e4\u0304"},
+	0x01E0:  {false, "\u0226\u0304"},
+	0x01E1:  {false, "\u0227\u0304"},
+	0x01E2:  {false, "\u00c6\u0304"},
+	0x01E3:  {false, "\u00e6\u0304"},
+	0x01E6:  {false, "G\u030c"},
+	0x01E7:  {false, "g\u030c"},
+	0x01E8:  {false, "K\u030c"},
+	0x01E9:  {false, "k\u030c"},
+	0x01EA:  {false, "O\u0328"},
+	0x01EB:  {false, "o\u0328"},
+	0x01EC:  {false, "\u01ea\u0304"},
+	0x01ED:  {false, "\u01eb\u0304"},
+	0x01EE:  {false, "\u01b7\u030c"},
+	0x01EF:  {false, "\u0292\u030c"},
+	0x01F0:  {false, "j\u030c"},
+	0x01F1:  {true, "DZ"},
+	0x01F2:  {true, "Dz"},
+	0x01F3:  {true, "dz"},
+	0x01F4:  {false, "G\u0301"},
+	0x01F5:  {false, "g\u0301"},
+	0x01F8:  {false, "N\u0300"},
+	0x01F9:  {false, "n\u0300"},
+	0x01FA:  {false, "\u00c5\u0301"},
+	0x01FB:  {false, "\u00e5\u0301"},
+	0x01FC:  {false, "\u00c6\u0301"},
+	0x01FD:  {false, "\u00e6\u0301"},
+	0x01FE:  {false, "\u00d8\u0301"},
+	0x01FF:  {false, "\u00f8\u0301"},
+	0x0200:  {false, "A\u030f"},
+	0x0201:  {false, "a\u030f"},
+	0x0202:  {false, "A\u0311"},
+	0x0203:  {false, "a\u0311"},
+	0x0204:  {false, "E\u030f"},
+	0x0205:  {false, "e\u030f"},
+	0x0206:  {false, "E\u0311"},
+	0x0207:  {false, "e\u0311"},
+	0x0208:  {false, "I\u030f"},
+	0x0209:  {false, "i\u030f"},
+	0x020A:  {false, "I\u0311"},
+	0x020B:  {false, "i\u0311"},
+	0x020C:  {false, "O\u030f"},
+	0x020D:  {false, "o\u030f"},
+	0x020E:  {false, "O\u0311"},
+	0x020F:  {false, "o\u0311"},
+	0x0210:  {false, "R\u030f"},
+	0x0211:  {false, "r\u030f"},
+	0x0212:  {false, "R\u0311"},
+	0x0213:  {false, "r\u0311"},
+	0x0214:  {false, "U\u030f"},
+	0x0215:  {false, "u\u030f"},
+	0x0216:  {false, "U\u0311"},
+	0x0217:  {false, "u\u0311"},
+	0x0218:  {false, "S\u0326"},
+	0x0219:  {false, "s\u0326"},
+	0x021A:  {false, "T\u0326"},
+	0x021B:  {false, "t\u0326"},
+	0x021E:  {false, "H\u030c"},
+	0x021F:  {false, "h\u030c"},
+	0x0226:  {false, "A\u0307"},
+	0x0227:  {false, "a\u0307"},
+	0x0228:  {false, "E\u0327"},
+	0x0229:  {false, "e\u0327"},
+	0x022A:  {false, "\u00d6\u0304"},
+	0x022B:  {false, "\u00f6\u0304"},
+	0x022C:  {false, "\u00d5\u0304"},
+	0x022D:  {false, "\u00f5\u0304"},
+	0x022E:  {false, "O\u0307"},
+	0x022F:  {false, "o\u0307"},
+	0x0230:  {false, "\u022e\u0304"},
+	0x0231:  {false, "\u022f\u0304"},
+	0x0232:  {false, "Y\u0304"},
+	0x0233:  {false, "y\u0304"},
+	0x02B0:  {true, "h"},
+	0x02B1:  {true, "\u0266"},
+	0x02B2:  {true, "j"},
+	0x02B3:  {true, "r"},
+	0x02B4:  {true, "\u0279"},
+	0x02B5:  {true, "\u027b"},
+	0x02B6:  {true, "\u0281"},
+	0x02B7:  {true, "w"},
+	0x02B8:  {true, "y"},
+	0x02D8:  {true, " \u0306"},
+	0x02D9:  {true, " \u0307"},
+	0x02DA:  {true, " \u030a"},
+	0x02DB:  {true, " \u0328"},
+	0x02DC:  {true, " \u0303"},
+	0x02DD:  {true, " \u030b"},
+	0x02E0:  {true, "\u0263"},
+	0x02E1:  {true, "l"},
+	0x02E2:  {true, "s"},
+	0x02E3:  {true, "x"},
+	0x02E4:  {true, "\u0295"},
+	0x0340:  {false, "\u0300"},
+	0x0341:  {false, "\u0301"},
+	0x0343:  {false, "\u0313"},
+	0x0344:  {false, "\u0308\u0301"},
+	0x0374:  {false, "\u02b9"},
+	0x037A:  {true, " \u0345"},
+	0x037E:  {false, ";"},
+	0x0384:  {true, " \u0301"},
+	0x0385:  {false, "\u00a8\u0301"},
+	0x0386:  {false, "\u0391\u0301"},
+	0x0387:  {false, "\u00b7"},
+	0x0388:  {false, "\u0395\u0301"},
+	0x0389:  {false, "\u0397\u0301"},
+	0x038A:  {false, "\u0399\u0301"},
+	0x038C:  {false, "\u039f\u0301"},
+	0x038E:  {false, "\u03a5\u0301"},
+	0x038F:  {false, "\u03a9\u0301"},
+	0x0390:  {false, "\u03ca\u0301"},
+	0x03AA:  {false, "\u0399\u0308"},
+	0x03AB:  {false, "\u03a5\u0308"},
+	0x03AC:  {false, "\u03b1\u0301"},
+	0x03AD:  {false, "\u03b5\u0301"},
+	0x03AE:  {false, "\u03b7\u0301"},
+	0x03AF:  {false, "\u03b9\u0301"},
+	0x03B0:  {false, "\u03cb\u0301"},
+	0x03CA:  {false, "\u03b9\u0308"},
+	0x03CB:  {false, "\u03c5\u0308"},
+	0x03CC:  {false, "\u03bf\u0301"},
+	0x03CD:  {false, "\u03c5\u0301"},
+	0x03CE:  {false, "\u03c9\u0301"},
+	0x03D0:  {true, "\u03b2"},
+	0x03D1:  {true, "\u03b8"},
+	0x03D2:  {true, "\u03a5"},
+	0x03D3:  {false, "\u03d2\u0301"},
+	0x03D4:  {false, "\u03d2\u0308"},
+	0x03D5:  {true, "\u03c6"},
+	0x03D6:  {true, "\u03c0"},
+	0x03F0:  {true, "\u03ba"},
+	0x03F1:  {true, "\u03c1"},
+	0x03F2:  {true, "\u03c2"},
+	0x03F4:  {true, "\u0398"},
+	0x03F5:  {true, "\u03b5"},
+	0x03F9:  {true, "\u03a3"},
+	0x0400:  {false, "\u0415\u0300"},
+	0x0401:  {false, "\u0415\u0308"},
+	0x0403:  {false, "\u0413\u0301"},
+	0x0407:  {false, "\u0406\u0308"},
+	0x040C:  {false, "\u041a\u0301"},
+	0x040D:  {false, "\u0418\u0300"},
+	0x040E:  {false, "\u0423\u0306"},
+	0x0419:  {false, "\u0418\u0306"},
+	0x0439:  {false, "\u0438\u0306"},
+	0x0450:  {false, "\u0435\u0300"},
+	0x0451:  {false, "\u0435\u0308"},
+	0x0453:  {false, "\u0433\u0301"},
+	0x0457:  {false, "\u0456\u0308"},
+	0x045C:  {false, "\u043a\u0301"},
+	0x045D:  {false, "\u0438\u0300"},
+	0x045E:  {false, "\u0443\u0306"},
+	0x0476:  {false, "\u0474\u030f"},
+	0x0477:  {false, "\u0475\u030f"},
+	0x04C1:  {false, "\u0416\u0306"},
+	0x04C2:  {false, "\u0436\u0306"},
+	0x04D0:  {false, "\u0410\u0306"},
+	0x04D1:  {false, "\u0430\u0306"},
+	0x04D2:  {false, "\u0410\u0308"},
+	0x04D3:  {false, "\u0430\u0308"},
+	0x04D6:  {false, "\u0415\u0306"},
+	0x04D7:  {false, "\u0435\u0306"},
+	0x04DA:  {false, "\u04d8\u0308"},
+	0x04DB:  {false, "\u04d9\u0308"},
+	0x04DC:  {false, "\u0416\u0308"},
+	0x04DD:  {false, "\u0436\u0308"},
+	0x04DE:  {false, "\u0417\u0308"},
+	0x04DF:  {false, "\u0437\u0308"},
+	0x04E2:  {false, "\u0418\u0304"},
+	0x04E3:  {false, "\u0438\u0304"},
+	0x04E4:  {false, "\u0418\u0308"},
+	0x04E5:  {false, "\u0438\u0308"},
+	0x04E6:  {false, "\u041e\u0308"},
+	0x04E7:  {false, "\u043e\u0308"},
+	0x04EA:  {false, "\u04e8\u0308"},
+	0x04EB:  {false, "\u04e9\u0308"},
+	0x04EC:  {false, "\u042d\u0308"},
+	0x04ED:  {false, "\u044d\u0308"},
+	0x04EE:  {false, "\u0423\u0304"},
+	0x04EF:  {false, "\u0443\u0304"},
+	0x04F0:  {false, "\u0423\u0308"},
+	0x04F1:  {false, "\u0443\u0308"},
+	0x04F2:  {false, "\u0423\u030b"},
+	0x04F3:  {false, "\u0443\u030b"},
+	0x04F4:  {false, "\u0427\u0308"},
+	0x04F5:  {false, "\u0447\u0308"},
+	0x04F8:  {false, "\u042b\u0308"},
+	0x04F9:  {false, "\u044b\u0308"},
+	0x0587:  {true, "\u0565\u0582"},
+	0x0622:  {false, "\u0627\u0653"},
+	0x0623:  {false, "\u0627\u0654"},
+	0x0624:  {false, "\u0648\u0654"},
+	0x0625:  {false, "\u0627\u0655"},
+	0x0626:  {false, "\u064a\u0654"},
+	0x0675:  {true, "\u0627\u0674"},
+	0x0676:  {true, "\u0648\u0674"},
+	0x0677:  {true, "\u06c7\u0674"},
+	0x0678:  {true, "\u064a\u0674"},
+	0x06C0:  {false, "\u06d5\u0654"},
+	0x06C2:  {false, "\u06c1\u0654"},
+	0x06D3:  {false, "\u06d2\u0654"},
+	0x0929:  {false, "\u0928\u093c"},
+	0x0931:  {false, "\u0930\u093c"},
+	0x0934:  {false, "\u0933\u093c"},
+	0x0958:  {false, "\u0915\u093c"},
+	0x0959:  {false, "\u0916\u093c"},
+	0x095A:  {false, "\u0917\u093c"},
+	0x095B:  {false, "\u091c\u093c"},
+	0x095C:  {false, "\u0921\u093c"},
+	0x095D:  {false, "\u0922\u093c"},
+	0x095E:  {false, "\u092b\u093c"},
+	0x095F:  {false, "\u092f\u093c"},
+	0x09CB:  {false, "\u09c7\u09be"},
+	0x09CC:  {false, "\u09c7\u09d7"},
+	0x09DC:  {false, "\u09a1\u09bc"},
+	0x09DD:  {false, "\u09a2\u09bc"},
+	0x09DF:  {false, "\u09af\u09bc"},
+	0x0A33:  {false, "\u0a32\u0a3c"},
+	0x0A36:  {false, "\u0a38\u0a3c"},
+	0x0A59:  {false, "\u0a16\u0a3c"},
+	0x0A5A:  {false, "\u0a17\u0a3c"},
+	0x0A5B:  {false, "\u0a1c\u0a3c"},
+	0x0A5E:  {false, "\u0a2b\u0a3c"},
+	0x0B48:  {false, "\u0b47\u0b56"},
+	0x0B4B:  {false, "\u0b47\u0b3e"},
+	0x0B4C:  {false, "\u0b47\u0b57"},
+	0x0B5C:  {false, "\u0b21\u0b3c"},
+	0x0B5D:  {false, "\u0b22\u0b3c"},
+	0x0B94:  {false, "\u0b92\u0bd7"},
+	0x0BCA:  {false, "\u0bc6\u0bbe"},
+	0x0BCB:  {false, "\u0bc7\u0bbe"},
+	0x0BCC:  {false, "\u0bc6\u0bd7"},
+	0x0C48:  {false, "\u0c46\u0c56"},
+	0x0CC0:  {false, "\u0cbf\u0cd5"},
+	0x0CC7:  {false, "\u0cc6\u0cd5"},
+	0x0CC8:  {false, "\u0cc6\u0cd6"},
+	0x0CCA:  {false, "\u0cc6\u0cc2"},
+	0x0CCB:  {false, "\u0cca\u0cd5"},
+	0x0D4A:  {false, "\u0d46\u0d3e"},
+	0x0D4B:  {false, "\u0d47\u0d3e"},
+	0x0D4C:  {false, "\u0d46\u0d57"},
+	0x0DDA:  {false, "\u0dd9\u0dca"},
+	0x0DDC:  {false, "\u0dd9\u0dcf"},
+	0x0DDD:  {false, "\u0ddc\u0dca"},
+	0x0DDE:  {false, "\u0dd9\u0ddf"},
+	0x0E33:  {true, "\u0e4d\u0e32"},
+	0x0EB3:  {true, "\u0ecd\u0eb2"},
+	0x0EDC:  {true, "\u0eab\u0e99"},
+	0x0EDD:  {true, "\u0eab\u0ea1"},
+	0x0F0C:  {true, "\u0f0b"},
+	0x0F43:  {false, "\u0f42\u0fb7"},
+	0x0F4D:  {false, "\u0f4c\u0fb7"},
+	0x0F52:  {false, "\u0f51\u0fb7"},
+	0x0F57:  {false, "\u0f56\u0fb7"},
+	0x0F5C:  {false, "\u0f5b\u0fb7"},
+	0x0F69:  {false, "\u0f40\u0fb5"},
+	0x0F73:  {false, "\u0f71\u0f72"},
+	0x0F75:  {false, "\u0f71\u0f74"},
+	0x0F76:  {false, "\u0fb2\u0f80"},
+	0x0F77:  {true, "\u0fb2\u0f81"},
+	0x0F78:  {false, "\u0fb3\u0f80"},
+	0x0F79:  {true, "\u0fb3\u0f81"},
+	0x0F81:  {false, "\u0f71\u0f80"},
+	0x0F93:  {false, "\u0f92\u0fb7"},
+	0x0F9D:  {false, "\u0f9c\u0fb7"},
+	0x0FA2:  {false, "\u0fa1\u0fb7"},
+	0x0FA7:  {false, "\u0fa6\u0fb7"},
+	0x0FAC:  {false, "\u0fab\u0fb7"},
+	0x0FB9:  {false, "\u0f90\u0fb5"},
+	0x1026:  {false, "\u1025\u102e"},
+	0x10FC:  {true, "\u10dc"},
+	0x1B06:  {false, "\u1b05\u1b35"},
+	0x1B08:  {false, "\u1b07\u1b35"},
+	0x1B0A:  {false, "\u1b09\u1b35"},
+	0x1B0C:  {false, "\u1b0b\u1b35"},
+	0x1B0E:  {false, "\u1b0d\u1b35"},
+	0x1B12:  {false, "\u1b11\u1b35"},
+	0x1B3B:  {false, "\u1b3a\u1b35"},
+	0x1B3D:  {false, "\u1b3c\u1b35"},
+	0x1B40:  {false, "\u1b3e\u1b35"},
+	0x1B41:  {false, "\u1b3f\u1b35"},
+	0x1B43:  {false, "\u1b42\u1b35"},
+	0x1D2C:  {true, "A"},
+	0x1D2D:  {true, "\u00c6"},
+	0x1D2E:  {true, "B"},
+	0x1D30:  {true, "D"},
+	0x1D31:  {true, "E"},
+	0x1D32:  {true, "\u018e"},
+	0x1D33:  {true, "G"},
+	0x1D34:  {true, "H"},
+	0x1D35:  {true, "I"},
+	0x1D36:  {true, "J"},
+	0x1D37:  {true, "K"},
+	0x1D38:  {true, "L"},
+	0x1D39:  {true, "M"},
+	0x1D3A:  {true, "N"},
+	0x1D3C:  {true, "O"},
+	0x1D3D:  {true, "\u0222"},
+	0x1D3E:  {true, "P"},
+	0x1D3F:  {true, "R"},
+	0x1D40:  {true, "T"},
+	0x1D41:  {true, "U"},
+	0x1D42:  {true, "W"},
+	0x1D43:  {true, "a"},
+	0x1D44:  {true, "\u0250"},
+	0x1D45:  {true, "\u0251"},
+	0x1D46:  {true, "\u1d02"},
+	0x1D47:  {true, "b"},
+	0x1D48:  {true, "d"},
+	0x1D49:  {true, "e"},
+	0x1D4A:  {true, "\u0259"},
+	0x1D4B:  {true, "\u025b"},
+	0x1D4C:  {true, "\u025c"},
+	0x1D4D:  {true, "g"},
+	0x1D4F:  {true, "k"},
+	0x1D50:  {true, "m"},
+	0x1D51:  {true, "\u014b"},
+	0x1D52:  {true, "o"},
+	0x1D53:  {true, "\u0254"},
+	0x1D54:  {true, "\u1d16"},
+	0x1D55:  {true, "\u1d17"},
+	0x1D56:  {true, "p"},
+	0x1D57:  {true, "t"},
+	0x1D58:  {true, "u"},
+	0x1D59:  {true, "\u1d1d"},
+	0x1D5A:  {true, "\u026f"},
+	0x1D5B:  {true, "v"},
+	0x1D5C:  {true, "\u1d25"},
+	0x1D5D:  {true, "\u03b2"},
+	0x1D5E:  {true, "\u03b3"},
+	0x1D5F:  {true, "\u03b4"},
+	0x1D60:  {true, "\u03c6"},
+	0x1D61:  {true, "\u03c7"},
+	0x1D62:  {true, "i"},
+	0x1D63:  {true, "r"},
+	0x1D64:  {true, "u"},
+	0x1D65:  {true, "v"},
+	0x1D66:  {true, "\u03b2"},
+	0x1D67:  {true, "\u03b3"},
+	0x1D68:  {true, "\u03c1"},
+	0x1D69:  {true, "\u03c6"},
+	0x1D6A:  {true, "\u03c7"},
+	0x1D78:  {true, "\u043d"},
+	0x1D9B:  {true, "\u0252"},
+	0x1D9C:  {true, "c"},
+	0x1D9D:  {true, "\u0255"},
+	0x1D9E:  {true, "\u00f0"},
+	0x1D9F:  {true, "\u025c"},
+	0x1DA0:  {true, "f"},
+	0x1DA1:  {true, "\u025f"},
+	0x1DA2:  {true, "\u0261"},
+	0x1DA3:  {true, "\u0265"},
+	0x1DA4:  {true, "\u0268"},
+	0x1DA5:  {true, "\u0269"},
+	0x1DA6:  {true, "\u026a"},
+	0x1DA7:  {true, "\u1d7b"},
+	0x1DA8:  {true, "\u029d"},
+	0x1DA9:  {true, "\u026d"},
+	0x1DAA:  {true, "\u1d85"},
+	0x1DAB:  {true, "\u029f"},
+	0x1DAC:  {true, "\u0271"},
+	0x1DAD:  {true, "\u0270"},
+	0x1DAE:  {true, "\u0272"},
+	0x1DAF:  {true, "\u0273"},
+	0x1DB0:  {true, "\u0274"},
+	0x1DB1:  {true, "\u0275"},
+	0x1DB2:  {true, "\u0278"},
+	0x1DB3:  {true, "\u0282"},
+	0x1DB4:  {true, "\u0283"},
+	0x1DB5:  {true, "\u01ab"},
+	0x1DB6:  {true, "\u0289"},
+	0x1DB7:  {true, "\u028a"},
+	0x1DB8:  {true, "\u1d1c"},
+	0x1DB9:  {true, "\u028b"},
+	0x1DBA:  {true, "\u028c"},
+	0x1DBB:  {true, "z"},
+	0x1DBC:  {true, "\u0290"},
+	0x1DBD:  {true, "\u0291"},
+	0x1DBE:  {true, "\u0292"},
+	0x1DBF:  {true, "\u03b8"},
+	0x1E00:  {false, "A\u0325"},
+	0x1E01:  {false, "a\u0325"},
+	0x1E02:  {false, "B\u0307"},
+	0x1E03:  {false, "b\u0307"},
+	0x1E04:  {false, "B\u0323"},
+	0x1E05:  {false, "b\u0323"},
+	0x1E06:  {false, "B\u0331"},
+	0x1E07:  {false, "b\u0331"},
+	0x1E08:  {false, "\u00c7\u0301"},
+	0x1E09:  {false, "\u00e7\u0301"},
+	0x1E0A:  {false, "D\u0307"},
+	0x1E0B:  {false, "d\u0307"},
+	0x1E0C:  {false, "D\u0323"},
+	0x1E0D:  {false, "d\u0323"},
+	0x1E0E:  {false, "D\u0331"},
+	0x1E0F:  {false, "d\u0331"},
+	0x1E10:  {false, "D\u0327"},
+	0x1E11:  {false, "d\u0327"},
+	0x1E12:  {false, "D\u032d"},
+	0x1E13:  {false, "d\u032d"},
+	0x1E14:  {false, "\u0112\u0300"},
+	0x1E15:  {false, "\u0113\u0300"},
+	0x1E16:  {false, "\u0112\u0301"},
+	0x1E17:  {false, "\u0113\u0301"},
+	0x1E18:  {false, "E\u032d"},
+	0x1E19:  {false, "e\u032d"},
+	0x1E1A:  {false, "E\u0330"},
+	0x1E1B:  {false, "e\u0330"},
+	0x1E1C:  {false, "\u0228\u0306"},
+	0x1E1D:  {false, "\u0229\u0306"},
+	0x1E1E:  {false, "F\u0307"},
+	0x1E1F:  {false, "f\u0307"},
+	0x1E20:  {false, "G\u0304"},
+	0x1E21:  {false, "g\u0304"},
+	0x1E22:  {false, "H\u0307"},
+	0x1E23:  {false, "h\u0307"},
+	0x1E24:  {false, "H\u0323"},
+	0x1E25:  {false, "h\u0323"},
+	0x1E26:  {false, "H\u0308"},
+	0x1E27:  {false, "h\u0308"},
+	0x1E28:  {false, "H\u0327"},
+	0x1E29:  {false, "h\u0327"},
+	0x1E2A:  {false, "H\u032e"},
+	0x1E2B:  {false, "h\u032e"},
+	0x1E2C:  {false, "I\u0330"},
+	0x1E2D:  {false, "i\u0330"},
+	0x1E2E:  {false, "\u00cf\u0301"},
+	0x1E2F:  {false, "\u00ef\u0301"},
+	0x1E30:  {false, "K\u0301"},
+	0x1E31:  {false, "k\u0301"},
+	0x1E32:  {false, "K\u0323"},
+	0x1E33:  {false, "k\u0323"},
+	0x1E34:  {false, "K\u0331"},
+	0x1E35:  {false, "k\u0331"},
+	0x1E36:  {false, "L\u0323"},
+	0x1E37:  {false, "l\u0323"},
+	0x1E38:  {false, "\u1e36\u0304"},
+	0x1E39:  {false, "\u1e37\u0304"},
+	0x1E3A:  {false, "L\u0331"},
+	0x1E3B:  {false, "l\u0331"},
+	0x1E3C:  {false, "L\u032d"},
+	0x1E3D:  {false, "l\u032d"},
+	0x1E3E:  {false, "M\u0301"},
+	0x1E3F:  {false, "m\u0301"},
+	0x1E40:  {false, "M\u0307"},
+	0x1E41:  {false, "m\u0307"},
+	0x1E42:  {false, "M\u0323"},
+	0x1E43:  {false, "m\u0323"},
+	0x1E44:  {false, "N\u0307"},
+	0x1E45:  {false, "n\u0307"},
+	0x1E46:  {false, "N\u0323"},
+	0x1E47:  {false, "n\u0323"},
+	0x1E48:  {false, "N\u0331"},
+	0x1E49:  {false, "n\u0331"},
+	0x1E4A:  {false, "N\u032d"},
+	0x1E4B:  {false, "n\u032d"},
+	0x1E4C:  {false, "\u00d5\u0301"},
+	0x1E4D:  {false, "\u00f5\u0301"},
+	0x1E4E:  {false, "\u00d5\u0308"},
+	0x1E4F:  {false, "\u00f5\u0308"},
+	0x1E50:  {false, "\u014c\u0300"},
+	0x1E51:  {false, "\u014d\u0300"},
+	0x1E52:  {false, "\u014c\u0301"},
+	0x1E53:  {false, "\u014d\u0301"},
+	0x1E54:  {false, "P\u0301"},
+	0x1E55:  {false, "p\u0301"},
+	0x1E56:  {false, "P\u0307"},
+	0x1E57:  {false, "p\u0307"},
+	0x1E58:  {false, "R\u0307"},
+	0x1E59:  {false, "r\u0307"},
+	0x1E5A:  {false, "R\u0323"},
+	0x1E5B:  {false, "r\u0323"},
+	0x1E5C:  {false, "\u1e5a\u0304"},
+	0x1E5D:  {false, "\u1e5b\u0304"},
+	0x1E5E:  {false, "R\u0331"},
+	0x1E5F:  {false, "r\u0331"},
+	0x1E60:  {false, "S\u0307"},
+	0x1E61:  {false, "s\u0307"},
+	0x1E62:  {false, "S\u0323"},
+	0x1E63:  {false, "s\u0323"},
+	0x1E64:  {false, "\u015a\u0307"},
+	0x1E65:  {false, "\u015b\u0307"},
+	0x1E66:  {false, "\u0160\u0307"},
+	0x1E67:  {false, "\u0161\u0307"},
+	0x1E68:  {false, "\u1e62\u0307"},
+	0x1E69:  {false, "\u1e63\u0307"},
+	0x1E6A:  {false, "T\u0307"},
+	0x1E6B:  {false, "t\u0307"},
+	0x1E6C:  {false, "T\u0323"},
+	0x1E6D:  {false, "t\u0323"},
+	0x1E6E:  {false, "T\u0331"},
+	0x1E6F:  {false, "t\u0331"},
+	0x1E70:  {false, "T\u032d"},
+	0x1E71:  {false, "t\u032d"},
+	0x1E72:  {false, "U\u0324"},
+	0x1E73:  {false, "u\u0324"},
+	0x1E74:  {false, "U\u0330"},
+	0x1E75:  {false, "u\u0330"},
+	0x1E76:  {false, "U\u032d"},
+	0x1E77:  {false, "u\u032d"},
+	0x1E78:  {false, "\u0168\u0301"},
+	0x1E79:  {false, "\u0169\u0301"},
+	0x1E7A:  {false, "\u016a\u0308"},
+	0x1E7B:  {false, "\u016b\u0308"},
+	0x1E7C:  {false, "V\u0303"},
+	0x1E7D:  {false, "v\u0303"},
+	0x1E7E:  {false, "V\u0323"},
+	0x1E7F:  {false, "v\u0323"},
+	0x1E80:  {false, "W\u0300"},
+	0x1E81:  {false, "w\u0300"},
+	0x1E82:  {false, "W\u0301"},
+	0x1E83:  {false, "w\u0301"},
+	0x1E84:  {false, "W\u0308"},
+	0x1E85:  {false, "w\u0308"},
+	0x1E86:  {false, "W\u0307"},
+	0x1E87:  {false, "w\u0307"},
+	0x1E88:  {false, "W\u0323"},
+	0x1E89:  {false, "w\u0323"},
+	0x1E8A:  {false, "X\u0307"},
+	0x1E8B:  {false, "x\u0307"},
+	0x1E8C:  {false, "X\u0308"},
+	0x1E8D:  {false, "x\u0308"},
+	0x1E8E:  {false, "Y\u0307"},
+	0x1E8F:  {false, "y\u0307"},
+	0x1E90:  {false, "Z\u0302"},
+	0x1E91:  {false, "z\u0302"},
+	0x1E92:  {false, "Z\u0323"},
+	0x1E93:  {false, "z\u0323"},
+	0x1E94:  {false, "Z\u0331"},
+	0x1E95:  {false, "z\u0331"},
+	0x1E96:  {false, "h\u0331"},
+	0x1E97:  {false, "t\u0308"},
+	0x1E98:  {false, "w\u030a"},
+	0x1E99:  {false, "y\u030a"},
+	0x1E9A:  {true, "a\u02be"},
+	0x1E9B:  {false, "\u017f\u0307"},
+	0x1EA0:  {false, "A\u0323"},
+	0x1EA1:  {false, "a\u0323"},
+	0x1EA2:  {false, "A\u0309"},
+	0x1EA3:  {false, "a\u0309"},
+	0x1EA4:  {false, "\u00c2\u0301"},
+	0x1EA5:  {false, "\u00e2\u0301"},
+	0x1EA6:  {false, "\u00c2\u0300"},
+	0x1EA7:  {false, "\u00e2\u0300"},
+	0x1EA8:  {false, "\u00c2\u0309"},
+	0x1EA9:  {false, "\u00e2\u0309"},
+	0x1EAA:  {false, "\u00c2\u0303"},
+	0x1EAB:  {false, "\u00e2\u0303"},
+	0x1EAC:  {false, "\u1ea0\u0302"},
+	0x1EAD:  {false, "\u1ea1\u0302"},
+	0x1EAE:  {false, "\u0102\u0301"},
+	0x1EAF:  {false, "\u0103\u0301"},
+	0x1EB0:  {false, "\u0102\u0300"},
+	0x1EB1:  {false, "\u0103\u0300"},
+	0x1EB2:  {false, "\u0102\u0309"},
+	0x1EB3:  {false, "\u0103\u0309"},
+	0x1EB4:  {false, "\u0102\u0303"},
+	0x1EB5:  {false, "\u0103\u0303"},
+	0x1EB6:  {false, "\u1ea0\u0306"},
+	0x1EB7:  {false, "\u1ea1\u0306"},
+	0x1EB8:  {false, "E\u0323"},
+	0x1EB9:  {false, "e\u0323"},
+	0x1EBA:  {false, "E\u0309"},
+	0x1EBB:  {false, "e\u0309"},
+	0x1EBC:  {false, "E\u0303"},
+	0x1EBD:  {false, "e\u0303"},
+	0x1EBE:  {false, "\u00ca\u0301"},
+	0x1EBF:  {false, "\u00ea\u0301"},
+	0x1EC0:  {false, "\u00ca\u0300"},
+	0x1EC1:  {false, "\u00ea\u0300"},
+	0x1EC2:  {false, "\u00ca\u0309"},
+	0x1EC3:  {false, "\u00ea\u0309"},
+	0x1EC4:  {false, "\u00ca\u0303"},
+	0x1EC5:  {false, "\u00ea\u0303"},
+	0x1EC6:  {false, "\u1eb8\u0302"},
+	0x1EC7:  {false, "\u1eb9\u0302"},
+	0x1EC8:  {false, "I\u0309"},
+	0x1EC9:  {false, "i\u0309"},
+	0x1ECA:  {false, "I\u0323"},
+	0x1ECB:  {false, "i\u0323"},
+	0x1ECC:  {false, "O\u0323"},
+	0x1ECD:  {false, "o\u0323"},
+	0x1ECE:  {false, "O\u0309"},
+	0x1ECF:  {false, "o\u0309"},
+	0x1ED0:  {false, "\u00d4\u0301"},
+	0x1ED1:  {false, "\u00f4\u0301"},
+	0x1ED2:  {false, "\u00d4\u0300"},
+	0x1ED3:  {false, "\u00f4\u0300"},
+	0x1ED4:  {false, "\u00d4\u0309"},
+	0x1ED5:  {false, "\u00f4\u0309"},
+	0x1ED6:  {false, "\u00d4\u0303"},
+	0x1ED7:  {false, "\u00f4\u0303"},
+	0x1ED8:  {false, "\u1ecc\u0302"},
+	0x1ED9:  {false, "\u1ecd\u0302"},
+	0x1EDA:  {false, "\u01a0\u0301"},
+	0x1EDB:  {false, "\u01a1\u0301"},
+	0x1EDC:  {false, "\u01a0\u0300"},
+	0x1EDD:  {false, "\u01a1\u0300"},
+	0x1EDE:  {false, "\u01a0\u0309"},
+	0x1EDF:  {false, "\u01a1\u0309"},
+	0x1EE0:  {false, "\u01a0\u0303"},
+	0x1EE1:  {false, "\u01a1\u0303"},
+	0x1EE2:  {false, "\u01a0\u0323"},
+	0x1EE3:  {false, "\u01a1\u0323"},
+	0x1EE4:  {false, "U\u0323"},
+	0x1EE5:  {false, "u\u0323"},
+	0x1EE6:  {false, "U\u0309"},
+	0x1EE7:  {false, "u\u0309"},
+	0x1EE8:  {false, "\u01af\u0301"},
+	0x1EE9:  {false, "\u01b0\u0301"},
+	0x1EEA:  {false, "\u01af\u0300"},
+	0x1EEB:  {false, "\u01b0\u0300"},
+	0x1EEC:  {false, "\u01af\u0309"},
+	0x1EED:  {false, "\u01b0\u0309"},
+	0x1EEE:  {false, "\u01af\u0303"},
+	0x1EEF:  {false, "\u01b0\u0303"},
+	0x1EF0:  {false, "\u01af\u0323"},
+	0x1EF1:  {false, "\u01b0\u0323"},
+	0x1EF2:  {false, "Y\u0300"},
+	0x1EF3:  {false, "y\u0300"},
+	0x1EF4:  {false, "Y\u0323"},
+	0x1EF5:  {false, "y\u0323"},
+	0x1EF6:  {false, "Y\u0309"},
+	0x1EF7:  {false, "y\u0309"},
+	0x1EF8:  {false, "Y\u0303"},
+	0x1EF9:  {false, "y\u0303"},
+	0x1F00:  {false, "\u03b1\u0313"},
+	0x1F01:  {false, "\u03b1\u0314"},
+	0x1F02:  {false, "\u1f00\u0300"},
+	0x1F03:  {false, "\u1f01\u0300"},
+	0x1F04:  {false, "\u1f00\u0301"},
+	0x1F05:  {false, "\u1f01\u0301"},
+	0x1F06:  {false, "\u1f00\u0342"},
+	0x1F07:  {false, "\u1f01\u0342"},
+	0x1F08:  {false, "\u0391\u0313"},
+	0x1F09:  {false, "\u0391\u0314"},
+	0x1F0A:  {false, "\u1f08\u0300"},
+	0x1F0B:  {false, "\u1f09\u0300"},
+	0x1F0C:  {false, "\u1f08\u0301"},
+	0x1F0D:  {false, "\u1f09\u0301"},
+	0x1F0E:  {false, "\u1f08\u0342"},
+	0x1F0F:  {false, "\u1f09\u0342"},
+	0x1F10:  {false, "\u03b5\u0313"},
+	0x1F11:  {false, "\u03b5\u0314"},
+	0x1F12:  {false, "\u1f10\u0300"},
+	0x1F13:  {false, "\u1f11\u0300"},
+	0x1F14:  {false, "\u1f10\u0301"},
+	0x1F15:  {false, "\u1f11\u0301"},
+	0x1F18:  {false, "\u0395\u0313"},
+	0x1F19:  {false, "\u0395\u0314"},
+	0x1F1A:  {false, "\u1f18\u0300"},
+	0x1F1B:  {false, "\u1f19\u0300"},
+	0x1F1C:  {false, "\u1f18\u0301"},
+	0x1F1D:  {false, "\u1f19\u0301"},
+	0x1F20:  {false, "\u03b7\u0313"},
+	0x1F21:  {false, "\u03b7\u0314"},
+	0x1F22:  {false, "\u1f20\u0300"},
+	0x1F23:  {false, "\u1f21\u0300"},
+	0x1F24:  {false, "\u1f20\u0301"},
+	0x1F25:  {false, "\u1f21\u0301"},
+	0x1F26:  {false, "\u1f20\u0342"},
+	0x1F27:  {false, "\u1f21\u0342"},
+	0x1F28:  {false, "\u0397\u0313"},
+	0x1F29:  {false, "\u0397\u0314"},
+	0x1F2A:  {false, "\u1f28\u0300"},
+	0x1F2B:  {false, "\u1f29\u0300"},
+	0x1F2C:  {false, "\u1f28\u0301"},
+	0x1F2D:  {false, "\u1f29\u0301"},
+	0x1F2E:  {false, "\u1f28\u0342"},
+	0x1F2F:  {false, "\u1f29\u0342"},
+	0x1F30:  {false, "\u03b9\u0313"},
+	0x1F31:  {false, "\u03b9\u0314"},
+	0x1F32:  {false, "\u1f30\u0300"},
+	0x1F33:  {false, "\u1f31\u0300"},
+	0x1F34:  {false, "\u1f30\u0301"},
+	0x1F35:  {false, "\u1f31\u0301"},
+	0x1F36:  {false, "\u1f30\u0342"},
+	0x1F37:  {false, "\u1f31\u0342"},
+	0x1F38:  {false, "\u0399\u0313"},
+	0x1F39:  {false, "\u0399\u0314"},
+	0x1F3A:  {false, "\u1f38\u0300"},
+	0x1F3B:  {false, "\u1f39\u0300"},
+	0x1F3C:  {false, "\u1f38\u0301"},
+	0x1F3D:  {false, "\u1f39\u0301"},
+	0x1F3E:  {false, "\u1f38\u0342"},
+	0x1F3F:  {false, "\u1f39\u0342"},
+	0x1F40:  {false, "\u03bf\u0313"},
+	0x1F41:  {false, "\u03bf\u0314"},
+	0x1F42:  {false, "\u1f40\u0300"},
+	0x1F43:  {false, "\u1f41\u0300"},
+	0x1F44:  {false, "\u1f40\u0301"},
+	0x1F45:  {false, "\u1f41\u0301"},
+	0x1F48:  {false, "\u039f\u0313"},
+	0x1F49:  {false, "\u039f\u0314"},
+	0x1F4A:  {false, "\u1f48\u0300"},
+	0x1F4B:  {false, "\u1f49\u0300"},
+	0x1F4C:  {false, "\u1f48\u0301"},
+	0x1F4D:  {false, "\u1f49\u0301"},
+	0x1F50:  {false, "\u03c5\u0313"},
+	0x1F51:  {false, "\u03c5\u0314"},
+	0x1F52:  {false, "\u1f50\u0300"},
+	0x1F53:  {false, "\u1f51\u0300"},
+	0x1F54:  {false, "\u1f50\u0301"},
+	0x1F55:  {false, "\u1f51\u0301"},
+	0x1F56:  {false, "\u1f50\u0342"},
+	0x1F57:  {false, "\u1f51\u0342"},
+	0x1F59:  {false, "\u03a5\u0314"},
+	0x1F5B:  {false, "\u1f59\u0300"},
+	0x1F5D:  {false, "\u1f59\u0301"},
+	0x1F5F:  {false, "\u1f59\u0342"},
+	0x1F60:  {false, "\u03c9\u0313"},
+	0x1F61:  {false, "\u03c9\u0314"},
+	0x1F62:  {false, "\u1f60\u0300"},
+	0x1F63:  {false, "\u1f61\u0300"},
+	0x1F64:  {false, "\u1f60\u0301"},
+	0x1F65:  {false, "\u1f61\u0301"},
+	0x1F66:  {false, "\u1f60\u0342"},
+	0x1F67:  {false, "\u1f61\u0342"},
+	0x1F68:  {false, "\u03a9\u0313"},
+	0x1F69:  {false, "\u03a9\u0314"},
+	0x1F6A:  {false, "\u1f68\u0300"},
+	0x1F6B:  {false, "\u1f69\u0300"},
+	0x1F6C:  {false, "\u1f68\u0301"},
+	0x1F6D:  {false, "\u1f69\u0301"},
+	0x1F6E:  {false, "\u1f68\u0342"},
+	0x1F6F:  {false, "\u1f69\u0342"},
+	0x1F70:  {false, "\u03b1\u0300"},
+	0x1F71:  {false, "\u03ac"},
+	0x1F72:  {false, "\u03b5\u0300"},
+	0x1F73:  {false, "\u03ad"},
+	0x1F74:  {false, "\u03b7\u0300"},
+	0x1F75:  {false, "\u03ae"},
+	0x1F76:  {false, "\u03b9\u0300"},
+	0x1F77:  {false, "\u03af"},
+	0x1F78:  {false, "\u03bf\u0300"},
+	0x1F79:  {false, "\u03cc"},
+	0x1F7A:  {false, "\u03c5\u0300"},
+	0x1F7B:  {false, "\u03cd"},
+	0x1F7C:  {false, "\u03c9\u0300"},
+	0x1F7D:  {false, "\u03ce"},
+	0x1F80:  {false, "\u1f00\u0345"},
+	0x1F81:  {false, "\u1f01\u0345"},
+	0x1F82:  {false, "\u1f02\u0345"},
+	0x1F83:  {false, "\u1f03\u0345"},
+	0x1F84:  {false, "\u1f04\u0345"},
+	0x1F85:  {false, "\u1f05\u0345"},
+	0x1F86:  {false, "\u1f06\u0345"},
+	0x1F87:  {false, "\u1f07\u0345"},
+	0x1F88:  {false, "\u1f08\u0345"},
+	0x1F89:  {false, "\u1f09\u0345"},
+	0x1F8A:  {false, "\u1f0a\u0345"},
+	0x1F8B:  {false, "\u1f0b\u0345"},
+	0x1F8C:  {false, "\u1f0c\u0345"},
+	0x1F8D:  {false, "\u1f0d\u0345"},
+	0x1F8E:  {false, "\u1f0e\u0345"},
+	0x1F8F:  {false, "\u1f0f\u0345"},
+	0x1F90:  {false, "\u1f20\u0345"},
+	0x1F91:  {false, "\u1f21\u0345"},
+	0x1F92:  {false, "\u1f22\u0345"},
+	0x1F93:  {false, "\u1f23\u0345"},
+	0x1F94:  {false, "\u1f24\u0345"},
+	0x1F95:  {false, "\u1f25\u0345"},
+	0x1F96:  {false, "\u1f26\u0345"},
+	0x1F97:  {false, "\u1f27\u0345"},
+	0x1F98:  {false, "\u1f28\u0345"},
+	0x1F99:  {false, "\u1f29\u0345"},
+	0x1F9A:  {false, "\u1f2a\u0345"},
+	0x1F9B:  {false, "\u1f2b\u0345"},
+	0x1F9C:  {false, "\u1f2c\u0345"},
+	0x1F9D:  {false, "\u1f2d\u0345"},
+	0x1F9E:  {false, "\u1f2e\u0345"},
+	0x1F9F:  {false, "\u1f2f\u0345"},
+	0x1FA0:  {false, "\u1f60\u0345"},
+	0x1FA1:  {false, "\u1f61\u0345"},
+	0x1FA2:  {false, "\u1f62\u0345"},
+	0x1FA3:  {false, "\u1f63\u0345"},
+	0x1FA4:  {false, "\u1f64\u0345"},
+	0x1FA5:  {false, "\u1f65\u0345"},
+	0x1FA6:  {false, "\u1f66\u0345"},
+	0x1FA7:  {false, "\u1f67\u0345"},
+	0x1FA8:  {false, "\u1f68\u0345"},
+	0x1FA9:  {false, "\u1f69\u0345"},
+	0x1FAA:  {false, "\u1f6a\u0345"},
+	0x1FAB:  {false, "\u1f6b\u0345"},
+	0x1FAC:  {false, "\u1f6c\u0345"},
+	0x1FAD:  {false, "\u1f6d\u0345"},
+	0x1FAE:  {false, "\u1f6e\u0345"},
+	0x1FAF:  {false, "\u1f6f\u0345"},
+	0x1FB0:  {false, "\u03b1\u0306"},
+	0x1FB1:  {false, "\u03b1\u0304"},
+	0x1FB2:  {false, "\u1f70\u0345"},
+	0x1FB3:  {false, "\u03b1\u0345"},
+	0x1FB4:  {false, "\u03ac\u0345"},
+	0x1FB6:  {false, "\u03b1\u0342"},
+	0x1FB7:  {false, "\u1fb6\u0345"},
+	0x1FB8:  {false, "\u0391\u0306"},
+	0x1FB9:  {false, "\u0391\u0304"},
+	0x1FBA:  {false, "\u0391\u0300"},
+	0x1FBB:  {false, "\u0386"},
+	0x1FBC:  {false, "\u0391\u0345"},
+	0x1FBD:  {true, " \u0313"},
+	0x1FBE:  {false, "\u03b9"},
+	0x1FBF:  {true, " \u0313"},
+	0x1FC0:  {true, " \u0342"},
+	0x1FC1:  {false, "\u00a8\u0342"},
+	0x1FC2:  {false, "\u1f74\u0345"},
+	0x1FC3:  {false, "\u03b7\u0345"},
+	0x1FC4:  {false, "\u03ae\u0345"},
+	0x1FC6:  {false, "\u03b7\u0342"},
+	0x1FC7:  {false, "\u1fc6\u0345"},
+	0x1FC8:  {false, "\u0395\u0300"},
+	0x1FC9:  {false, "\u0388"},
+	0x1FCA:  {false, "\u0397\u0300"},
+	0x1FCB:  {false, "\u0389"},
+	0x1FCC:  {false, "\u0397\u0345"},
+	0x1FCD:  {false, "\u1fbf\u0300"},
+	0x1FCE:  {false, "\u1fbf\u0301"},
+	0x1FCF:  {false, "\u1fbf\u0342"},
+	0x1FD0:  {false, "\u03b9\u0306"},
+	0x1FD1:  {false, "\u03b9\u0304"},
+	0x1FD2:  {false, "\u03ca\u0300"},
+	0x1FD3:  {false, "\u0390"},
+	0x1FD6:  {false, "\u03b9\u0342"},
+	0x1FD7:  {false, "\u03ca\u0342"},
+	0x1FD8:  {false, "\u0399\u0306"},
+	0x1FD9:  {false, "\u0399\u0304"},
+	0x1FDA:  {false, "\u0399\u0300"},
+	0x1FDB:  {false, "\u038a"},
+	0x1FDD:  {false, "\u1ffe\u0300"},
+	0x1FDE:  {false, "\u1ffe\u0301"},
+	0x1FDF:  {false, "\u1ffe\u0342"},
+	0x1FE0:  {false, "\u03c5\u0306"},
+	0x1FE1:  {false, "\u03c5\u0304"},
+	0x1FE2:  {false, "\u03cb\u0300"},
+	0x1FE3:  {false, "\u03b0"},
+	0x1FE4:  {false, "\u03c1\u0313"},
+	0x1FE5:  {false, "\u03c1\u0314"},
+	0x1FE6:  {false, "\u03c5\u0342"},
+	0x1FE7:  {false, "\u03cb\u0342"},
+	0x1FE8:  {false, "\u03a5\u0306"},
+	0x1FE9:  {false, "\u03a5\u0304"},
+	0x1FEA:  {false, "\u03a5\u0300"},
+	0x1FEB:  {false, "\u038e"},
+	0x1FEC:  {false, "\u03a1\u0314"},
+	0x1FED:  {false, "\u00a8\u0300"},
+	0x1FEE:  {false, "\u0385"},
+	0x1FEF:  {false, "`"},
+	0x1FF2:  {false, "\u1f7c\u0345"},
+	0x1FF3:  {false, "\u03c9\u0345"},
+	0x1FF4:  {false, "\u03ce\u0345"},
+	0x1FF6:  {false, "\u03c9\u0342"},
+	0x1FF7:  {false, "\u1ff6\u0345"},
+	0x1FF8:  {false, "\u039f\u0300"},
+	0x1FF9:  {false, "\u038c"},
+	0x1FFA:  {false, "\u03a9\u0300"},
+	0x1FFB:  {false, "\u038f"},
+	0x1FFC:  {false, "\u03a9\u0345"},
+	0x1FFD:  {false, "\u00b4"},
+	0x1FFE:  {true, " \u0314"},
+	0x2000:  {false, "\u2002"},
+	0x2001:  {false, "\u2003"},
+	0x2002:  {true, " "},
+	0x2003:  {true, " "},
+	0x2004:  {true, " "},
+	0x2005:  {true, " "},
+	0x2006:  {true, " "},
+	0x2007:  {true, " "},
+	0x2008:  {true, " "},
+	0x2009:  {true, " "},
+	0x200A:  {true, " "},
+	0x2011:  {true, "\u2010"},
+	0x2017:  {true, " \u0333"},
+	0x2024:  {true, "."},
+	0x2025:  {true, ".."},
+	0x2026:  {true, "..."},
+	0x202F:  {true, " "},
+	0x2033:  {true, "\u2032\u2032"},
+	0x2034:  {true, "\u2032\u2032\u2032"},
+	0x2036:  {true, "\u2035\u2035"},
+	0x2037:  {true, "\u2035\u2035\u2035"},
+	0x203C:  {true, "!!"},
+	0x203E:  {true, " \u0305"},
+	0x2047:  {true, "??"},
+	0x2048:  {true, "?!"},
+	0x2049:  {true, "!?"},
+	0x2057:  {true, "\u2032\u2032\u2032\u2032"},
+	0x205F:  {true, " "},
+	0x2070:  {true, "0"},
+	0x2071:  {true, "i"},
+	0x2074:  {true, "4"},
+	0x2075:  {true, "5"},
+	0x2076:  {true, "6"},
+	0x2077:  {true, "7"},
+	0x2078:  {true, "8"},
+	0x2079:  {true, "9"},
+	0x207A:  {true, "+"},
+	0x207B:  {true, "\u2212"},
+	0x207C:  {true, "="},
+	0x207D:  {true, "("},
+	0x207E:  {true, ")"},
+	0x207F:  {true, "n"},
+	0x2080:  {true, "0"},
+	0x2081:  {true, "1"},
+	0x2082:  {true, "2"},
+	0x2083:  {true, "3"},
+	0x2084:  {true, "4"},
+	0x2085:  {true, "5"},
+	0x2086:  {true, "6"},
+	0x2087:  {true, "7"},
+	0x2088:  {true, "8"},
+	0x2089:  {true, "9"},
+	0x208A:  {true, "+"},
+	0x208B:  {true, "\u2212"},
+	0x208C:  {true, "="},
+	0x208D:  {true, "("},
+	0x208E:  {true, ")"},
+	0x2090:  {true, "a"},
+	0x2091:  {true, "e"},
+	0x2092:  {true, "o"},
+	0x2093:  {true, "x"},
+	0x2094:  {true, "\u0259"},
+	0x2095:  {true, "h"},
+	0x2096:  {true, "k"},
+	0x2097:  {true, "l"},
+	0x2098:  {true, "m"},
+	0x2099:  {true, "n"},
+	0x209A:  {true, "p"},
+	0x209B:  {true, "s"},
+	0x209C:  {true, "t"},
+	0x20A8:  {true, "Rs"},
+	0x2100:  {true, "a/c"},
+	0x2101:  {true, "a/s"},
+	0x2102:  {true, "C"},
+	0x2103:  {true, "\u00b0C"},
+	0x2105:  {true, "c/o"},
+	0x2106:  {true, "c/u"},
+	0x2107:  {true, "\u0190"},
+	0x2109:  {true, "\u00b0F"},
+	0x210A:  {true, "g"},
+	0x210B:  {true, "H"},
+	0x210C:  {true, "H"},
+	0x210D:  {true, "H"},
+	0x210E:  {true, "h"},
+	0x210F:  {true, "\u0127"},
+	0x2110:  {true, "I"},
+	0x2111:  {true, "I"},
+	0x2112:  {true, "L"},
+	0x2113:  {true, "l"},
+	0x2115:  {true, "N"},
+	0x2116:  {true, "No"},
+	0x2119:  {true, "P"},
+	0x211A:  {true, "Q"},
+	0x211B:  {true, "R"},
+	0x211C:  {true, "R"},
+	0x211D:  {true, "R"},
+	0x2120:  {true, "SM"},
+	0x2121:  {true, "TEL"},
+	0x2122:  {true, "TM"},
+	0x2124:  {true, "Z"},
+	0x2126:  {false, "\u03a9"},
+	0x2128:  {true, "Z"},
+	0x212A:  {false, "K"},
+	0x212B:  {false, "\u00c5"},
+	0x212C:  {true, "B"},
+	0x212D:  {true, "C"},
+	0x212F:  {true, "e"},
+	0x2130:  {true, "E"},
+	0x2131:  {true, "F"},
+	0x2133:  {true, "M"},
+	0x2134:  {true, "o"},
+	0x2135:  {true, "\u05d0"},
+	0x2136:  {true, "\u05d1"},
+	0x2137:  {true, "\u05d2"},
+	0x2138:  {true, "\u05d3"},
+	0x2139:  {true, "i"},
+	0x213B:  {true, "FAX"},
+	0x213C:  {true, "\u03c0"},
+	0x213D:  {true, "\u03b3"},
+	0x213E:  {true, "\u0393"},
+	0x213F:  {true, "\u03a0"},
+	0x2140:  {true, "\u2211"},
+	0x2145:  {true, "D"},
+	0x2146:  {true, "d"},
+	0x2147:  {true, "e"},
+	0x2148:  {true, "i"},
+	0x2149:  {true, "j"},
+	0x2150:  {true, "1\u20447"},
+	0x2151:  {true, "1\u20449"},
+	0x2152:  {true, "1\u204410"},
+	0x2153:  {true, "1\u20443"},
+	0x2154:  {true, "2\u20443"},
+	0x2155:  {true, "1\u20445"},
+	0x2156:  {true, "2\u20445"},
+	0x2157:  {true, "3\u20445"},
+	0x2158:  {true, "4\u20445"},
+	0x2159:  {true, "1\u20446"},
+	0x215A:  {true, "5\u20446"},
+	0x215B:  {true, "1\u20448"},
+	0x215C:  {true, "3\u20448"},
+	0x215D:  {true, "5\u20448"},
+	0x215E:  {true, "7\u20448"},
+	0x215F:  {true, "1\u2044"},
+	0x2160:  {true, "I"},
+	0x2161:  {true, "II"},
+	0x2162:  {true, "III"},
+	0x2163:  {true, "IV"},
+	0x2164:  {true, "V"},
+	0x2165:  {true, "VI"},
+	0x2166:  {true, "VII"},
+	0x2167:  {true, "VIII"},
+	0x2168:  {true, "IX"},
+	0x2169:  {true, "X"},
+	0x216A:  {true, "XI"},
+	0x216B:  {true, "XII"},
+	0x216C:  {true, "L"},
+	0x216D:  {true, "C"},
+	0x216E:  {true, "D"},
+	0x216F:  {true, "M"},
+	0x2170:  {true, "i"},
+	0x2171:  {true, "ii"},
+	0x2172:  {true, "iii"},
+	0x2173:  {true, "iv"},
+	0x2174:  {true, "v"},
+	0x2175:  {true, "vi"},
+	0x2176:  {true, "vii"},
+	0x2177:  {true, "viii"},
+	0x2178:  {true, "ix"},
+	0x2179:  {true, "x"},
+	0x217A:  {true, "xi"},
+	0x217B:  {true, "xii"},
+	0x217C:  {true, "l"},
+	0x217D:  {true, "c"},
+	0x217E:  {true, "d"},
+	0x217F:  {true, "m"},
+	0x2189:  {true, "0\u20443"},
+	0x219A:  {false, "\u2190\u0338"},
+	0x219B:  {false, "\u2192\u0338"},
+	0x21AE:  {false, "\u2194\u0338"},
+	0x21CD:  {false, "\u21d0\u0338"},
+	0x21CE:  {false, "\u21d4\u0338"},
+	0x21CF:  {false, "\u21d2\u0338"},
+	0x2204:  {false, "\u2203\u0338"},
+	0x2209:  {false, "\u2208\u0338"},
+	0x220C:  {false, "\u220b\u0338"},
+	0x2224:  {false, "\u2223\u0338"},
+	0x2226:  {false, "\u2225\u0338"},
+	0x222C:  {true, "\u222b\u222b"},
+	0x222D:  {true, "\u222b\u222b\u222b"},
+	0x222F:  {true, "\u222e\u222e"},
+	0x2230:  {true, "\u222e\u222e\u222e"},
+	0x2241:  {false, "\u223c\u0338"},
+	0x2244:  {false, "\u2243\u0338"},
+	0x2247:  {false, "\u2245\u0338"},
+	0x2249:  {false, "\u2248\u0338"},
+	0x2260:  {false, "=\u0338"},
+	0x2262:  {false, "\u2261\u0338"},
+	0x226D:  {false, "\u224d\u0338"},
+	0x226E:  {false, "<\u0338"},
+	0x226F:  {false, ">\u0338"},
+	0x2270:  {false, "\u2264\u0338"},
+	0x2271:  {false, "\u2265\u0338"},
+	0x2274:  {false, "\u2272\u0338"},
+	0x2275:  {false, "\u2273\u0338"},
+	0x2278:  {false, "\u2276\u0338"},
+	0x2279:  {false, "\u2277\u0338"},
+	0x2280:  {false, "\u227a\u0338"},
+	0x2281:  {false, "\u227b\u0338"},
+	0x2284:  {false, "\u2282\u0338"},
+	0x2285:  {false, "\u2283\u0338"},
+	0x2288:  {false, "\u2286\u0338"},
+	0x2289:  {false, "\u2287\u0338"},
+	0x22AC:  {false, "\u22a2\u0338"},
+	0x22AD:  {false, "\u22a8\u0338"},
+	0x22AE:  {false, "\u22a9\u0338"},
+	0x22AF:  {false, "\u22ab\u0338"},
+	0x22E0:  {false, "\u227c\u0338"},
+	0x22E1:  {false, "\u227d\u0338"},
+	0x22E2:  {false, "\u2291\u0338"},
+	0x22E3:  {false, "\u2292\u0338"},
+	0x22EA:  {false, "\u22b2\u0338"},
+	0x22EB:  {false, "\u22b3\u0338"},
+	0x22EC:  {false, "\u22b4\u0338"},
+	0x22ED:  {false, "\u22b5\u0338"},
+	0x2329:  {false, "\u3008"},
+	0x232A:  {false, "\u3009"},
+	0x2460:  {true, "1"},
+	0x2461:  {true, "2"},
+	0x2462:  {true, "3"},
+	0x2463:  {true, "4"},
+	0x2464:  {true, "5"},
+	0x2465:  {true, "6"},
+	0x2466:  {true, "7"},
+	0x2467:  {true, "8"},
+	0x2468:  {true, "9"},
+	0x2469:  {true, "10"},
+	0x246A:  {true, "11"},
+	0x246B:  {true, "12"},
+	0x246C:  {true, "13"},
+	0x246D:  {true, "14"},
+	0x246E:  {true, "15"},
+	0x246F:  {true, "16"},
+	0x2470:  {true, "17"},
+	0x2471:  {true, "18"},
+	0x2472:  {true, "19"},
+	0x2473:  {true, "20"},
+	0x2474:  {true, "(1)"},
+	0x2475:  {true, "(2)"},
+	0x2476:  {true, "(3)"},
+	0x2477:  {true, "(4)"},
+	0x2478:  {true, "(5)"},
+	0x2479:  {true, "(6)"},
+	0x247A:  {true, "(7)"},
+	0x247B:  {true, "(8)"},
+	0x247C:  {true, "(9)"},
+	0x247D:  {true, "(10)"},
+	0x247E:  {true, "(11)"},
+	0x247F:  {true, "(12)"},
+	0x2480:  {true, "(13)"},
+	0x2481:  {true, "(14)"},
+	0x2482:  {true, "(15)"},
+	0x2483:  {true, "(16)"},
+	0x2484:  {true, "(17)"},
+	0x2485:  {true, "(18)"},
+	0x2486:  {true, "(19)"},
+	0x2487:  {true, "(20)"},
+	0x2488:  {true, "1."},
+	0x2489:  {true, "2."},
+	0x248A:  {true, "3."},
+	0x248B:  {true, "4."},
+	0x248C:  {true, "5."},
+	0x248D:  {true, "6."},
+	0x248E:  {true, "7."},
+	0x248F:  {true, "8."},
+	0x2490:  {true, "9."},
+	0x2491:  {true, "10."},
+	0x2492:  {true, "11."},
+	0x2493:  {true, "12."},
+	0x2494:  {true, "13."},
+	0x2495:  {true, "14."},
+	0x2496:  {true, "15."},
+	0x2497:  {true, "16."},
+	0x2498:  {true, "17."},
+	0x2499:  {true, "18."},
+	0x249A:  {true, "19."},
+	0x249B:  {true, "20."},
+	0x249C:  {true, "(a)"},
+	0x249D:  {true, "(b)"},
+	0x249E:  {true, "(c)"},
+	0x249F:  {true, "(d)"},
+	0x24A0:  {true, "(e)"},
+	0x24A1:  {true, "(f)"},
+	0x24A2:  {true, "(g)"},
+	0x24A3:  {true, "(h)"},
+	0x24A4:  {true, "(i)"},
+	0x24A5:  {true, "(j)"},
+	0x24A6:  {true, "(k)"},
+	0x24A7:  {true, "(l)"},
+	0x24A8:  {true, "(m)"},
+	0x24A9:  {true, "(n)"},
+	0x24AA:  {true, "(o)"},
+	0x24AB:  {true, "(p)"},
+	0x24AC:  {true, "(q)"},
+	0x24AD:  {true, "(r)"},
+	0x24AE:  {true, "(s)"},
+	0x24AF:  {true, "(t)"},
+	0x24B0:  {true, "(u)"},
+	0x24B1:  {true, "(v)"},
+	0x24B2:  {true, "(w)"},
+	0x24B3:  {true, "(x)"},
+	0x24B4:  {true, "(y)"},
+	0x24B5:  {true, "(z)"},
+	0x24B6:  {true, "A"},
+	0x24B7:  {true, "B"},
+	0x24B8:  {true, "C"},
+	0x24B9:  {true, "D"},
+	0x24BA:  {true, "E"},
+	0x24BB:  {true, "F"},
+	0x24BC:  {true, "G"},
+	0x24BD:  {true, "H"},
+	0x24BE:  {true, "I"},
+	0x24BF:  {true, "J"},
+	0x24C0:  {true, "K"},
+	0x24C1:  {true, "L"},
+	0x24C2:  {true, "M"},
+	0x24C3:  {true, "N"},
+	0x24C4:  {true, "O"},
+	0x24C5:  {true, "P"},
+	0x24C6:  {true, "Q"},
+	0x24C7:  {true, "R"},
+	0x24C8:  {true, "S"},
+	0x24C9:  {true, "T"},
+	0x24CA:  {true, "U"},
+	0x24CB:  {true, "V"},
+	0x24CC:  {true, "W"},
+	0x24CD:  {true, "X"},
+	0x24CE:  {true, "Y"},
+	0x24CF:  {true, "Z"},
+	0x24D0:  {true, "a"},
+	0x24D1:  {true, "b"},
+	0x24D2:  {true, "c"},
+	0x24D3:  {true, "d"},
+	0x24D4:  {true, "e"},
+	0x24D5:  {true, "f"},
+	0x24D6:  {true, "g"},
+	0x24D7:  {true, "h"},
+	0x24D8:  {true, "i"},
+	0x24D9:  {true, "j"},
+	0x24DA:  {true, "k"},
+	0x24DB:  {true, "l"},
+	0x24DC:  {true, "m"},
+	0x24DD:  {true, "n"},
+	0x24DE:  {true, "o"},
+	0x24DF:  {true, "p"},
+	0x24E0:  {true, "q"},
+	0x24E1:  {true, "r"},
+	0x24E2:  {true, "s"},
+	0x24E3:  {true, "t"},
+	0x24E4:  {true, "u"},
+	0x24E5:  {true, "v"},
+	0x24E6:  {true, "w"},
+	0x24E7:  {true, "x"},
+	0x24E8:  {true, "y"},
+	0x24E9:  {true, "z"},
+	0x24EA:  {true, "0"},
+	0x2A0C:  {true, "\u222b\u222b\u222b\u222b"},
+	0x2A74:  {true, "::="},
+	0x2A75:  {true, "=="},
+	0x2A76:  {true, "==="},
+	0x2ADC:  {false, "\u2add\u0338"},
+	0x2C7C:  {true, "j"},
+	0x2C7D:  {true, "V"},
+	0x2D6F:  {true, "\u2d61"},
+	0x2E9F:  {true, "\u6bcd"},
+	0x2EF3:  {true, "\u9f9f"},
+	0x2F00:  {true, "\u4e00"},
+	0x2F01:  {true, "\u4e28"},
+	0x2F02:  {true, "\u4e36"},
+	0x2F03:  {true, "\u4e3f"},
+	0x2F04:  {true, "\u4e59"},
+	0x2F05:  {true, "\u4e85"},
+	0x2F06:  {true, "\u4e8c"},
+	0x2F07:  {true, "\u4ea0"},
+	0x2F08:  {true, "\u4eba"},
+	0x2F09:  {true, "\u513f"},
+	0x2F0A:  {true, "\u5165"},
+	0x2F0B:  {true, "\u516b"},
+	0x2F0C:  {true, "\u5182"},
+	0x2F0D:  {true, "\u5196"},
+	0x2F0E:  {true, "\u51ab"},
+	0x2F0F:  {true, "\u51e0"},
+	0x2F10:  {true, "\u51f5"},
+	0x2F11:  {true, "\u5200"},
+	0x2F12:  {true, "\u529b"},
+	0x2F13:  {true, "\u52f9"},
+	0x2F14:  {true, "\u5315"},
+	0x2F15:  {true, "\u531a"},
+	0x2F16:  {true, "\u5338"},
+	0x2F17:  {true, "\u5341"},
+	0x2F18:  {true, "\u535c"},
+	0x2F19:  {true, "\u5369"},
+	0x2F1A:  {true, "\u5382"},
+	0x2F1B:  {true, "\u53b6"},
+	0x2F1C:  {true, "\u53c8"},
+	0x2F1D:  {true, "\u53e3"},
+	0x2F1E:  {true, "\u56d7"},
+	0x2F1F:  {true, "\u571f"},
+	0x2F20:  {true, "\u58eb"},
+	0x2F21:  {true, "\u5902"},
+	0x2F22:  {true, "\u590a"},
+	0x2F23:  {true, "\u5915"},
+	0x2F24:  {true, "\u5927"},
+	0x2F25:  {true, "\u5973"},
+	0x2F26:  {true, "\u5b50"},
+	0x2F27:  {true, "\u5b80"},
+	0x2F28:  {true, "\u5bf8"},
+	0x2F29:  {true, "\u5c0f"},
+	0x2F2A:  {true, "\u5c22"},
+	0x2F2B:  {true, "\u5c38"},
+	0x2F2C:  {true, "\u5c6e"},
+	0x2F2D:  {true, "\u5c71"},
+	0x2F2E:  {true, "\u5ddb"},
+	0x2F2F:  {true, "\u5de5"},
+	0x2F30:  {true, "\u5df1"},
+	0x2F31:  {true, "\u5dfe"},
+	0x2F32:  {true, "\u5e72"},
+	0x2F33:  {true, "\u5e7a"},
+	0x2F34:  {true, "\u5e7f"},
+	0x2F35:  {true, "\u5ef4"},
+	0x2F36:  {true, "\u5efe"},
+	0x2F37:  {true, "\u5f0b"},
+	0x2F38:  {true, "\u5f13"},
+	0x2F39:  {true, "\u5f50"},
+	0x2F3A:  {true, "\u5f61"},
+	0x2F3B:  {true, "\u5f73"},
+	0x2F3C:  {true, "\u5fc3"},
+	0x2F3D:  {true, "\u6208"},
+	0x2F3E:  {true, "\u6236"},
+	0x2F3F:  {true, "\u624b"},
+	0x2F40:  {true, "\u652f"},
+	0x2F41:  {true, "\u6534"},
+	0x2F42:  {true, "\u6587"},
+	0x2F43:  {true, "\u6597"},
+	0x2F44:  {true, "\u65a4"},
+	0x2F45:  {true, "\u65b9"},
+	0x2F46:  {true, "\u65e0"},
+	0x2F47:  {true, "\u65e5"},
+	0x2F48:  {true, "\u66f0"},
+	0x2F49:  {true, "\u6708"},
+	0x2F4A:  {true, "\u6728"},
+	0x2F4B:  {true, "\u6b20"},
+	0x2F4C:  {true, "\u6b62"},
+	0x2F4D:  {true, "\u6b79"},
+	0x2F4E:  {true, "\u6bb3"},
+	0x2F4F:  {true, "\u6bcb"},
+	0x2F50:  {true, "\u6bd4"},
+	0x2F51:  {true, "\u6bdb"},
+	0x2F52:  {true, "\u6c0f"},
+	0x2F53:  {true, "\u6c14"},
+	0x2F54:  {true, "\u6c34"},
+	0x2F55:  {true, "\u706b"},
+	0x2F56:  {true, "\u722a"},
+	0x2F57:  {true, "\u7236"},
+	0x2F58:  {true, "\u723b"},
+	0x2F59:  {true, "\u723f"},
+	0x2F5A:  {true, "\u7247"},
+	0x2F5B:  {true, "\u7259"},
+	0x2F5C:  {true, "\u725b"},
+	0x2F5D:  {true, "\u72ac"},
+	0x2F5E:  {true, "\u7384"},
+	0x2F5F:  {true, "\u7389"},
+	0x2F60:  {true, "\u74dc"},
+	0x2F61:  {true, "\u74e6"},
+	0x2F62:  {true, "\u7518"},
+	0x2F63:  {true, "\u751f"},
+	0x2F64:  {true, "\u7528"},
+	0x2F65:  {true, "\u7530"},
+	0x2F66:  {true, "\u758b"},
+	0x2F67:  {true, "\u7592"},
+	0x2F68:  {true, "\u7676"},
+	0x2F69:  {true, "\u767d"},
+	0x2F6A:  {true, "\u76ae"},
+	0x2F6B:  {true, "\u76bf"},
+	0x2F6C:  {true, "\u76ee"},
+	0x2F6D:  {true, "\u77db"},
+	0x2F6E:  {true, "\u77e2"},
+	0x2F6F:  {true, "\u77f3"},
+	0x2F70:  {true, "\u793a"},
+	0x2F71:  {true, "\u79b8"},
+	0x2F72:  {true, "\u79be"},
+	0x2F73:  {true, "\u7a74"},
+	0x2F74:  {true, "\u7acb"},
+	0x2F75:  {true, "\u7af9"},
+	0x2F76:  {true, "\u7c73"},
+	0x2F77:  {true, "\u7cf8"},
+	0x2F78:  {true, "\u7f36"},
+	0x2F79:  {true, "\u7f51"},
+	0x2F7A:  {true, "\u7f8a"},
+	0x2F7B:  {true, "\u7fbd"},
+	0x2F7C:  {true, "\u8001"},
+	0x2F7D:  {true, "\u800c"},
+	0x2F7E:  {true, "\u8012"},
+	0x2F7F:  {true, "\u8033"},
+	0x2F80:  {true, "\u807f"},
+	0x2F81:  {true, "\u8089"},
+	0x2F82:  {true, "\u81e3"},
+	0x2F83:  {true, "\u81ea"},
+	0x2F84:  {true, "\u81f3"},
+	0x2F85:  {true, "\u81fc"},
+	0x2F86:  {true, "\u820c"},
+	0x2F87:  {true, "\u821b"},
+	0x2F88:  {true, "\u821f"},
+	0x2F89:  {true, "\u826e"},
+	0x2F8A:  {true, "\u8272"},
+	0x2F8B:  {true, "\u8278"},
+	0x2F8C:  {true, "\u864d"},
+	0x2F8D:  {true, "\u866b"},
+	0x2F8E:  {true, "\u8840"},
+	0x2F8F:  {true, "\u884c"},
+	0x2F90:  {true, "\u8863"},
+	0x2F91:  {true, "\u897e"},
+	0x2F92:  {true, "\u898b"},
+	0x2F93:  {true, "\u89d2"},
+	0x2F94:  {true, "\u8a00"},
+	0x2F95:  {true, "\u8c37"},
+	0x2F96:  {true, "\u8c46"},
+	0x2F97:  {true, "\u8c55"},
+	0x2F98:  {true, "\u8c78"},
+	0x2F99:  {true, "\u8c9d"},
+	0x2F9A:  {true, "\u8d64"},
+	0x2F9B:  {true, "\u8d70"},
+	0x2F9C:  {true, "\u8db3"},
+	0x2F9D:  {true, "\u8eab"},
+	0x2F9E:  {true, "\u8eca"},
+	0x2F9F:  {true, "\u8f9b"},
+	0x2FA0:  {true, "\u8fb0"},
+	0x2FA1:  {true, "\u8fb5"},
+	0x2FA2:  {true, "\u9091"},
+	0x2FA3:  {true, "\u9149"},
+	0x2FA4:  {true, "\u91c6"},
+	0x2FA5:  {true, "\u91cc"},
+	0x2FA6:  {true, "\u91d1"},
+	0x2FA7:  {true, "\u9577"},
+	0x2FA8:  {true, "\u9580"},
+	0x2FA9:  {true, "\u961c"},
+	0x2FAA:  {true, "\u96b6"},
+	0x2FAB:  {true, "\u96b9"},
+	0x2FAC:  {true, "\u96e8"},
+	0x2FAD:  {true, "\u9751"},
+	0x2FAE:  {true, "\u975e"},
+	0x2FAF:  {true, "\u9762"},
+	0x2FB0:  {true, "\u9769"},
+	0x2FB1:  {true, "\u97cb"},
+	0x2FB2:  {true, "\u97ed"},
+	0x2FB3:  {true, "\u97f3"},
+	0x2FB4:  {true, "\u9801"},
+	0x2FB5:  {true, "\u98a8"},
+	0x2FB6:  {true, "\u98db"},
+	0x2FB7:  {true, "\u98df"},
+	0x2FB8:  {true, "\u9996"},
+	0x2FB9:  {true, "\u9999"},
+	0x2FBA:  {true, "\u99ac"},
+	0x2FBB:  {true, "\u9aa8"},
+	0x2FBC:  {true, "\u9ad8"},
+	0x2FBD:  {true, "\u9adf"},
+	0x2FBE:  {true, "\u9b25"},
+	0x2FBF:  {true, "\u9b2f"},
+	0x2FC0:  {true, "\u9b32"},
+	0x2FC1:  {true, "\u9b3c"},
+	0x2FC2:  {true, "\u9b5a"},
+	0x2FC3:  {true, "\u9ce5"},
+	0x2FC4:  {true, "\u9e75"},
+	0x2FC5:  {true, "\u9e7f"},
+	0x2FC6:  {true, "\u9ea5"},
+	0x2FC7:  {true, "\u9ebb"},
+	0x2FC8:  {true, "\u9ec3"},
+	0x2FC9:  {true, "\u9ecd"},
+	0x2FCA:  {true, "\u9ed1"},
+	0x2FCB:  {true, "\u9ef9"},
+	0x2FCC:  {true, "\u9efd"},
+	0x2FCD:  {true, "\u9f0e"},
+	0x2FCE:  {true, "\u9f13"},
+	0x2FCF:  {true, "\u9f20"},
+	0x2FD0:  {true, "\u9f3b"},
+	0x2FD1:  {true, "\u9f4a"},
+	0x2FD2:  {true, "\u9f52"},
+	0x2FD3:  {true, "\u9f8d"},
+	0x2FD4:  {true, "\u9f9c"},
+	0x2FD5:  {true, "\u9fa0"},
+	0x3000:  {true, " "},
+	0x3036:  {true, "\u3012"},
+	0x3038:  {true, "\u5341"},
+	0x3039:  {true, "\u5344"},
+	0x303A:  {true, "\u5345"},
+	0x304C:  {false, "\u304b\u3099"},
+	0x304E:  {false, "\u304d\u3099"},
+	0x3050:  {false, "\u304f\u3099"},
+	0x3052:  {false, "\u3051\u3099"},
+	0x3054:  {false, "\u3053\u3099"},
+	0x3056:  {false, "\u3055\u3099"},
+	0x3058:  {false, "\u3057\u3099"},
+	0x305A:  {false, "\u3059\u3099"},
+	0x305C:  {false, "\u305b\u3099"},
+	0x305E:  {false, "\u305d\u3099"},
+	0x3060:  {false, "\u305f\u3099"},
+	0x3062:  {false, "\u3061\u3099"},
+	0x3065:  {false, "\u3064\u3099"},
+	0x3067:  {false, "\u3066\u3099"},
+	0x3069:  {false, "\u3068\u3099"},
+	0x3070:  {false, "\u306f\u3099"},
+	0x3071:  {false, "\u306f\u309a"},
+	0x3073:  {false, "\u3072\u3099"},
+	0x3074:  {false, "\u3072\u309a"},
+	0x3076:  {false, "\u3075\u3099"},
+	0x3077:  {false, "\u3075\u309a"},
+	0x3079:  {false, "\u3078\u3099"},
+	0x307A:  {false, "\u3078\u309a"},
+	0x307C:  {false, "\u307b\u3099"},
+	0x307D:  {false, "\u307b\u309a"},
+	0x3094:  {false, "\u3046\u3099"},
+	0x309B:  {true, " \u3099"},
+	0x309C:  {true, " \u309a"},
+	0x309E:  {false, "\u309d\u3099"},
+	0x309F:  {true, "\u3088\u308a"},
+	0x30AC:  {false, "\u30ab\u3099"},
+	0x30AE:  {false, "\u30ad\u3099"},
+	0x30B0:  {false, "\u30af\u3099"},
+	0x30B2:  {false, "\u30b1\u3099"},
+	0x30B4:  {false, "\u30b3\u3099"},
+	0x30B6:  {false, "\u30b5\u3099"},
+	0x30B8:  {false, "\u30b7\u3099"},
+	0x30BA:  {false, "\u30b9\u3099"},
+	0x30BC:  {false, "\u30bb\u3099"},
+	0x30BE:  {false, "\u30bd\u3099"},
+	0x30C0:  {false, "\u30bf\u3099"},
+	0x30C2:  {false, "\u30c1\u3099"},
+	0x30C5:  {false, "\u30c4\u3099"},
+	0x30C7:  {false, "\u30c6\u3099"},
+	0x30C9:  {false, "\u30c8\u3099"},
+	0x30D0:  {false, "\u30cf\u3099"},
+	0x30D1:  {false, "\u30cf\u309a"},
+	0x30D3:  {false, "\u30d2\u3099"},
+	0x30D4:  {false, "\u30d2\u309a"},
+	0x30D6:  {false, "\u30d5\u3099"},
+	0x30D7:  {false, "\u30d5\u309a"},
+	0x30D9:  {false, "\u30d8\u3099"},
+	0x30DA:  {false, "\u30d8\u309a"},
+	0x30DC:  {false, "\u30db\u3099"},
+	0x30DD:  {false, "\u30db\u309a"},
+	0x30F4:  {false, "\u30a6\u3099"},
+	0x30F7:  {false, "\u30ef\u3099"},
+	0x30F8:  {false, "\u30f0\u3099"},
+	0x30F9:  {false, "\u30f1\u3099"},
+	0x30FA:  {false, "\u30f2\u3099"},
+	0x30FE:  {false, "\u30fd\u3099"},
+	0x30FF:  {true, "\u30b3\u30c8"},
+	0x3131:  {true, "\u1100"},
+	0x3132:  {true, "\u1101"},
+	0x3133:  {true, "\u11aa"},
+	0x3134:  {true, "\u1102"},
+	0x3135:  {true, "\u11ac"},
+	0x3136:  {true, "\u11ad"},
+	0x3137:  {true, "\u1103"},
+	0x3138:  {true, "\u1104"},
+	0x3139:  {true, "\u1105"},
+	0x313A:  {true, "\u11b0"},
+	0x313B:  {true, "\u11b1"},
+	0x313C:  {true, "\u11b2"},
+	0x313D:  {true, "\u11b3"},
+	0x313E:  {true, "\u11b4"},
+	0x313F:  {true, "\u11b5"},
+	0x3140:  {true, "\u111a"},
+	0x3141:  {true, "\u1106"},
+	0x3142:  {true, "\u1107"},
+	0x3143:  {true, "\u1108"},
+	0x3144:  {true, "\u1121"},
+	0x3145:  {true, "\u1109"},
+	0x3146:  {true, "\u110a"},
+	0x3147:  {true, "\u110b"},
+	0x3148:  {true, "\u110c"},
+	0x3149:  {true, "\u110d"},
+	0x314A:  {true, "\u110e"},
+	0x314B:  {true, "\u110f"},
+	0x314C:  {true, "\u1110"},
+	0x314D:  {true, "\u1111"},
+	0x314E:  {true, "\u1112"},
+	0x314F:  {true, "\u1161"},
+	0x3150:  {true, "\u1162"},
+	0x3151:  {true, "\u1163"},
+	0x3152:  {true, "\u1164"},
+	0x3153:  {true, "\u1165"},
+	0x3154:  {true, "\u1166"},
+	0x3155:  {true, "\u1167"},
+	0x3156:  {true, "\u1168"},
+	0x3157:  {true, "\u1169"},
+	0x3158:  {true, "\u116a"},
+	0x3159:  {true, "\u116b"},
+	0x315A:  {true, "\u116c"},
+	0x315B:  {true, "\u116d"},
+	0x315C:  {true, "\u116e"},
+	0x315D:  {true, "\u116f"},
+	0x315E:  {true, "\u1170"},
+	0x315F:  {true, "\u1171"},
+	0x3160:  {true, "\u1172"},
+	0x3161:  {true, "\u1173"},
+	0x3162:  {true, "\u1174"},
+	0x3163:  {true, "\u1175"},
+	0x3164:  {true, "\u1160"},
+	0x3165:  {true, "\u1114"},
+	0x3166:  {true, "\u1115"},
+	0x3167:  {true, "\u11c7"},
+	0x3168:  {true, "\u11c8"},
+	0x3169:  {true, "\u11cc"},
+	0x316A:  {true, "\u11ce"},
+	0x316B:  {true, "\u11d3"},
+	0x316C:  {true, "\u11d7"},
+	0x316D:  {true, "\u11d9"},
+	0x316E:  {true, "\u111c"},
+	0x316F:  {true, "\u11dd"},
+	0x3170:  {true, "\u11df"},
+	0x3171:  {true, "\u111d"},
+	0x3172:  {true, "\u111e"},
+	0x3173:  {true, "\u1120"},
+	0x3174:  {true, "\u1122"},
+	0x3175:  {true, "\u1123"},
+	0x3176:  {true, "\u1127"},
+	0x3177:  {true, "\u1129"},
+	0x3178:  {true, "\u112b"},
+	0x3179:  {true, "\u112c"},
+	0x317A:  {true, "\u112d"},
+	0x317B:  {true, "\u112e"},
+	0x317C:  {true, "\u112f"},
+	0x317D:  {true, "\u1132"},
+	0x317E:  {true, "\u1136"},
+	0x317F:  {true, "\u1140"},
+	0x3180:  {true, "\u1147"},
+	0x3181:  {true, "\u114c"},
+	0x3182:  {true, "\u11f1"},
+	0x3183:  {true, "\u11f2"},
+	0x3184:  {true, "\u1157"},
+	0x3185:  {true, "\u1158"},
+	0x3186:  {true, "\u1159"},
+	0x3187:  {true, "\u1184"},
+	0x3188:  {true, "\u1185"},
+	0x3189:  {true, "\u1188"},
+	0x318A:  {true, "\u1191"},
+	0x318B:  {true, "\u1192"},
+	0x318C:  {true, "\u1194"},
+	0x318D:  {true, "\u119e"},
+	0x318E:  {true, "\u11a1"},
+	0x3192:  {true, "\u4e00"},
+	0x3193:  {true, "\u4e8c"},
+	0x3194:  {true, "\u4e09"},
+	0x3195:  {true, "\u56db"},
+	0x3196:  {true, "\u4e0a"},
+	0x3197:  {true, "\u4e2d"},
+	0x3198:  {true, "\u4e0b"},
+	0x3199:  {true, "\u7532"},
+	0x319A:  {true, "\u4e59"},
+	0x319B:  {true, "\u4e19"},
+	0x319C:  {true, "\u4e01"},
+	0x319D:  {true, "\u5929"},
+	0x319E:  {true, "\u5730"},
+	0x319F:  {true, "\u4eba"},
+	0x3200:  {true, "(\u1100)"},
+	0x3201:  {true, "(\u1102)"},
+	0x3202:  {true, "(\u1103)"},
+	0x3203:  {true, "(\u1105)"},
+	0x3204:  {true, "(\u1106)"},
+	0x3205:  {true, "(\u1107)"},
+	0x3206:  {true, "(\u1109)"},
+	0x3207:  {true, "(\u110b)"},
+	0x3208:  {true, "(\u110c)"},
+	0x3209:  {true, "(\u110e)"},
+	0x320A:  {true, "(\u110f)"},
+	0x320B:  {true, "(\u1110)"},
+	0x320C:  {true, "(\u1111)"},
+	0x320D:  {true, "(\u1112)"},
+	0x320E:  {true, "(\u1100\u1161)"},
+	0x320F:  {true, "(\u1102\u1161)"},
+	0x3210:  {true, "(\u1103\u1161)"},
+	0x3211:  {true, "(\u1105\u1161)"},
+	0x3212:  {true, "(\u1106\u1161)"},
+	0x3213:  {true, "(\u1107\u1161)"},
+	0x3214:  {true, "(\u1109\u1161)"},
+	0x3215:  {true, "(\u110b\u1161)"},
+	0x3216:  {true, "(\u110c\u1161)"},
+	0x3217:  {true, "(\u110e\u1161)"},
+	0x3218:  {true, "(\u110f\u1161)"},
+	0x3219:  {true, "(\u1110\u1161)"},
+	0x321A:  {true, "(\u1111\u1161)"},
+	0x321B:  {true, "(\u1112\u1161)"},
+	0x321C:  {true, "(\u110c\u116e)"},
+	0x321D:  {true, "(\u110b\u1169\u110c\u1165\u11ab)"},
+	0x321E:  {true, "(\u110b\u1169\u1112\u116e)"},
+	0x3220:  {true, "(\u4e00)"},
+	0x3221:  {true, "(\u4e8c)"},
+	0x3222:  {true, "(\u4e09)"},
+	0x3223:  {true, "(\u56db)"},
+	0x3224:  {true, "(\u4e94)"},
+	0x3225:  {true, "(\u516d)"},
+	0x3226:  {true, "(\u4e03)"},
+	0x3227:  {true, "(\u516b)"},
+	0x3228:  {true, "(\u4e5d)"},
+	0x3229:  {true, "(\u5341)"},
+	0x322A:  {true, "(\u6708)"},
+	0x322B:  {true, "(\u706b)"},
+	0x322C:  {true, "(\u6c34)"},
+	0x322D:  {true, "(\u6728)"},
+	0x322E:  {true, "(\u91d1)"},
+	0x322F:  {true, "(\u571f)"},
+	0x3230:  {true, "(\u65e5)"},
+	0x3231:  {true, "(\u682a)"},
+	0x3232:  {true, "(\u6709)"},
+	0x3233:  {true, "(\u793e)"},
+	0x3234:  {true, "(\u540d)"},
+	0x3235:  {true, "(\u7279)"},
+	0x3236:  {true, "(\u8ca1)"},
+	0x3237:  {true, "(\u795d)"},
+	0x3238:  {true, "(\u52b4)"},
+	0x3239:  {true, "(\u4ee3)"},
+	0x323A:  {true, "(\u547c)"},
+	0x323B:  {true, "(\u5b66)"},
+	0x323C:  {true, "(\u76e3)"},
+	0x323D:  {true, "(\u4f01)"},
+	0x323E:  {true, "(\u8cc7)"},
+	0x323F:  {true, "(\u5354)"},
+	0x3240:  {true, "(\u796d)"},
+	0x3241:  {true, "(\u4f11)"},
+	0x3242:  {true, "(\u81ea)"},
+	0x3243:  {true, "(\u81f3)"},
+	0x3244:  {true, "\u554f"},
+	0x3245:  {true, "\u5e7c"},
+	0x3246:  {true, "\u6587"},
+	0x3247:  {true, "\u7b8f"},
+	0x3250:  {true, "PTE"},
+	0x3251:  {true, "21"},
+	0x3252:  {true, "22"},
+	0x3253:  {true, "23"},
+	0x3254:  {true, "24"},
+	0x3255:  {true, "25"},
+	0x3256:  {true, "26"},
+	0x3257:  {true, "27"},
+	0x3258:  {true, "28"},
+	0x3259:  {true, "29"},
+	0x325A:  {true, "30"},
+	0x325B:  {true, "31"},
+	0x325C:  {true, "32"},
+	0x325D:  {true, "33"},
+	0x325E:  {true, "34"},
+	0x325F:  {true, "35"},
+	0x3260:  {true, "\u1100"},
+	0x3261:  {true, "\u1102"},
+	0x3262:  {true, "\u1103"},
+	0x3263:  {true, "\u1105"},
+	0x3264:  {true, "\u1106"},
+	0x3265:  {true, "\u1107"},
+	0x3266:  {true, "\u1109"},
+	0x3267:  {true, "\u110b"},
+	0x3268:  {true, "\u110c"},
+	0x3269:  {true, "\u110e"},
+	0x326A:  {true, "\u110f"},
+	0x326B:  {true, "\u1110"},
+	0x326C:  {true, "\u1111"},
+	0x326D:  {true, "\u1112"},
+	0x326E:  {true, "\u1100\u1161"},
+	0x326F:  {true, "\u1102\u1161"},
+	0x3270:  {true, "\u1103\u1161"},
+	0x3271:  {true, "\u1105\u1161"},
+	0x3272:  {true, "\u1106\u1161"},
+	0x3273:  {true, "\u1107\u1161"},
+	0x3274:  {true, "\u1109\u1161"},
+	0x3275:  {true, "\u110b\u1161"},
+	0x3276:  {true, "\u110c\u1161"},
+	0x3277:  {true, "\u110e\u1161"},
+	0x3278:  {true, "\u110f\u1161"},
+	0x3279:  {true, "\u1110\u1161"},
+	0x327A:  {true, "\u1111\u1161"},
+	0x327B:  {true, "\u1112\u1161"},
+	0x327C:  {true, "\u110e\u1161\u11b7\u1100\u1169"},
+	0x327D:  {true, "\u110c\u116e\u110b\u1174"},
+	0x327E:  {true, "\u110b\u116e"},
+	0x3280:  {true, "\u4e00"},
+	0x3281:  {true, "\u4e8c"},
+	0x3282:  {true, "\u4e09"},
+	0x3283:  {true, "\u56db"},
+	0x3284:  {true, "\u4e94"},
+	0x3285:  {true, "\u516d"},
+	0x3286:  {true, "\u4e03"},
+	0x3287:  {true, "\u516b"},
+	0x3288:  {true, "\u4e5d"},
+	0x3289:  {true, "\u5341"},
+	0x328A:  {true, "\u6708"},
+	0x328B:  {true, "\u706b"},
+	0x328C:  {true, "\u6c34"},
+	0x328D:  {true, "\u6728"},
+	0x328E:  {true, "\u91d1"},
+	0x328F:  {true, "\u571f"},
+	0x3290:  {true, "\u65e5"},
+	0x3291:  {true, "\u682a"},
+	0x3292:  {true, "\u6709"},
+	0x3293:  {true, "\u793e"},
+	0x3294:  {true, "\u540d"},
+	0x3295:  {true, "\u7279"},
+	0x3296:  {true, "\u8ca1"},
+	0x3297:  {true, "\u795d"},
+	0x3298:  {true, "\u52b4"},
+	0x3299:  {true, "\u79d8"},
+	0x329A:  {true, "\u7537"},
+	0x329B:  {true, "\u5973"},
+	0x329C:  {true, "\u9069"},
+	0x329D:  {true, "\u512a"},
+	0x329E:  {true, "\u5370"},
+	0x329F:  {true, "\u6ce8"},
+	0x32A0:  {true, "\u9805"},
+	0x32A1:  {true, "\u4f11"},
+	0x32A2:  {true, "\u5199"},
+	0x32A3:  {true, "\u6b63"},
+	0x32A4:  {true, "\u4e0a"},
+	0x32A5:  {true, "\u4e2d"},
+	0x32A6:  {true, "\u4e0b"},
+	0x32A7:  {true, "\u5de6"},
+	0x32A8:  {true, "\u53f3"},
+	0x32A9:  {true, "\u533b"},
+	0x32AA:  {true, "\u5b97"},
+	0x32AB:  {true, "\u5b66"},
+	0x32AC:  {true, "\u76e3"},
+	0x32AD:  {true, "\u4f01"},
+	0x32AE:  {true, "\u8cc7"},
+	0x32AF:  {true, "\u5354"},
+	0x32B0:  {true, "\u591c"},
+	0x32B1:  {true, "36"},
+	0x32B2:  {true, "37"},
+	0x32B3:  {true, "38"},
+	0x32B4:  {true, "39"},
+	0x32B5:  {true, "40"},
+	0x32B6:  {true, "41"},
+	0x32B7:  {true, "42"},
+	0x32B8:  {true, "43"},
+	0x32B9:  {true, "44"},
+	0x32BA:  {true, "45"},
+	0x32BB:  {true, "46"},
+	0x32BC:  {true, "47"},
+	0x32BD:  {true, "48"},
+	0x32BE:  {true, "49"},
+	0x32BF:  {true, "50"},
+	0x32C0:  {true, "1\u6708"},
+	0x32C1:  {true, "2\u6708"},
+	0x32C2:  {true, "3\u6708"},
+	0x32C3:  {true, "4\u6708"},
+	0x32C4:  {true, "5\u6708"},
+	0x32C5:  {true, "6\u6708"},
+	0x32C6:  {true, "7\u6708"},
+	0x32C7:  {true, "8\u6708"},
+	0x32C8:  {true, "9\u6708"},
+	0x32C9:  {true, "10\u6708"},
+	0x32CA:  {true, "11\u6708"},
+	0x32CB:  {true, "12\u6708"},
+	0x32CC:  {true, "Hg"},
+	0x32CD:  {true, "erg"},
+	0x32CE:  {true, "eV"},
+	0x32CF:  {true, "LTD"},
+	0x32D0:  {true, "\u30a2"},
+	0x32D1:  {true, "\u30a4"},
+	0x32D2:  {true, "\u30a6"},
+	0x32D3:  {true, "\u30a8"},
+	0x32D4:  {true, "\u30aa"},
+	0x32D5:  {true, "\u30ab"},
+	0x32D6:  {true, "\u30ad"},
+	0x32D7:  {true, "\u30af"},
+	0x32D8:  {true, "\u30b1"},
+	0x32D9:  {true, "\u30b3"},
+	0x32DA:  {true, "\u30b5"},
+	0x32DB:  {true, "\u30b7"},
+	0x32DC:  {true, "\u30b9"},
+	0x32DD:  {true, "\u30bb"},
+	0x32DE:  {true, "\u30bd"},
+	0x32DF:  {true, "\u30bf"},
+	0x32E0:  {true, "\u30c1"},
+	0x32E1:  {true, "\u30c4"},
+	0x32E2:  {true, "\u30c6"},
+	0x32E3:  {true, "\u30c8"},
+	0x32E4:  {true, "\u30ca"},
+	0x32E5:  {true, "\u30cb"},
+	0x32E6:  {true, "\u30cc"},
+	0x32E7:  {true, "\u30cd"},
+	0x32E8:  {true, "\u30ce"},
+	0x32E9:  {true, "\u30cf"},
+	0x32EA:  {true, "\u30d2"},
+	0x32EB:  {true, "\u30d5"},
+	0x32EC:  {true, "\u30d8"},
+	0x32ED:  {true, "\u30db"},
+	0x32EE:  {true, "\u30de"},
+	0x32EF:  {true, "\u30df"},
+	0x32F0:  {true, "\u30e0"},
+	0x32F1:  {true, "\u30e1"},
+	0x32F2:  {true, "\u30e2"},
+	0x32F3:  {true, "\u30e4"},
+	0x32F4:  {true, "\u30e6"},
+	0x32F5:  {true, "\u30e8"},
+	0x32F6:  {true, "\u30e9"},
+	0x32F7:  {true, "\u30ea"},
+	0x32F8:  {true, "\u30eb"},
+	0x32F9:  {true, "\u30ec"},
+	0x32FA:  {true, "\u30ed"},
+	0x32FB:  {true, "\u30ef"},
+	0x32FC:  {true, "\u30f0"},
+	0x32FD:  {true, "\u30f1"},
+	0x32FE:  {true, "\u30f2"},
+	0x32FF:  {true, "\u4ee4\u548c"},
+	0x3300:  {true, "\u30a2\u30d1\u30fc\u30c8"},
+	0x3301:  {true, "\u30a2\u30eb\u30d5\u30a1"},
+	0x3302:  {true, "\u30a2\u30f3\u30da\u30a2"},
+	0x3303:  {true, "\u30a2\u30fc\u30eb"},
+	0x3304:  {true, "\u30a4\u30cb\u30f3\u30b0"},
+	0x3305:  {true, "\u30a4\u30f3\u30c1"},
+	0x3306:  {true, "\u30a6\u30a9\u30f3"},
+	0x3307:  {true, "\u30a8\u30b9\u30af\u30fc\u30c9"},
+	0x3308:  {true, "\u30a8\u30fc\u30ab\u30fc"},
+	0x3309:  {true, "\u30aa\u30f3\u30b9"},
+	0x330A:  {true, "\u30aa\u30fc\u30e0"},
+	0x330B:  {true, "\u30ab\u30a4\u30ea"},
+	0x330C:  {true, "\u30ab\u30e9\u30c3\u30c8"},
+	0x330D:  {true, "\u30ab\u30ed\u30ea\u30fc"},
+	0x330E:  {true, "\u30ac\u30ed\u30f3"},
+	0x330F:  {true, "\u30ac\u30f3\u30de"},
+	0x3310:  {true, "\u30ae\u30ac"},
+	0x3311:  {true, "\u30ae\u30cb\u30fc"},
+	0x3312:  {true, "\u30ad\u30e5\u30ea\u30fc"},
+	0x3313:  {true, "\u30ae\u30eb\u30c0\u30fc"},
+	0x3314:  {true, "\u30ad\u30ed"},
+	0x3315:  {true, "\u30ad\u30ed\u30b0\u30e9\u30e0"},
+	0x3316:  {true, "\u30ad\u30ed\u30e1\u30fc\u30c8\u30eb"},
+	0x3317:  {true, "\u30ad\u30ed\u30ef\u30c3\u30c8"},
+	0x3318:  {true, "\u30b0\u30e9\u30e0"},
+	0x3319:  {true, "\u30b0\u30e9\u30e0\u30c8\u30f3"},
+	0x331A:  {true, "\u30af\u30eb\u30bc\u30a4\u30ed"},
+	0x331B:  {true, "\u30af\u30ed\u30fc\u30cd"},
+	0x331C:  {true, "\u30b1\u30fc\u30b9"},
+	0x331D:  {true, "\u30b3\u30eb\u30ca"},
+	0x331E:  {true, "\u30b3\u30fc\u30dd"},
+	0x331F:  {true, "\u30b5\u30a4\u30af\u30eb"},
+	0x3320:  {true, "\u30b5\u30f3\u30c1\u30fc\u30e0"},
+	0x3321:  {true, "\u30b7\u30ea\u30f3\u30b0"},
+	0x3322:  {true, "\u30bb\u30f3\u30c1"},
+	0x3323:  {true, "\u30bb\u30f3\u30c8"},
+	0x3324:  {true, "\u30c0\u30fc\u30b9"},
+	0x3325:  {true, "\u30c7\u30b7"},
+	0x3326:  {true, "\u30c9\u30eb"},
+	0x3327:  {true, "\u30c8\u30f3"},
+	0x3328:  {true, "\u30ca\u30ce"},
+	0x3329:  {true, "\u30ce\u30c3\u30c8"},
+	0x332A:  {true, "\u30cf\u30a4\u30c4"},
+	0x332B:  {true, "\u30d1\u30fc\u30bb\u30f3\u30c8"},
+	0x332C:  {true, "\u30d1\u30fc\u30c4"},
+	0x332D:  {true, "\u30d0\u30fc\u30ec\u30eb"},
+	0x332E:  {true, "\u30d4\u30a2\u30b9\u30c8\u30eb"},
+	0x332F:  {true, "\u30d4\u30af\u30eb"},
+	0x3330:  {true, "\u30d4\u30b3"},
+	0x3331:  {true, "\u30d3\u30eb"},
+	0x3332:  {true, "\u30d5\u30a1\u30e9\u30c3\u30c9"},
+	0x3333:  {true, "\u30d5\u30a3\u30fc\u30c8"},
+	0x3334:  {true, "\u30d6\u30c3\u30b7\u30a7\u30eb"},
+	0x3335:  {true, "\u30d5\u30e9\u30f3"},
+	0x3336:  {true, "\u30d8\u30af\u30bf\u30fc\u30eb"},
+	0x3337:  {true, "\u30da\u30bd"},
+	0x3338:  {true, "\u30da\u30cb\u30d2"},
+	0x3339:  {true, "\u30d8\u30eb\u30c4"},
+	0x333A:  {true, "\u30da\u30f3\u30b9"},
+	0x333B:  {true, "\u30da\u30fc\u30b8"},
+	0x333C:  {true, "\u30d9\u30fc\u30bf"},
+	0x333D:  {true, "\u30dd\u30a4\u30f3\u30c8"},
+	0x333E:  {true, "\u30dc\u30eb\u30c8"},
+	0x333F:  {true, "\u30db\u30f3"},
+	0x3340:  {true, "\u30dd\u30f3\u30c9"},
+	0x3341:  {true, "\u30db\u30fc\u30eb"},
+	0x3342:  {true, "\u30db\u30fc\u30f3"},
+	0x3343:  {true, "\u30de\u30a4\u30af\u30ed"},
+	0x3344:  {true, "\u30de\u30a4\u30eb"},
+	0x3345:  {true, "\u30de\u30c3\u30cf"},
+	0x3346:  {true, "\u30de\u30eb\u30af"},
+	0x3347:  {true, "\u30de\u30f3\u30b7\u30e7\u30f3"},
+	0x3348:  {true, "\u30df\u30af\u30ed\u30f3"},
+	0x3349:  {true, "\u30df\u30ea"},
+	0x334A:  {true, "\u30df\u30ea\u30d0\u30fc\u30eb"},
+	0x334B:  {true, "\u30e1\u30ac"},
+	0x334C:  {true, "\u30e1\u30ac\u30c8\u30f3"},
+	0x334D:  {true, "\u30e1\u30fc\u30c8\u30eb"},
+	0x334E:  {true, "\u30e4\u30fc\u30c9"},
+	0x334F:  {true, "\u30e4\u30fc\u30eb"},
+	0x3350:  {true, "\u30e6\u30a2\u30f3"},
+	0x3351:  {true, "\u30ea\u30c3\u30c8\u30eb"},
+	0x3352:  {true, "\u30ea\u30e9"},
+	0x3353:  {true, "\u30eb\u30d4\u30fc"},
+	0x3354:  {true, "\u30eb\u30fc\u30d6\u30eb"},
+	0x3355:  {true, "\u30ec\u30e0"},
+	0x3356:  {true, "\u30ec\u30f3\u30c8\u30b2\u30f3"},
+	0x3357:  {true, "\u30ef\u30c3\u30c8"},
+	0x3358:  {true, "0\u70b9"},
+	0x3359:  {true, "1\u70b9"},
+	0x335A:  {true, "2\u70b9"},
+	0x335B:  {true, "3\u70b9"},
+	0x335C:  {true, "4\u70b9"},
+	0x335D:  {true, "5\u70b9"},
+	0x335E:  {true, "6\u70b9"},
+	0x335F:  {true, "7\u70b9"},
+	0x3360:  {true, "8\u70b9"},
+	0x3361:  {true, "9\u70b9"},
+	0x3362:  {true, "10\u70b9"},
+	0x3363:  {true, "11\u70b9"},
+	0x3364:  {true, "12\u70b9"},
+	0x3365:  {true, "13\u70b9"},
+	0x3366:  {true, "14\u70b9"},
+	0x3367:  {true, "15\u70b9"},
+	0x3368:  {true, "16\u70b9"},
+	0x3369:  {true, "17\u70b9"},
+	0x336A:  {true, "18\u70b9"},
+	0x336B:  {true, "19\u70b9"},
+	0x336C:  {true, "20\u70b9"},
+	0x336D:  {true, "21\u70b9"},
+	0x336E:  {true, "22\u70b9"},
+	0x336F:  {true, "23\u70b9"},
+	0x3370:  {true, "24\u70b9"},
+	0x3371:  {true, "hPa"},
+	0x3372:  {true, "da"},
+	0x3373:  {true, "AU"},
+	0x3374:  {true, "bar"},
+	0x3375:  {true, "oV"},
+	0x3376:  {true, "pc"},
+	0x3377:  {true, "dm"},
+	0x3378:  {true, "dm\u00b2"},
+	0x3379:  {true, "dm\u00b3"},
+	0x337A:  {true, "IU"},
+	0x337B:  {true, "\u5e73\u6210"},
+	0x337C:  {true, "\u662d\u548c"},
+	0x337D:  {true, "\u5927\u6b63"},
+	0x337E:  {true, "\u660e\u6cbb"},
+	0x337F:  {true, "\u682a\u5f0f\u4f1a\u793e"},
+	0x3380:  {true, "pA"},
+	0x3381:  {true, "nA"},
+	0x3382:  {true, "\u03bcA"},
+	0x3383:  {true, "mA"},
+	0x3384:  {true, "kA"},
+	0x3385:  {true, "KB"},
+	0x3386:  {true, "MB"},
+	0x3387:  {true, "GB"},
+	0x3388:  {true, "cal"},
+	0x3389:  {true, "kcal"},
+	0x338A:  {true, "pF"},
+	0x338B:  {true, "nF"},
+	0x338C:  {true, "\u03bcF"},
+	0x338D:  {true, "\u03bcg"},
+	0x338E:  {true, "mg"},
+	0x338F:  {true, "kg"},
+	0x3390:  {true, "Hz"},
+	0x3391:  {true, "kHz"},
+	0x3392:  {true, "MHz"},
+	0x3393:  {true, "GHz"},
+	0x3394:  {true, "THz"},
+	0x3395:  {true, "\u03bc\u2113"},
+	0x3396:  {true, "m\u2113"},
+	0x3397:  {true, "d\u2113"},
+	0x3398:  {true, "k\u2113"},
+	0x3399:  {true, "fm"},
+	0x339A:  {true, "nm"},
+	0x339B:  {true, "\u03bcm"},
+	0x339C:  {true, "mm"},
+	0x339D:  {true, "cm"},
+	0x339E:  {true, "km"},
+	0x339F:  {true, "mm\u00b2"},
+	0x33A0:  {true, "cm\u00b2"},
+	0x33A1:  {true, "m\u00b2"},
+	0x33A2:  {true, "km\u00b2"},
+	0x33A3:  {true, "mm\u00b3"},
+	0x33A4:  {true, "cm\u00b3"},
+	0x33A5:  {true, "m\u00b3"},
+	0x33A6:  {true, "km\u00b3"},
+	0x33A7:  {true, "m\u2215s"},
+	0x33A8:  {true, "m\u2215s\u00b2"},
+	0x33A9:  {true, "Pa"},
+	0x33AA:  {true, "kPa"},
+	0x33AB:  {true, "MPa"},
+	0x33AC:  {true, "GPa"},
+	0x33AD:  {true, "rad"},
+	0x33AE:  {true, "rad\u2215s"},
+	0x33AF:  {true, "rad\u2215s\u00b2"},
+	0x33B0:  {true, "ps"},
+	0x33B1:  {true, "ns"},
+	0x33B2:  {true, "\u03bcs"},
+	0x33B3:  {true, "ms"},
+	0x33B4:  {true, "pV"},
+	0x33B5:  {true, "nV"},
+	0x33B6:  {true, "\u03bcV"},
+	0x33B7:  {true, "mV"},
+	0x33B8:  {true, "kV"},
+	0x33B9:  {true, "MV"},
+	0x33BA:  {true, "pW"},
+	0x33BB:  {true, "nW"},
+	0x33BC:  {true, "\u03bcW"},
+	0x33BD:  {true, "mW"},
+	0x33BE:  {true, "kW"},
+	0x33BF:  {true, "MW"},
+	0x33C0:  {true, "k\u03a9"},
+	0x33C1:  {true, "M\u03a9"},
+	0x33C2:  {true, "a.m."},
+	0x33C3:  {true, "Bq"},
+	0x33C4:  {true, "cc"},
+	0x33C5:  {true, "cd"},
+	0x33C6:  {true, "C\u2215kg"},
+	0x33C7:  {true, "Co."},
+	0x33C8:  {true, "dB"},
+	0x33C9:  {true, "Gy"},
+	0x33CA:  {true, "ha"},
+	0x33CB:  {true, "HP"},
+	0x33CC:  {true, "in"},
+	0x33CD:  {true, "KK"},
+	0x33CE:  {true, "KM"},
+	0x33CF:  {true, "kt"},
+	0x33D0:  {true, "lm"},
+	0x33D1:  {true, "ln"},
+	0x33D2:  {true, "log"},
+	0x33D3:  {true, "lx"},
+	0x33D4:  {true, "mb"},
+	0x33D5:  {true, "mil"},
+	0x33D6:  {true, "mol"},
+	0x33D7:  {true, "PH"},
+	0x33D8:  {true, "p.m."},
+	0x33D9:  {true, "PPM"},
+	0x33DA:  {true, "PR"},
+	0x33DB:  {true, "sr"},
+	0x33DC:  {true, "Sv"},
+	0x33DD:  {true, "Wb"},
+	0x33DE:  {true, "V\u2215m"},
+	0x33DF:  {true, "A\u2215m"},
+	0x33E0:  {true, "1\u65e5"},
+	0x33E1:  {true, "2\u65e5"},
+	0x33E2:  {true, "3\u65e5"},
+	0x33E3:  {true, "4\u65e5"},
+	0x33E4:  {true, "5\u65e5"},
+	0x33E5:  {true, "6\u65e5"},
+	0x33E6:  {true, "7\u65e5"},
+	0x33E7:  {true, "8\u65e5"},
+	0x33E8:  {true, "9\u65e5"},
+	0x33E9:  {true, "10\u65e5"},
+	0x33EA:  {true, "11\u65e5"},
+	0x33EB:  {true, "12\u65e5"},
+	0x33EC:  {true, "13\u65e5"},
+	0x33ED:  {true, "14\u65e5"},
+	0x33EE:  {true, "15\u65e5"},
+	0x33EF:  {true, "16\u65e5"},
+	0x33F0:  {true, "17\u65e5"},
+	0x33F1:  {true, "18\u65e5"},
+	0x33F2:  {true, "19\u65e5"},
+	0x33F3:  {true, "20\u65e5"},
+	0x33F4:  {true, "21\u65e5"},
+	0x33F5:  {true, "22\u65e5"},
+	0x33F6:  {true, "23\u65e5"},
+	0x33F7:  {true, "24\u65e5"},
+	0x33F8:  {true, "25\u65e5"},
+	0x33F9:  {true, "26\u65e5"},
+	0x33FA:  {true, "27\u65e5"},
+	0x33FB:  {true, "28\u65e5"},
+	0x33FC:  {true, "29\u65e5"},
+	0x33FD:  {true, "30\u65e5"},
+	0x33FE:  {true, "31\u65e5"},
+	0x33FF:  {true, "gal"},
+	0xA69C:  {true, "\u044a"},
+	0xA69D:  {true, "\u044c"},
+	0xA770:  {true, "\ua76f"},
+	0xA7F2:  {true, "C"},
+	0xA7F3:  {true, "F"},
+	0xA7F4:  {true, "Q"},
+	0xA7F8:  {true, "\u0126"},
+	0xA7F9:  {true, "\u0153"},
+	0xAB5C:  {true, "\ua727"},
+	0xAB5D:  {true, "\uab37"},
+	0xAB5E:  {true, "\u026b"},
+	0xAB5F:  {true, "\uab52"},
+	0xAB69:  {true, "\u028d"},
+	0xF900:  {false, "\u8c48"},
+	0xF901:  {false, "\u66f4"},
+	0xF902:  {false, "\u8eca"},
+	0xF903:  {false, "\u8cc8"},
+	0xF904:  {false, "\u6ed1"},
+	0xF905:  {false, "\u4e32"},
+	0xF906:  {false, "\u53e5"},
+	0xF907:  {false, "\u9f9c"},
+	0xF908:  {false, "\u9f9c"},
+	0xF909:  {false, "\u5951"},
+	0xF90A:  {false, "\u91d1"},
+	0xF90B:  {false, "\u5587"},
+	0xF90C:  {false, "\u5948"},
+	0xF90D:  {false, "\u61f6"},
+	0xF90E:  {false, "\u7669"},
+	0xF90F:  {false, "\u7f85"},
+	0xF910:  {false, "\u863f"},
+	0xF911:  {false, "\u87ba"},
+	0xF912:  {false, "\u88f8"},
+	0xF913:  {false, "\u908f"},
+	0xF914:  {false, "\u6a02"},
+	0xF915:  {false, "\u6d1b"},
+	0xF916:  {false, "\u70d9"},
+	0xF917:  {false, "\u73de"},
+	0xF918:  {false, "\u843d"},
+	0xF919:  {false, "\u916a"},
+	0xF91A:  {false, "\u99f1"},
+	0xF91B:  {false, "\u4e82"},
+	0xF91C:  {false, "\u5375"},
+	0xF91D:  {false, "\u6b04"},
+	0xF91E:  {false, "\u721b"},
+	0xF91F:  {false, "\u862d"},
+	0xF920:  {false, "\u9e1e"},
+	0xF921:  {false, "\u5d50"},
+	0xF922:  {false, "\u6feb"},
+	0xF923:  {false, "\u85cd"},
+	0xF924:  {false, "\u8964"},
+	0xF925:  {false, "\u62c9"},
+	0xF926:  {false, "\u81d8"},
+	0xF927:  {false, "\u881f"},
+	0xF928:  {false, "\u5eca"},
+	0xF929:  {false, "\u6717"},
+	0xF92A:  {false, "\u6d6a"},
+	0xF92B:  {false, "\u72fc"},
+	0xF92C:  {false, "\u90ce"},
+	0xF92D:  {false, "\u4f86"},
+	0xF92E:  {false, "\u51b7"},
+	0xF92F:  {false, "\u52de"},
+	0xF930:  {false, "\u64c4"},
+	0xF931:  {false, "\u6ad3"},
+	0xF932:  {false, "\u7210"},
+	0xF933:  {false, "\u76e7"},
+	0xF934:  {false, "\u8001"},
+	0xF935:  {false, "\u8606"},
+	0xF936:  {false, "\u865c"},
+	0xF937:  {false, "\u8def"},
+	0xF938:  {false, "\u9732"},
+	0xF939:  {false, "\u9b6f"},
+	0xF93A:  {false, "\u9dfa"},
+	0xF93B:  {false, "\u788c"},
+	0xF93C:  {false, "\u797f"},
+	0xF93D:  {false, "\u7da0"},
+	0xF93E:  {false, "\u83c9"},
+	0xF93F:  {false, "\u9304"},
+	0xF940:  {false, "\u9e7f"},
+	0xF941:  {false, "\u8ad6"},
+	0xF942:  {false, "\u58df"},
+	0xF943:  {false, "\u5f04"},
+	0xF944:  {false, "\u7c60"},
+	0xF945:  {false, "\u807e"},
+	0xF946:  {false, "\u7262"},
+	0xF947:  {false, "\u78ca"},
+	0xF948:  {false, "\u8cc2"},
+	0xF949:  {false, "\u96f7"},
+	0xF94A:  {false, "\u58d8"},
+	0xF94B:  {false, "\u5c62"},
+	0xF94C:  {false, "\u6a13"},
+	0xF94D:  {false, "\u6dda"},
+	0xF94E:  {false, "\u6f0f"},
+	0xF94F:  {false, "\u7d2f"},
+	0xF950:  {false, "\u7e37"},
+	0xF951:  {false, "\u964b"},
+	0xF952:  {false, "\u52d2"},
+	0xF953:  {false, "\u808b"},
+	0xF954:  {false, "\u51dc"},
+	0xF955:  {false, "\u51cc"},
+	0xF956:  {false, "\u7a1c"},
+	0xF957:  {false, "\u7dbe"},
+	0xF958:  {false, "\u83f1"},
+	0xF959:  {false, "\u9675"},
+	0xF95A:  {false, "\u8b80"},
+	0xF95B:  {false, "\u62cf"},
+	0xF95C:  {false, "\u6a02"},
+	0xF95D:  {false, "\u8afe"},
+	0xF95E:  {false, "\u4e39"},
+	0xF95F:  {false, "\u5be7"},
+	0xF960:  {false, "\u6012"},
+	0xF961:  {false, "\u7387"},
+	0xF962:  {false, "\u7570"},
+	0xF963:  {false, "\u5317"},
+	0xF964:  {false, "\u78fb"},
+	0xF965:  {false, "\u4fbf"},
+	0xF966:  {false, "\u5fa9"},
+	0xF967:  {false, "\u4e0d"},
+	0xF968:  {false, "\u6ccc"},
+	0xF969:  {false, "\u6578"},
+	0xF96A:  {false, "\u7d22"},
+	0xF96B:  {false, "\u53c3"},
+	0xF96C:  {false, "\u585e"},
+	0xF96D:  {false, "\u7701"},
+	0xF96E:  {false, "\u8449"},
+	0xF96F:  {false, "\u8aaa"},
+	0xF970:  {false, "\u6bba"},
+	0xF971:  {false, "\u8fb0"},
+	0xF972:  {false, "\u6c88"},
+	0xF973:  {false, "\u62fe"},
+	0xF974:  {false, "\u82e5"},
+	0xF975:  {false, "\u63a0"},
+	0xF976:  {false, "\u7565"},
+	0xF977:  {false, "\u4eae"},
+	0xF978:  {false, "\u5169"},
+	0xF979:  {false, "\u51c9"},
+	0xF97A:  {false, "\u6881"},
+	0xF97B:  {false, "\u7ce7"},
+	0xF97C:  {false, "\u826f"},
+	0xF97D:  {false, "\u8ad2"},
+	0xF97E:  {false, "\u91cf"},
+	0xF97F:  {false, "\u52f5"},
+	0xF980:  {false, "\u5442"},
+	0xF981:  {false, "\u5973"},
+	0xF982:  {false, "\u5eec"},
+	0xF983:  {false, "\u65c5"},
+	0xF984:  {false, "\u6ffe"},
+	0xF985:  {false, "\u792a"},
+	0xF986:  {false, "\u95ad"},
+	0xF987:  {false, "\u9a6a"},
+	0xF988:  {false, "\u9e97"},
+	0xF989:  {false, "\u9ece"},
+	0xF98A:  {false, "\u529b"},
+	0xF98B:  {false, "\u66c6"},
+	0xF98C:  {false, "\u6b77"},
+	0xF98D:  {false, "\u8f62"},
+	0xF98E:  {false, "\u5e74"},
+	0xF98F:  {false, "\u6190"},
+	0xF990:  {false, "\u6200"},
+	0xF991:  {false, "\u649a"},
+	0xF992:  {false, "\u6f23"},
+	0xF993:  {false, "\u7149"},
+	0xF994:  {false, "\u7489"},
+	0xF995:  {false, "\u79ca"},
+	0xF996:  {false, "\u7df4"},
+	0xF997:  {false, "\u806f"},
+	0xF998:  {false, "\u8f26"},
+	0xF999:  {false, "\u84ee"},
+	0xF99A:  {false, "\u9023"},
+	0xF99B:  {false, "\u934a"},
+	0xF99C:  {false, "\u5217"},
+	0xF99D:  {false, "\u52a3"},
+	0xF99E:  {false, "\u54bd"},
+	0xF99F:  {false, "\u70c8"},
+	0xF9A0:  {false, "\u88c2"},
+	0xF9A1:  {false, "\u8aaa"},
+	0xF9A2:  {false, "\u5ec9"},
+	0xF9A3:  {false, "\u5ff5"},
+	0xF9A4:  {false, "\u637b"},
+	0xF9A5:  {false, "\u6bae"},
+	0xF9A6:  {false, "\u7c3e"},
+	0xF9A7:  {false, "\u7375"},
+	0xF9A8:  {false, "\u4ee4"},
+	0xF9A9:  {false, "\u56f9"},
+	0xF9AA:  {false, "\u5be7"},
+	0xF9AB:  {false, "\u5dba"},
+	0xF9AC:  {false, "\u601c"},
+	0xF9AD:  {false, "\u73b2"},
+	0xF9AE:  {false, "\u7469"},
+	0xF9AF:  {false, "\u7f9a"},
+	0xF9B0:  {false, "\u8046"},
+	0xF9B1:  {false, "\u9234"},
+	0xF9B2:  {false, "\u96f6"},
+	0xF9B3:  {false, "\u9748"},
+	0xF9B4:  {false, "\u9818"},
+	0xF9B5:  {false, "\u4f8b"},
+	0xF9B6:  {false, "\u79ae"},
+	0xF9B7:  {false, "\u91b4"},
+	0xF9B8:  {false, "\u96b8"},
+	0xF9B9:  {false, "\u60e1"},
+	0xF9BA:  {false, "\u4e86"},
+	0xF9BB:  {false, "\u50da"},
+	0xF9BC:  {false, "\u5bee"},
+	0xF9BD:  {false, "\u5c3f"},
+	0xF9BE:  {false, "\u6599"},
+	0xF9BF:  {false, "\u6a02"},
+	0xF9C0:  {false, "\u71ce"},
+	0xF9C1:  {false, "\u7642"},
+	0xF9C2:  {false, "\u84fc"},
+	0xF9C3:  {false, "\u907c"},
+	0xF9C4:  {false, "\u9f8d"},
+	0xF9C5:  {false, "\u6688"},
+	0xF9C6:  {false, "\u962e"},
+	0xF9C7:  {false, "\u5289"},
+	0xF9C8:  {false, "\u677b"},
+	0xF9C9:  {false, "\u67f3"},
+	0xF9CA:  {false, "\u6d41"},
+	0xF9CB:  {false, "\u6e9c"},
+	0xF9CC:  {false, "\u7409"},
+	0xF9CD:  {false, "\u7559"},
+	0xF9CE:  {false, "\u786b"},
+	0xF9CF:  {false, "\u7d10"},
+	0xF9D0:  {false, "\u985e"},
+	0xF9D1:  {false, "\u516d"},
+	0xF9D2:  {false, "\u622e"},
+	0xF9D3:  {false, "\u9678"},
+	0xF9D4:  {false, "\u502b"},
+	0xF9D5:  {false, "\u5d19"},
+	0xF9D6:  {false, "\u6dea"},
+	0xF9D7:  {false, "\u8f2a"},
+	0xF9D8:  {false, "\u5f8b"},
+	0xF9D9:  {false, "\u6144"},
+	0xF9DA:  {false, "\u6817"},
+	0xF9DB:  {false, "\u7387"},
+	0xF9DC:  {false, "\u9686"},
+	0xF9DD:  {false, "\u5229"},
+	0xF9DE:  {false, "\u540f"},
+	0xF9DF:  {false, "\u5c65"},
+	0xF9E0:  {false, "\u6613"},
+	0xF9E1:  {false, "\u674e"},
+	0xF9E2:  {false, "\u68a8"},
+	0xF9E3:  {false, "\u6ce5"},
+	0xF9E4:  {false, "\u7406"},
+	0xF9E5:  {false, "\u75e2"},
+	0xF9E6:  {false, "\u7f79"},
+	0xF9E7:  {false, "\u88cf"},
+	0xF9E8:  {false, "\u88e1"},
+	0xF9E9:  {false, "\u91cc"},
+	0xF9EA:  {false, "\u96e2"},
+	0xF9EB:  {false, "\u533f"},
+	0xF9EC:  {false, "\u6eba"},
+	0xF9ED:  {false, "\u541d"},
+	0xF9EE:  {false, "\u71d0"},
+	0xF9EF:  {false, "\u7498"},
+	0xF9F0:  {false, "\u85fa"},
+	0xF9F1:  {false, "\u96a3"},
+	0xF9F2:  {false, "\u9c57"},
+	0xF9F3:  {false, "\u9e9f"},
+	0xF9F4:  {false, "\u6797"},
+	0xF9F5:  {false, "\u6dcb"},
+	0xF9F6:  {false, "\u81e8"},
+	0xF9F7:  {false, "\u7acb"},
+	0xF9F8:  {false, "\u7b20"},
+	0xF9F9:  {false, "\u7c92"},
+	0xF9FA:  {false, "\u72c0"},
+	0xF9FB:  {false, "\u7099"},
+	0xF9FC:  {false, "\u8b58"},
+	0xF9FD:  {false, "\u4ec0"},
+	0xF9FE:  {false, "\u8336"},
+	0xF9FF:  {false, "\u523a"},
+	0xFA00:  {false, "\u5207"},
+	0xFA01:  {false, "\u5ea6"},
+	0xFA02:  {false, "\u62d3"},
+	0xFA03:  {false, "\u7cd6"},
+	0xFA04:  {false, "\u5b85"},
+	0xFA05:  {false, "\u6d1e"},
+	0xFA06:  {false, "\u66b4"},
+	0xFA07:  {false, "\u8f3b"},
+	0xFA08:  {false, "\u884c"},
+	0xFA09:  {false, "\u964d"},
+	0xFA0A:  {false, "\u898b"},
+	0xFA0B:  {false, "\u5ed3"},
+	0xFA0C:  {false, "\u5140"},
+	0xFA0D:  {false, "\u55c0"},
+	0xFA10:  {false, "\u585a"},
+	0xFA12:  {false, "\u6674"},
+	0xFA15:  {false, "\u51de"},
+	0xFA16:  {false, "\u732a"},
+	0xFA17:  {false, "\u76ca"},
+	0xFA18:  {false, "\u793c"},
+	0xFA19:  {false, "\u795e"},
+	0xFA1A:  {false, "\u7965"},
+	0xFA1B:  {false, "\u798f"},
+	0xFA1C:  {false, "\u9756"},
+	0xFA1D:  {false, "\u7cbe"},
+	0xFA1E:  {false, "\u7fbd"},
+	0xFA20:  {false, "\u8612"},
+	0xFA22:  {false, "\u8af8"},
+	0xFA25:  {false, "\u9038"},
+	0xFA26:  {false, "\u90fd"},
+	0xFA2A:  {false, "\u98ef"},
+	0xFA2B:  {false, "\u98fc"},
+	0xFA2C:  {false, "\u9928"},
+	0xFA2D:  {false, "\u9db4"},
+	0xFA2E:  {false, "\u90de"},
+	0xFA2F:  {false, "\u96b7"},
+	0xFA30:  {false, "\u4fae"},
+	0xFA31:  {false, "\u50e7"},
+	0xFA32:  {false, "\u514d"},
+	0xFA33:  {false, "\u52c9"},
+	0xFA34:  {false, "\u52e4"},
+	0xFA35:  {false, "\u5351"},
+	0xFA36:  {false, "\u559d"},
+	0xFA37:  {false, "\u5606"},
+	0xFA38:  {false, "\u5668"},
+	0xFA39:  {false, "\u5840"},
+	0xFA3A:  {false, "\u58a8"},
+	0xFA3B:  {false, "\u5c64"},
+	0xFA3C:  {false, "\u5c6e"},
+	0xFA3D:  {false, "\u6094"},
+	0xFA3E:  {false, "\u6168"},
+	0xFA3F:  {false, "\u618e"},
+	0xFA40:  {false, "\u61f2"},
+	0xFA41:  {false, "\u654f"},
+	0xFA42:  {false, "\u65e2"},
+	0xFA43:  {false, "\u6691"},
+	0xFA44:  {false, "\u6885"},
+	0xFA45:  {false, "\u6d77"},
+	0xFA46:  {false, "\u6e1a"},
+	0xFA47:  {false, "\u6f22"},
+	0xFA48:  {false, "\u716e"},
+	0xFA49:  {false, "\u722b"},
+	0xFA4A:  {false, "\u7422"},
+	0xFA4B:  {false, "\u7891"},
+	0xFA4C:  {false, "\u793e"},
+	0xFA4D:  {false, "\u7949"},
+	0xFA4E:  {false, "\u7948"},
+	0xFA4F:  {false, "\u7950"},
+	0xFA50:  {false, "\u7956"},
+	0xFA51:  {false, "\u795d"},
+	0xFA52:  {false, "\u798d"},
+	0xFA53:  {false, "\u798e"},
+	0xFA54:  {false, "\u7a40"},
+	0xFA55:  {false, "\u7a81"},
+	0xFA56:  {false, "\u7bc0"},
+	0xFA57:  {false, "\u7df4"},
+	0xFA58:  {false, "\u7e09"},
+	0xFA59:  {false, "\u7e41"},
+	0xFA5A:  {false, "\u7f72"},
+	0xFA5B:  {false, "\u8005"},
+	0xFA5C:  {false, "\u81ed"},
+	0xFA5D:  {false, "\u8279"},
+	0xFA5E:  {false, "\u8279"},
+	0xFA5F:  {false, "\u8457"},
+	0xFA60:  {false, "\u8910"},
+	0xFA61:  {false, "\u8996"},
+	0xFA62:  {false, "\u8b01"},
+	0xFA63:  {false, "\u8b39"},
+	0xFA64:  {false, "\u8cd3"},
+	0xFA65:  {false, "\u8d08"},
+	0xFA66:  {false, "\u8fb6"},
+	0xFA67:  {false, "\u9038"},
+	0xFA68:  {false, "\u96e3"},
+	0xFA69:  {false, "\u97ff"},
+	0xFA6A:  {false, "\u983b"},
+	0xFA6B:  {false, "\u6075"},
+	0xFA6C:  {false, "\U000242ee"},
+	0xFA6D:  {false, "\u8218"},
+	0xFA70:  {false, "\u4e26"},
+	0xFA71:  {false, "\u51b5"},
+	0xFA72:  {false, "\u5168"},
+	0xFA73:  {false, "\u4f80"},
+	0xFA74:  {false, "\u5145"},
+	0xFA75:  {false, "\u5180"},
+	0xFA76:  {false, "\u52c7"},
+	0xFA77:  {false, "\u52fa"},
+	0xFA78:  {false, "\u559d"},
+	0xFA79:  {false, "\u5555"},
+	0xFA7A:  {false, "\u5599"},
+	0xFA7B:  {false, "\u55e2"},
+	0xFA7C:  {false, "\u585a"},
+	0xFA7D:  {false, "\u58b3"},
+	0xFA7E:  {false, "\u5944"},
+	0xFA7F:  {false, "\u5954"},
+	0xFA80:  {false, "\u5a62"},
+	0xFA81:  {false, "\u5b28"},
+	0xFA82:  {false, "\u5ed2"},
+	0xFA83:  {false, "\u5ed9"},
+	0xFA84:  {false, "\u5f69"},
+	0xFA85:  {false, "\u5fad"},
+	0xFA86:  {false, "\u60d8"},
+	0xFA87:  {false, "\u614e"},
+	0xFA88:  {false, "\u6108"},
+	0xFA89:  {false, "\u618e"},
+	0xFA8A:  {false, "\u6160"},
+	0xFA8B:  {false, "\u61f2"},
+	0xFA8C:  {false, "\u6234"},
+	0xFA8D:  {false, "\u63c4"},
+	0xFA8E:  {false, "\u641c"},
+	0xFA8F:  {false, "\u6452"},
+	0xFA90:  {false, "\u6556"},
+	0xFA91:  {false, "\u6674"},
+	0xFA92:  {false, "\u6717"},
+	0xFA93:  {false, "\u671b"},
+	0xFA94:  {false, "\u6756"},
+	0xFA95:  {false, "\u6b79"},
+	0xFA96:  {false, "\u6bba"},
+	0xFA97:  {false, "\u6d41"},
+	0xFA98:  {false, "\u6edb"},
+	0xFA99:  {false, "\u6ecb"},
+	0xFA9A:  {false, "\u6f22"},
+	0xFA9B:  {false, "\u701e"},
+	0xFA9C:  {false, "\u716e"},
+	0xFA9D:  {false, "\u77a7"},
+	0xFA9E:  {false, "\u7235"},
+	0xFA9F:  {false, "\u72af"},
+	0xFAA0:  {false, "\u732a"},
+	0xFAA1:  {false, "\u7471"},
+	0xFAA2:  {false, "\u7506"},
+	0xFAA3:  {false, "\u753b"},
+	0xFAA4:  {false, "\u761d"},
+	0xFAA5:  {false, "\u761f"},
+	0xFAA6:  {false, "\u76ca"},
+	0xFAA7:  {false, "\u76db"},
+	0xFAA8:  {false, "\u76f4"},
+	0xFAA9:  {false, "\u774a"},
+	0xFAAA:  {false, "\u7740"},
+	0xFAAB:  {false, "\u78cc"},
+	0xFAAC:  {false, "\u7ab1"},
+	0xFAAD:  {false, "\u7bc0"},
+	0xFAAE:  {false, "\u7c7b"},
+	0xFAAF:  {false, "\u7d5b"},
+	0xFAB0:  {false, "\u7df4"},
+	0xFAB1:  {false, "\u7f3e"},
+	0xFAB2:  {false, "\u8005"},
+	0xFAB3:  {false, "\u8352"},
+	0xFAB4:  {false, "\u83ef"},
+	0xFAB5:  {false, "\u8779"},
+	0xFAB6:  {false, "\u8941"},
+	0xFAB7:  {false, "\u8986"},
+	0xFAB8:  {false, "\u8996"},
+	0xFAB9:  {false, "\u8abf"},
+	0xFABA:  {false, "\u8af8"},
+	0xFABB:  {false, "\u8acb"},
+	0xFABC:  {false, "\u8b01"},
+	0xFABD:  {false, "\u8afe"},
+	0xFABE:  {false, "\u8aed"},
+	0xFABF:  {false, "\u8b39"},
+	0xFAC0:  {false, "\u8b8a"},
+	0xFAC1:  {false, "\u8d08"},
+	0xFAC2:  {false, "\u8f38"},
+	0xFAC3:  {false, "\u9072"},
+	0xFAC4:  {false, "\u9199"},
+	0xFAC5:  {false, "\u9276"},
+	0xFAC6:  {false, "\u967c"},
+	0xFAC7:  {false, "\u96e3"},
+	0xFAC8:  {false, "\u9756"},
+	0xFAC9:  {false, "\u97db"},
+	0xFACA:  {false, "\u97ff"},
+	0xFACB:  {false, "\u980b"},
+	0xFACC:  {false, "\u983b"},
+	0xFACD:  {false, "\u9b12"},
+	0xFACE:  {false, "\u9f9c"},
+	0xFACF:  {false, "\U0002284a"},
+	0xFAD0:  {false, "\U00022844"},
+	0xFAD1:  {false, "\U000233d5"},
+	0xFAD2:  {false, "\u3b9d"},
+	0xFAD3:  {false, "\u4018"},
+	0xFAD4:  {false, "\u4039"},
+	0xFAD5:  {false, "\U00025249"},
+	0xFAD6:  {false, "\U00025cd0"},
+	0xFAD7:  {false, "\U00027ed3"},
+	0xFAD8:  {false, "\u9f43"},
+	0xFAD9:  {false, "\u9f8e"},
+	0xFB00:  {true, "ff"},
+	0xFB01:  {true, "fi"},
+	0xFB02:  {true, "fl"},
+	0xFB03:  {true, "ffi"},
+	0xFB04:  {true, "ffl"},
+	0xFB05:  {true, "\u017ft"},
+	0xFB06:  {true, "st"},
+	0xFB13:  {true, "\u0574\u0576"},
+	0xFB14:  {true, "\u0574\u0565"},
+	0xFB15:  {true, "\u0574\u056b"},
+	0xFB16:  {true, "\u057e\u0576"},
+	0xFB17:  {true, "\u0574\u056d"},
+	0xFB1D:  {false, "\u05d9\u05b4"},
+	0xFB1F:  {false, "\u05f2\u05b7"},
+	0xFB20:  {true, "\u05e2"},
+	0xFB21:  {true, "\u05d0"},
+	0xFB22:  {true, "\u05d3"},
+	0xFB23:  {true, "\u05d4"},
+	0xFB24:  {true, "\u05db"},
+	0xFB25:  {true, "\u05dc"},
+	0xFB26:  {true, "\u05dd"},
+	0xFB27:  {true, "\u05e8"},
+	0xFB28:  {true, "\u05ea"},
+	0xFB29:  {true, "+"},
+	0xFB2A:  {false, "\u05e9\u05c1"},
+	0xFB2B:  {false, "\u05e9\u05c2"},
+	0xFB2C:  {false, "\ufb49\u05c1"},
+	0xFB2D:  {false, "\ufb49\u05c2"},
+	0xFB2E:  {false, "\u05d0\u05b7"},
+	0xFB2F:  {false, "\u05d0\u05b8"},
+	0xFB30:  {false, "\u05d0\u05bc"},
+	0xFB31:  {false, "\u05d1\u05bc"},
+	0xFB32:  {false, "\u05d2\u05bc"},
+	0xFB33:  {false, "\u05d3\u05bc"},
+	0xFB34:  {false, "\u05d4\u05bc"},
+	0xFB35:  {false, "\u05d5\u05bc"},
+	0xFB36:  {false, "\u05d6\u05bc"},
+	0xFB38:  {false, "\u05d8\u05bc"},
+	0xFB39:  {false, "\u05d9\u05bc"},
+	0xFB3A:  {false, "\u05da\u05bc"},
+	0xFB3B:  {false, "\u05db\u05bc"},
+	0xFB3C:  {false, "\u05dc\u05bc"},
+	0xFB3E:  {false, "\u05de\u05bc"},
+	0xFB40:  {false, "\u05e0\u05bc"},
+	0xFB41:  {false, "\u05e1\u05bc"},
+	0xFB43:  {false, "\u05e3\u05bc"},
+	0xFB44:  {false, "\u05e4\u05bc"},
+	0xFB46:  {false, "\u05e6\u05bc"},
+	0xFB47:  {false, "\u05e7\u05bc"},
+	0xFB48:  {false, "\u05e8\u05bc"},
+	0xFB49:  {false, "\u05e9\u05bc"},
+	0xFB4A:  {false, "\u05ea\u05bc"},
+	0xFB4B:  {false, "\u05d5\u05b9"},
+	0xFB4C:  {false, "\u05d1\u05bf"},
+	0xFB4D:  {false, "\u05db\u05bf"},
+	0xFB4E:  {false, "\u05e4\u05bf"},
+	0xFB4F:  {true, "\u05d0\u05dc"},
+	0xFB50:  {true, "\u0671"},
+	0xFB51:  {true, "\u0671"},
+	0xFB52:  {true, "\u067b"},
+	0xFB53:  {true, "\u067b"},
+	0xFB54:  {true, "\u067b"},
+	0xFB55:  {true, "\u067b"},
+	0xFB56:  {true, "\u067e"},
+	0xFB57:  {true, "\u067e"},
+	0xFB58:  {true, "\u067e"},
+	0xFB59:  {true, "\u067e"},
+	0xFB5A:  {true, "\u0680"},
+	0xFB5B:  {true, "\u0680"},
+	0xFB5C:  {true, "\u0680"},
+	0xFB5D:  {true, "\u0680"},
+	0xFB5E:  {true, "\u067a"},
+	0xFB5F:  {true, "\u067a"},
+	0xFB60:  {true, "\u067a"},
+	0xFB61:  {true, "\u067a"},
+	0xFB62:  {true, "\u067f"},
+	0xFB63:  {true, "\u067f"},
+	0xFB64:  {true, "\u067f"},
+	0xFB65:  {true, "\u067f"},
+	0xFB66:  {true, "\u0679"},
+	0xFB67:  {true, "\u0679"},
+	0xFB68:  {true, "\u0679"},
+	0xFB69:  {true, "\u0679"},
+	0xFB6A:  {true, "\u06a4"},
+	0xFB6B:  {true, "\u06a4"},
+	0xFB6C:  {true, "\u06a4"},
+	0xFB6D:  {true, "\u06a4"},
+	0xFB6E:  {true, "\u06a6"},
+	0xFB6F:  {true, "\u06a6"},
+	0xFB70:  {true, "\u06a6"},
+	0xFB71:  {true, "\u06a6"},
+	0xFB72:  {true, "\u0684"},
+	0xFB73:  {true, "\u0684"},
+	0xFB74:  {true, "\u0684"},
+	0xFB75:  {true, "\u0684"},
+	0xFB76:  {true, "\u0683"},
+	0xFB77:  {true, "\u0683"},
+	0xFB78:  {true, "\u0683"},
+	0xFB79:  {true, "\u0683"},
+	0xFB7A:  {true, "\u0686"},
+	0xFB7B:  {true, "\u0686"},
+	0xFB7C:  {true, "\u0686"},
+	0xFB7D:  {true, "\u0686"},
+	0xFB7E:  {true, "\u0687"},
+	0xFB7F:  {true, "\u0687"},
+	0xFB80:  {true, "\u0687"},
+	0xFB81:  {true, "\u0687"},
+	0xFB82:  {true, "\u068d"},
+	0xFB83:  {true, "\u068d"},
+	0xFB84:  {true, "\u068c"},
+	0xFB85:  {true, "\u068c"},
+	0xFB86:  {true, "\u068e"},
+	0xFB87:  {true, "\u068e"},
+	0xFB88:  {true, "\u0688"},
+	0xFB89:  {true, "\u0688"},
+	0xFB8A:  {true, "\u0698"},
+	0xFB8B:  {true, "\u0698"},
+	0xFB8C:  {true, "\u0691"},
+	0xFB8D:  {true, "\u0691"},
+	0xFB8E:  {true, "\u06a9"},
+	0xFB8F:  {true, "\u06a9"},
+	0xFB90:  {true, "\u06a9"},
+	0xFB91:  {true, "\u06a9"},
+	0xFB92:  {true, "\u06af"},
+	0xFB93:  {true, "\u06af"},
+	0xFB94:  {true, "\u06af"},
+	0xFB95:  {true, "\u06af"},
+	0xFB96:  {true, "\u06b3"},
+	0xFB97:  {true, "\u06b3"},
+	0xFB98:  {true, "\u06b3"},
+	0xFB99:  {true, "\u06b3"},
+	0xFB9A:  {true, "\u06b1"},
+	0xFB9B:  {true, "\u06b1"},
+	0xFB9C:  {true, "\u06b1"},
+	0xFB9D:  {true, "\u06b1"},
+	0xFB9E:  {true, "\u06ba"},
+	0xFB9F:  {true, "\u06ba"},
+	0xFBA0:  {true, "\u06bb"},
+	0xFBA1:  {true, "\u06bb"},
+	0xFBA2:  {true, "\u06bb"},
+	0xFBA3:  {true, "\u06bb"},
+	0xFBA4:  {true, "\u06c0"},
+	0xFBA5:  {true, "\u06c0"},
+	0xFBA6:  {true, "\u06c1"},
+	0xFBA7:  {true, "\u06c1"},
+	0xFBA8:  {true, "\u06c1"},
+	0xFBA9:  {true, "\u06c1"},
+	0xFBAA:  {true, "\u06be"},
+	0xFBAB:  {true, "\u06be"},
+	0xFBAC:  {true, "\u06be"},
+	0xFBAD:  {true, "\u06be"},
+	0xFBAE:  {true, "\u06d2"},
+	0xFBAF:  {true, "\u06d2"},
+	0xFBB0:  {true, "\u06d3"},
+	0xFBB1:  {true, "\u06d3"},
+	0xFBD3:  {true, "\u06ad"},
+	0xFBD4:  {true, "\u06ad"},
+	0xFBD5:  {true, "\u06ad"},
+	0xFBD6:  {true, "\u06ad"},
+	0xFBD7:  {true, "\u06c7"},
+	0xFBD8:  {true, "\u06c7"},
+	0xFBD9:  {true, "\u06c6"},
+	0xFBDA:  {true, "\u06c6"},
+	0xFBDB:  {true, "\u06c8"},
+	0xFBDC:  {true, "\u06c8"},
+	0xFBDD:  {true, "\u0677"},
+	0xFBDE:  {true, "\u06cb"},
+	0xFBDF:  {true, "\u06cb"},
+	0xFBE0:  {true, "\u06c5"},
+	0xFBE1:  {true, "\u06c5"},
+	0xFBE2:  {true, "\u06c9"},
+	0xFBE3:  {true, "\u06c9"},
+	0xFBE4:  {true, "\u06d0"},
+	0xFBE5:  {true, "\u06d0"},
+	0xFBE6:  {true, "\u06d0"},
+	0xFBE7:  {true, "\u06d0"},
+	0xFBE8:  {true, "\u0649"},
+	0xFBE9:  {true, "\u0649"},
+	0xFBEA:  {true, "\u0626\u0627"},
+	0xFBEB:  {true, "\u0626\u0627"},
+	0xFBEC:  {true, "\u0626\u06d5"},
+	0xFBED:  {true, "\u0626\u06d5"},
+	0xFBEE:  {true, "\u0626\u0648"},
+	0xFBEF:  {true, "\u0626\u0648"},
+	0xFBF0:  {true, "\u0626\u06c7"},
+	0xFBF1:  {true, "\u0626\u06c7"},
+	0xFBF2:  {true, "\u0626\u06c6"},
+	0xFBF3:  {true, "\u0626\u06c6"},
+	0xFBF4:  {true, "\u0626\u06c8"},
+	0xFBF5:  {true, "\u0626\u06c8"},
+	0xFBF6:  {true, "\u0626\u06d0"},
+	0xFBF7:  {true, "\u0626\u06d0"},
+	0xFBF8:  {true, "\u0626\u06d0"},
+	0xFBF9:  {true, "\u0626\u0649"},
+	0xFBFA:  {true, "\u0626\u0649"},
+	0xFBFB:  {true, "\u0626\u0649"},
+	0xFBFC:  {true, "\u06cc"},
+	0xFBFD:  {true, "\u06cc"},
+	0xFBFE:  {true, "\u06cc"},
+	0xFBFF:  {true, "\u06cc"},
+	0xFC00:  {true, "\u0626\u062c"},
+	0xFC01:  {true, "\u0626\u062d"},
+	0xFC02:  {true, "\u0626\u0645"},
+	0xFC03:  {true, "\u0626\u0649"},
+	0xFC04:  {true, "\u0626\u064a"},
+	0xFC05:  {true, "\u0628\u062c"},
+	0xFC06:  {true, "\u0628\u062d"},
+	0xFC07:  {true, "\u0628\u062e"},
+	0xFC08:  {true, "\u0628\u0645"},
+	0xFC09:  {true, "\u0628\u0649"},
+	0xFC0A:  {true, "\u0628\u064a"},
+	0xFC0B:  {true, "\u062a\u062c"},
+	0xFC0C:  {true, "\u062a\u062d"},
+	0xFC0D:  {true, "\u062a\u062e"},
+	0xFC0E:  {true, "\u062a\u0645"},
+	0xFC0F:  {true, "\u062a\u0649"},
+	0xFC10:  {true, "\u062a\u064a"},
+	0xFC11:  {true, "\u062b\u062c"},
+	0xFC12:  {true, "\u062b\u0645"},
+	0xFC13:  {true, "\u062b\u0649"},
+	0xFC14:  {true, "\u062b\u064a"},
+	0xFC15:  {true, "\u062c\u062d"},
+	0xFC16:  {true, "\u062c\u0645"},
+	0xFC17:  {true, "\u062d\u062c"},
+	0xFC18:  {true, "\u062d\u0645"},
+	0xFC19:  {true, "\u062e\u062c"},
+	0xFC1A:  {true, "\u062e\u062d"},
+	0xFC1B:  {true, "\u062e\u0645"},
+	0xFC1C:  {true, "\u0633\u062c"},
+	0xFC1D:  {true, "\u0633\u062d"},
+	0xFC1E:  {true, "\u0633\u062e"},
+	0xFC1F:  {true, "\u0633\u0645"},
+	0xFC20:  {true, "\u0635\u062d"},
+	0xFC21:  {true, "\u0635\u0645"},
+	0xFC22:  {true, "\u0636\u062c"},
+	0xFC23:  {true, "\u0636\u062d"},
+	0xFC24:  {true, "\u0636\u062e"},
+	0xFC25:  {true, "\u0636\u0645"},
+	0xFC26:  {true, "\u0637\u062d"},
+	0xFC27:  {true, "\u0637\u0645"},
+	0xFC28:  {true, "\u0638\u0645"},
+	0xFC29:  {true, "\u0639\u062c"},
+	0xFC2A:  {true, "\u0639\u0645"},
+	0xFC2B:  {true, "\u063a\u062c"},
+	0xFC2C:  {true, "\u063a\u0645"},
+	0xFC2D:  {true, "\u0641\u062c"},
+	0xFC2E:  {true, "\u0641\u062d"},
+	0xFC2F:  {true, "\u0641\u062e"},
+	0xFC30:  {true, "\u0641\u0645"},
+	0xFC31:  {true, "\u0641\u0649"},
+	0xFC32:  {true, "\u0641\u064a"},
+	0xFC33:  {true, "\u0642\u062d"},
+	0xFC34:  {true, "\u0642\u0645"},
+	0xFC35:  {true, "\u0642\u0649"},
+	0xFC36:  {true, "\u0642\u064a"},
+	0xFC37:  {true, "\u0643\u0627"},
+	0xFC38:  {true, "\u0643\u062c"},
+	0xFC39:  {true, "\u0643\u062d"},
+	0xFC3A:  {true, "\u0643\u062e"},
+	0xFC3B:  {true, "\u0643\u0644"},
+	0xFC3C:  {true, "\u0643\u0645"},
+	0xFC3D:  {true, "\u0643\u0649"},
+	0xFC3E:  {true, "\u0643\u064a"},
+	0xFC3F:  {true, "\u0644\u062c"},
+	0xFC40:  {true, "\u0644\u062d"},
+	0xFC41:  {true, "\u0644\u062e"},
+	0xFC42:  {true, "\u0644\u0645"},
+	0xFC43:  {true, "\u0644\u0649"},
+	0xFC44:  {true, "\u0644\u064a"},
+	0xFC45:  {true, "\u0645\u062c"},
+	0xFC46:  {true, "\u0645\u062d"},
+	0xFC47:  {true, "\u0645\u062e"},
+	0xFC48:  {true, "\u0645\u0645"},
+	0xFC49:  {true, "\u0645\u0649"},
+	0xFC4A:  {true, "\u0645\u064a"},
+	0xFC4B:  {true, "\u0646\u062c"},
+	0xFC4C:  {true, "\u0646\u062d"},
+	0xFC4D:  {true, "\u0646\u062e"},
+	0xFC4E:  {true, "\u0646\u0645"},
+	0xFC4F:  {true, "\u0646\u0649"},
+	0xFC50:  {true, "\u0646\u064a"},
+	0xFC51:  {true, "\u0647\u062c"},
+	0xFC52:  {true, "\u0647\u0645"},
+	0xFC53:  {true, "\u0647\u0649"},
+	0xFC54:  {true, "\u0647\u064a"},
+	0xFC55:  {true, "\u064a\u062c"},
+	0xFC56:  {true, "\u064a\u062d"},
+	0xFC57:  {true, "\u064a\u062e"},
+	0xFC58:  {true, "\u064a\u0645"},
+	0xFC59:  {true, "\u064a\u0649"},
+	0xFC5A:  {true, "\u064a\u064a"},
+	0xFC5B:  {true, "\u0630\u0670"},
+	0xFC5C:  {true, "\u0631\u0670"},
+	0xFC5D:  {true, "\u0649\u0670"},
+	0xFC5E:  {true, " \u064c\u0651"},
+	0xFC5F:  {true, " \u064d\u0651"},
+	0xFC60:  {true, " \u064e\u0651"},
+	0xFC61:  {true, " \u064f\u0651"},
+	0xFC62:  {true, " \u0650\u0651"},
+	0xFC63:  {true, " \u0651\u0670"},
+	0xFC64:  {true, "\u0626\u0631"},
+	0xFC65:  {true, "\u0626\u0632"},
+	0xFC66:  {true, "\u0626\u0645"},
+	0xFC67:  {true, "\u0626\u0646"},
+	0xFC68:  {true, "\u0626\u0649"},
+	0xFC69:  {true, "\u0626\u064a"},
+	0xFC6A:  {true, "\u0628\u0631"},
+	0xFC6B:  {true, "\u0628\u0632"},
+	0xFC6C:  {true, "\u0628\u0645"},
+	0xFC6D:  {true, "\u0628\u0646"},
+	0xFC6E:  {true, "\u0628\u0649"},
+	0xFC6F:  {true, "\u0628\u064a"},
+	0xFC70:  {true, "\u062a\u0631"},
+	0xFC71:  {true, "\u062a\u0632"},
+	0xFC72:  {true, "\u062a\u0645"},
+	0xFC73:  {true, "\u062a\u0646"},
+	0xFC74:  {true, "\u062a\u0649"},
+	0xFC75:  {true, "\u062a\u064a"},
+	0xFC76:  {true, "\u062b\u0631"},
+	0xFC77:  {true, "\u062b\u0632"},
+	0xFC78:  {true, "\u062b\u0645"},
+	0xFC79:  {true, "\u062b\u0646"},
+	0xFC7A:  {true, "\u062b\u0649"},
+	0xFC7B:  {true, "\u062b\u064a"},
+	0xFC7C:  {true, "\u0641\u0649"},
+	0xFC7D:  {true, "\u0641\u064a"},
+	0xFC7E:  {true, "\u0642\u0649"},
+	0xFC7F:  {true, "\u0642\u064a"},
+	0xFC80:  {true, "\u0643\u0627"},
+	0xFC81:  {true, "\u0643\u0644"},
+	0xFC82:  {true, "\u0643\u0645"},
+	0xFC83:  {true, "\u0643\u0649"},
+	0xFC84:  {true, "\u0643\u064a"},
+	0xFC85:  {true, "\u0644\u0645"},
+	0xFC86:  {true, "\u0644\u0649"},
+	0xFC87:  {true, "\u0644\u064a"},
+	0xFC88:  {true, "\u0645\u0627"},
+	0xFC89:  {true, "\u0645\u0645"},
+	0xFC8A:  {true, "\u0646\u0631"},
+	0xFC8B:  {true, "\u0646\u0632"},
+	0xFC8C:  {true, "\u0646\u0645"},
+	0xFC8D:  {true, "\u0646\u0646"},
+	0xFC8E:  {true, "\u0646\u0649"},
+	0xFC8F:  {true, "\u0646\u064a"},
+	0xFC90:  {true, "\u0649\u0670"},
+	0xFC91:  {true, "\u064a\u0631"},
+	0xFC92:  {true, "\u064a\u0632"},
+	0xFC93:  {true, "\u064a\u0645"},
+	0xFC94:  {true, "\u064a\u0646"},
+	0xFC95:  {true, "\u064a\u0649"},
+	0xFC96:  {true, "\u064a\u064a"},
+	0xFC97:  {true, "\u0626\u062c"},
+	0xFC98:  {true, "\u0626\u062d"},
+	0xFC99:  {true, "\u0626\u062e"},
+	0xFC9A:  {true, "\u0626\u0645"},
+	0xFC9B:  {true, "\u0626\u0647"},
+	0xFC9C:  {true, "\u0628\u062c"},
+	0xFC9D:  {true, "\u0628\u062d"},
+	0xFC9E:  {true, "\u0628\u062e"},
+	0xFC9F:  {true, "\u0628\u0645"},
+	0xFCA0:  {true, "\u0628\u0647"},
+	0xFCA1:  {true, "\u062a\u062c"},
+	0xFCA2:  {true, "\u062a\u062d"},
+	0xFCA3:  {true, "\u062a\u062e"},
+	0xFCA4:  {true, "\u062a\u0645"},
+	0xFCA5:  {true, "\u062a\u0647"},
+	0xFCA6:  {true, "\u062b\u0645"},
+	0xFCA7:  {true, "\u062c\u062d"},
+	0xFCA8:  {true, "\u062c\u0645"},
+	0xFCA9:  {true, "\u062d\u062c"},
+	0xFCAA:  {true, "\u062d\u0645"},
+	0xFCAB:  {true, "\u062e\u062c"},
+	0xFCAC:  {true, "\u062e\u0645"},
+	0xFCAD:  {true, "\u0633\u062c"},
+	0xFCAE:  {true, "\u0633\u062d"},
+	0xFCAF:  {true, "\u0633\u062e"},
+	0xFCB0:  {true, "\u0633\u0645"},
+	0xFCB1:  {true, "\u0635\u062d"},
+	0xFCB2:  {true, "\u0635\u062e"},
+	0xFCB3:  {true, "\u0635\u0645"},
+	0xFCB4:  {true, "\u0636\u062c"},
+	0xFCB5:  {true, "\u0636\u062d"},
+	0xFCB6:  {true, "\u0636\u062e"},
+	0xFCB7:  {true, "\u0636\u0645"},
+	0xFCB8:  {true, "\u0637\u062d"},
+	0xFCB9:  {true, "\u0638\u0645"},
+	0xFCBA:  {true, "\u0639\u062c"},
+	0xFCBB:  {true, "\u0639\u0645"},
+	0xFCBC:  {true, "\u063a\u062c"},
+	0xFCBD:  {true, "\u063a\u0645"},
+	0xFCBE:  {true, "\u0641\u062c"},
+	0xFCBF:  {true, "\u0641\u062d"},
+	0xFCC0:  {true, "\u0641\u062e"},
+	0xFCC1:  {true, "\u0641\u0645"},
+	0xFCC2:  {true, "\u0642\u062d"},
+	0xFCC3:  {true, "\u0642\u0645"},
+	0xFCC4:  {true, "\u0643\u062c"},
+	0xFCC5:  {true, "\u0643\u062d"},
+	0xFCC6:  {true, "\u0643\u062e"},
+	0xFCC7:  {true, "\u0643\u0644"},
+	0xFCC8:  {true, "\u0643\u0645"},
+	0xFCC9:  {true, "\u0644\u062c"},
+	0xFCCA:  {true, "\u0644\u062d"},
+	0xFCCB:  {true, "\u0644\u062e"},
+	0xFCCC:  {true, "\u0644\u0645"},
+	0xFCCD:  {true, "\u0644\u0647"},
+	0xFCCE:  {true, "\u0645\u062c"},
+	0xFCCF:  {true, "\u0645\u062d"},
+	0xFCD0:  {true, "\u0645\u062e"},
+	0xFCD1:  {true, "\u0645\u0645"},
+	0xFCD2:  {true, "\u0646\u062c"},
+	0xFCD3:  {true, "\u0646\u062d"},
+	0xFCD4:  {true, "\u0646\u062e"},
+	0xFCD5:  {true, "\u0646\u0645"},
+	0xFCD6:  {true, "\u0646\u0647"},
+	0xFCD7:  {true, "\u0647\u062c"},
+	0xFCD8:  {true, "\u0647\u0645"},
+	0xFCD9:  {true, "\u0647\u0670"},
+	0xFCDA:  {true, "\u064a\u062c"},
+	0xFCDB:  {true, "\u064a\u062d"},
+	0xFCDC:  {true, "\u064a\u062e"},
+	0xFCDD:  {true, "\u064a\u0645"},
+	0xFCDE:  {true, "\u064a\u0647"},
+	0xFCDF:  {true, "\u0626\u0645"},
+	0xFCE0:  {true, "\u0626\u0647"},
+	0xFCE1:  {true, "\u0628\u0645"},
+	0xFCE2:  {true, "\u0628\u0647"},
+	0xFCE3:  {true, "\u062a\u0645"},
+	0xFCE4:  {true, "\u062a\u0647"},
+	0xFCE5:  {true, "\u062b\u0645"},
+	0xFCE6:  {true, "\u062b\u0647"},
+	0xFCE7:  {true, "\u0633\u0645"},
+	0xFCE8:  {true, "\u0633\u0647"},
+	0xFCE9:  {true, "\u0634\u0645"},
+	0xFCEA:  {true, "\u0634\u0647"},
+	0xFCEB:  {true, "\u0643\u0644"},
+	0xFCEC:  {true, "\u0643\u0645"},
+	0xFCED:  {true, "\u0644\u0645"},
+	0xFCEE:  {true, "\u0646\u0645"},
+	0xFCEF:  {true, "\u0646\u0647"},
+	0xFCF0:  {true, "\u064a\u0645"},
+	0xFCF1:  {true, "\u064a\u0647"},
+	0xFCF2:  {true, "\u0640\u064e\u0651"},
+	0xFCF3:  {true, "\u0640\u064f\u0651"},
+	0xFCF4:  {true, "\u0640\u0650\u0651"},
+	0xFCF5:  {true, "\u0637\u0649"},
+	0xFCF6:  {true, "\u0637\u064a"},
+	0xFCF7:  {true, "\u0639\u0649"},
+	0xFCF8:  {true, "\u0639\u064a"},
+	0xFCF9:  {true, "\u063a\u0649"},
+	0xFCFA:  {true, "\u063a\u064a"},
+	0xFCFB:  {true, "\u0633\u0649"},
+	0xFCFC:  {true, "\u0633\u064a"},
+	0xFCFD:  {true, "\u0634\u0649"},
+	0xFCFE:  {true, "\u0634\u064a"},
+	0xFCFF:  {true, "\u062d\u0649"},
+	0xFD00:  {true, "\u062d\u064a"},
+	0xFD01:  {true, "\u062c\u0649"},
+	0xFD02:  {true, "\u062c\u064a"},
+	0xFD03:  {true, "\u062e\u0649"},
+	0xFD04:  {true, "\u062e\u064a"},
+	0xFD05:  {true, "\u0635\u0649"},
+	0xFD06:  {true, "\u0635\u064a"},
+	0xFD07:  {true, "\u0636\u0649"},
+	0xFD08:  {true, "\u0636\u064a"},
+	0xFD09:  {true, "\u0634\u062c"},
+	0xFD0A:  {true, "\u0634\u062d"},
+	0xFD0B:  {true, "\u0634\u062e"},
+	0xFD0C:  {true, "\u0634\u0645"},
+	0xFD0D:  {true, "\u0634\u0631"},
+	0xFD0E:  {true, "\u0633\u0631"},
+	0xFD0F:  {true, "\u0635\u0631"},
+	0xFD10:  {true, "\u0636\u0631"},
+	0xFD11:  {true, "\u0637\u0649"},
+	0xFD12:  {true, "\u0637\u064a"},
+	0xFD13:  {true, "\u0639\u0649"},
+	0xFD14:  {true, "\u0639\u064a"},
+	0xFD15:  {true, "\u063a\u0649"},
+	0xFD16:  {true, "\u063a\u064a"},
+	0xFD17:  {true, "\u0633\u0649"},
+	0xFD18:  {true, "\u0633\u064a"},
+	0xFD19:  {true, "\u0634\u0649"},
+	0xFD1A:  {true, "\u0634\u064a"},
+	0xFD1B:  {true, "\u062d\u0649"},
+	0xFD1C:  {true, "\u062d\u064a"},
+	0xFD1D:  {true, "\u062c\u0649"},
+	0xFD1E:  {true, "\u062c\u064a"},
+	0xFD1F:  {true, "\u062e\u0649"},
+	0xFD20:  {true, "\u062e\u064a"},
+	0xFD21:  {true, "\u0635\u0649"},
+	0xFD22:  {true, "\u0635\u064a"},
+	0xFD23:  {true, "\u0636\u0649"},
+	0xFD24:  {true, "\u0636\u064a"},
+	0xFD25:  {true, "\u0634\u062c"},
+	0xFD26:  {true, "\u0634\u062d"},
+	0xFD27:  {true, "\u0634\u062e"},
+	0xFD28:  {true, "\u0634\u0645"},
+	0xFD29:  {true, "\u0634\u0631"},
+	0xFD2A:  {true, "\u0633\u0631"},
+	0xFD2B:  {true, "\u0635\u0631"},
+	0xFD2C:  {true, "\u0636\u0631"},
+	0xFD2D:  {true, "\u0634\u062c"},
+	0xFD2E:  {true, "\u0634\u062d"},
+	0xFD2F:  {true, "\u0634\u062e"},
+	0xFD30:  {true, "\u0634\u0645"},
+	0xFD31:  {true, "\u0633\u0647"},
+	0xFD32:  {true, "\u0634\u0647"},
+	0xFD33:  {true, "\u0637\u0645"},
+	0xFD34:  {true, "\u0633\u062c"},
+	0xFD35:  {true, "\u0633\u062d"},
+	0xFD36:  {true, "\u0633\u062e"},
+	0xFD37:  {true, "\u0634\u062c"},
+	0xFD38:  {true, "\u0634\u062d"},
+	0xFD39:  {true, "\u0634\u062e"},
+	0xFD3A:  {true, "\u0637\u0645"},
+	0xFD3B:  {true, "\u0638\u0645"},
+	0xFD3C:  {true, "\u0627\u064b"},
+	0xFD3D:  {true, "\u0627\u064b"},
+	0xFD50:  {true, "\u062a\u062c\u0645"},
+	0xFD51:  {true, "\u062a\u062d\u062c"},
+	0xFD52:  {true, "\u062a\u062d\u062c"},
+	0xFD53:  {true, "\u062a\u062d\u0645"},
+	0xFD54:  {true, "\u062a\u062e\u0645"},
+	0xFD55:  {true, "\u062a\u0645\u062c"},
+	0xFD56:  {true, "\u062a\u0645\u062d"},
+	0xFD57:  {true, "\u062a\u0645\u062e"},
+	0xFD58:  {true, "\u062c\u0645\u062d"},
+	0xFD59:  {true, "\u062c\u0645\u062d"},
+	0xFD5A:  {true, "\u062d\u0645\u064a"},
+	0xFD5B:  {true, "\u062d\u0645\u0649"},
+	0xFD5C:  {true, "\u0633\u062d\u062c"},
+	0xFD5D:  {true, "\u0633\u062c\u062d"},
+	0xFD5E:  {true, "\u0633\u062c\u0649"},
+	0xFD5F:  {true, "\u0633\u0645\u062d"},
+	0xFD60:  {true, "\u0633\u0645\u062d"},
+	0xFD61:  {true, "\u0633\u0645\u062c"},
+	0xFD62:  {true, "\u0633\u0645\u0645"},
+	0xFD63:  {true, "\u0633\u0645\u0645"},
+	0xFD64:  {true, "\u0635\u062d\u062d"},
+	0xFD65:  {true, "\u0635\u062d\u062d"},
+	0xFD66:  {true, "\u0635\u0645\u0645"},
+	0xFD67:  {true, "\u0634\u062d\u0645"},
+	0xFD68:  {true, "\u0634\u062d\u0645"},
+	0xFD69:  {true, "\u0634\u062c\u064a"},
+	0xFD6A:  {true, "\u0634\u0645\u062e"},
+	0xFD6B:  {true, "\u0634\u0645\u062e"},
+	0xFD6C:  {true, "\u0634\u0645\u0645"},
+	0xFD6D:  {true, "\u0634\u0645\u0645"},
+	0xFD6E:  {true, "\u0636\u062d\u0649"},
+	0xFD6F:  {true, "\u0636\u062e\u0645"},
+	0xFD70:  {true, "\u0636\u062e\u0645"},
+	0xFD71:  {true, "\u0637\u0645\u062d"},
+	0xFD72:  {true, "\u0637\u0645\u062d"},
+	0xFD73:  {true, "\u0637\u0645\u0645"},
+	0xFD74:  {true, "\u0637\u0645\u064a"},
+	0xFD75:  {true, "\u0639\u062c\u0645"},
+	0xFD76:  {true, "\u0639\u0645\u0645"},
+	0xFD77:  {true, "\u0639\u0645\u0645"},
+	0xFD78:  {true, "\u0639\u0645\u0649"},
+	0xFD79:  {true, "\u063a\u0645\u0645"},
+	0xFD7A:  {true, "\u063a\u0645\u064a"},
+	0xFD7B:  {true, "\u063a\u0645\u0649"},
+	0xFD7C:  {true, "\u0641\u062e\u0645"},
+	0xFD7D:  {true, "\u0641\u062e\u0645"},
+	0xFD7E:  {true, "\u0642\u0645\u062d"},
+	0xFD7F:  {true, "\u0642\u0645\u0645"},
+	0xFD80:  {true, "\u0644\u062d\u0645"},
+	0xFD81:  {true, "\u0644\u062d\u064a"},
+	0xFD82:  {true, "\u0644\u062d\u0649"},
+	0xFD83:  {true, "\u0644\u062c\u062c"},
+	0xFD84:  {true, "\u0644\u062c\u062c"},
+	0xFD85:  {true, "\u0644\u062e\u0645"},
+	0xFD86:  {true, "\u0644\u062e\u0645"},
+	0xFD87:  {true, "\u0644\u0645\u062d"},
+	0xFD88:  {true, "\u0644\u0645\u062d"},
+	0xFD89:  {true, "\u0645\u062d\u062c"},
+	0xFD8A:  {true, "\u0645\u062d\u0645"},
+	0xFD8B:  {true, "\u0645\u062d\u064a"},
+	0xFD8C:  {true, "\u0645\u062c\u062d"},
+	0xFD8D:  {true, "\u0645\u062c\u0645"},
+	0xFD8E:  {true, "\u0645\u062e\u062c"},
+	0xFD8F:  {true, "\u0645\u062e\u0645"},
+	0xFD92:  {true, "\u0645\u062c\u062e"},
+	0xFD93:  {true, "\u0647\u0645\u062c"},
+	0xFD94:  {true, "\u0647\u0645\u0645"},
+	0xFD95:  {true, "\u0646\u062d\u0645"},
+	0xFD96:  {true, "\u0646\u062d\u0649"},
+	0xFD97:  {true, "\u0646\u062c\u0645"},
+	0xFD98:  {true, "\u0646\u062c\u0645"},
+	0xFD99:  {true, "\u0646\u062c\u0649"},
+	0xFD9A:  {true, "\u0646\u0645\u064a"},
+	0xFD9B:  {true, "\u0646\u0645\u0649"},
+	0xFD9C:  {true, "\u064a\u0645\u0645"},
+	0xFD9D:  {true, "\u064a\u0645\u0645"},
+	0xFD9E:  {true, "\u0628\u062e\u064a"},
+	0xFD9F:  {true, "\u062a\u062c\u064a"},
+	0xFDA0:  {true, "\u062a\u062c\u0649"},
+	0xFDA1:  {true, "\u062a\u062e\u064a"},
+	0xFDA2:  {true, "\u062a\u062e\u0649"},
+	0xFDA3:  {true, "\u062a\u0645\u064a"},
+	0xFDA4:  {true, "\u062a\u0645\u0649"},
+	0xFDA5:  {true, "\u062c\u0645\u064a"},
+	0xFDA6:  {true, "\u062c\u062d\u0649"},
+	0xFDA7:  {true, "\u062c\u0645\u0649"},
+	0xFDA8:  {true, "\u0633\u062e\u0649"},
+	0xFDA9:  {true, "\u0635\u062d\u064a"},
+	0xFDAA:  {true, "\u0634\u062d\u064a"},
+	0xFDAB:  {true, "\u0636\u062d\u064a"},
+	0xFDAC:  {true, "\u0644\u062c\u064a"},
+	0xFDAD:  {true, "\u0644\u0645\u064a"},
+	0xFDAE:  {true, "\u064a\u062d\u064a"},
+	0xFDAF:  {true, "\u064a\u062c\u064a"},
+	0xFDB0:  {true, "\u064a\u0645\u064a"},
+	0xFDB1:  {true, "\u0645\u0645\u064a"},
+	0xFDB2:  {true, "\u0642\u0645\u064a"},
+	0xFDB3:  {true, "\u0646\u062d\u064a"},
+	0xFDB4:  {true, "\u0642\u0645\u062d"},
+	0xFDB5:  {true, "\u0644\u062d\u0645"},
+	0xFDB6:  {true, "\u0639\u0645\u064a"},
+	0xFDB7:  {true, "\u0643\u0645\u064a"},
+	0xFDB8:  {true, "\u0646\u062c\u062d"},
+	0xFDB9:  {true, "\u0645\u062e\u064a"},
+	0xFDBA:  {true, "\u0644\u062c\u0645"},
+	0xFDBB:  {true, "\u0643\u0645\u0645"},
+	0xFDBC:  {true, "\u0644\u062c\u0645"},
+	0xFDBD:  {true, "\u0646\u062c\u062d"},
+	0xFDBE:  {true, "\u062c\u062d\u064a"},
+	0xFDBF:  {true, "\u062d\u062c\u064a"},
+	0xFDC0:  {true, "\u0645\u062c\u064a"},
+	0xFDC1:  {true, "\u0641\u0645\u064a"},
+	0xFDC2:  {true, "\u0628\u062d\u064a"},
+	0xFDC3:  {true, "\u0643\u0645\u0645"},
+	0xFDC4:  {true, "\u0639\u062c\u0645"},
+	0xFDC5:  {true, "\u0635\u0645\u0645"},
+	0xFDC6:  {true, "\u0633\u062e\u064a"},
+	0xFDC7:  {true, "\u0646\u062c\u064a"},
+	0xFDF0:  {true, "\u0635\u0644\u06d2"},
+	0xFDF1:  {true, "\u0642\u0644\u06d2"},
+	0xFDF2:  {true, "\u0627\u0644\u0644\u0647"},
+	0xFDF3:  {true, "\u0627\u0643\u0628\u0631"},
+	0xFDF4:  {true, "\u0645\u062d\u0645\u062f"},
+	0xFDF5:  {true, "\u0635\u0644\u0639\u0645"},
+	0xFDF6:  {true, "\u0631\u0633\u0648\u0644"},
+	0xFDF7:  {true, "\u0639\u0644\u064a\u0647"},
+	0xFDF8:  {true, "\u0648\u0633\u0644\u0645"},
+	0xFDF9:  {true, "\u0635\u0644\u0649"},
+	0xFDFA:  {true, "\u0635\u0644\u0649 \u0627\u0644\u0644\u0647 \u0639\u0644\u064a\u0647 \u0648\u0633\u0644\u0645"},
+	0xFDFB:  {true, "\u062c\u0644 \u062c\u0644\u0627\u0644\u0647"},
+	0xFDFC:  {true, "\u0631\u06cc\u0627\u0644"},
+	0xFE10:  {true, ","},
+	0xFE11:  {true, "\u3001"},
+	0xFE12:  {true, "\u3002"},
+	0xFE13:  {true, ":"},
+	0xFE14:  {true, ";"},
+	0xFE15:  {true, "!"},
+	0xFE16:  {true, "?"},
+	0xFE17:  {true, "\u3016"},
+	0xFE18:  {true, "\u3017"},
+	0xFE19:  {true, "\u2026"},
+	0xFE30:  {true, "\u2025"},
+	0xFE31:  {true, "\u2014"},
+	0xFE32:  {true, "\u2013"},
+	0xFE33:  {true, "_"},
+	0xFE34:  {true, "_"},
+	0xFE35:  {true, "("},
+	0xFE36:  {true, ")"},
+	0xFE37:  {true, "{"},
+	0xFE38:  {true, "}"},
+	0xFE39:  {true, "\u3014"},
+	0xFE3A:  {true, "\u3015"},
+	0xFE3B:  {true, "\u3010"},
+	0xFE3C:  {true, "\u3011"},
+	0xFE3D:  {true, "\u300a"},
+	0xFE3E:  {true, "\u300b"},
+	0xFE3F:  {true, "\u3008"},
+	0xFE40:  {true, "\u3009"},
+	0xFE41:  {true, "\u300c"},
+	0xFE42:  {true, "\u300d"},
+	0xFE43:  {true, "\u300e"},
+	0xFE44:  {true, "\u300f"},
+	0xFE47:  {true, "["},
+	0xFE48:  {true, "]"},
+	0xFE49:  {true, "\u203e"},
+	0xFE4A:  {true, "\u203e"},
+	0xFE4B:  {true, "\u203e"},
+	0xFE4C:  {true, "\u203e"},
+	0xFE4D:  {true, "_"},
+	0xFE4E:  {true, "_"},
+	0xFE4F:  {true, "_"},
+	0xFE50:  {true, ","},
+	0xFE51:  {true, "\u3001"},
+	0xFE52:  {true, "."},
+	0xFE54:  {true, ";"},
+	0xFE55:  {true, ":"},
+	0xFE56:  {true, "?"},
+	0xFE57:  {true, "!"},
+	0xFE58:  {true, "\u2014"},
+	0xFE59:  {true, "("},
+	0xFE5A:  {true, ")"},
+	0xFE5B:  {true, "{"},
+	0xFE5C:  {true, "}"},
+	0xFE5D:  {true, "\u3014"},
+	0xFE5E:  {true, "\u3015"},
+	0xFE5F:  {true, "#"},
+	0xFE60:  {true, "&"},
+	0xFE61:  {true, "*"},
+	0xFE62:  {true, "+"},
+	0xFE63:  {true, "-"},
+	0xFE64:  {true, "<"},
+	0xFE65:  {true, ">"},
+	0xFE66:  {true, "="},
+	0xFE68:  {true, "\\"},
+	0xFE69:  {true, "$"},
+	0xFE6A:  {true, "%"},
+	0xFE6B:  {true, "@"},
+	0xFE70:  {true, " \u064b"},
+	0xFE71:  {true, "\u0640\u064b"},
+	0xFE72:  {true, " \u064c"},
+	0xFE74:  {true, " \u064d"},
+	0xFE76:  {true, " \u064e"},
+	0xFE77:  {true, "\u0640\u064e"},
+	0xFE78:  {true, " \u064f"},
+	0xFE79:  {true, "\u0640\u064f"},
+	0xFE7A:  {true, " \u0650"},
+	0xFE7B:  {true, "\u0640\u0650"},
+	0xFE7C:  {true, " \u0651"},
+	0xFE7D:  {true, "\u0640\u0651"},
+	0xFE7E:  {true, " \u0652"},
+	0xFE7F:  {true, "\u0640\u0652"},
+	0xFE80:  {true, "\u0621"},
+	0xFE81:  {true, "\u0622"},
+	0xFE82:  {true, "\u0622"},
+	0xFE83:  {true, "\u0623"},
+	0xFE84:  {true, "\u0623"},
+	0xFE85:  {true, "\u0624"},
+	0xFE86:  {true, "\u0624"},
+	0xFE87:  {true, "\u0625"},
+	0xFE88:  {true, "\u0625"},
+	0xFE89:  {true, "\u0626"},
+	0xFE8A:  {true, "\u0626"},
+	0xFE8B:  {true, "\u0626"},
+	0xFE8C:  {true, "\u0626"},
+	0xFE8D:  {true, "\u0627"},
+	0xFE8E:  {true, "\u0627"},
+	0xFE8F:  {true, "\u0628"},
+	0xFE90:  {true, "\u0628"},
+	0xFE91:  {true, "\u0628"},
+	0xFE92:  {true, "\u0628"},
+	0xFE93:  {true, "\u0629"},
+	0xFE94:  {true, "\u0629"},
+	0xFE95:  {true, "\u062a"},
+	0xFE96:  {true, "\u062a"},
+	0xFE97:  {true, "\u062a"},
+	0xFE98:  {true, "\u062a"},
+	0xFE99:  {true, "\u062b"},
+	0xFE9A:  {true, "\u062b"},
+	0xFE9B:  {true, "\u062b"},
+	0xFE9C:  {true, "\u062b"},
+	0xFE9D:  {true, "\u062c"},
+	0xFE9E:  {true, "\u062c"},
+	0xFE9F:  {true, "\u062c"},
+	0xFEA0:  {true, "\u062c"},
+	0xFEA1:  {true, "\u062d"},
+	0xFEA2:  {true, "\u062d"},
+	0xFEA3:  {true, "\u062d"},
+	0xFEA4:  {true, "\u062d"},
+	0xFEA5:  {true, "\u062e"},
+	0xFEA6:  {true, "\u062e"},
+	0xFEA7:  {true, "\u062e"},
+	0xFEA8:  {true, "\u062e"},
+	0xFEA9:  {true, "\u062f"},
+	0xFEAA:  {true, "\u062f"},
+	0xFEAB:  {true, "\u0630"},
+	0xFEAC:  {true, "\u0630"},
+	0xFEAD:  {true, "\u0631"},
+	0xFEAE:  {true, "\u0631"},
+	0xFEAF:  {true, "\u0632"},
+	0xFEB0:  {true, "\u0632"},
+	0xFEB1:  {true, "\u0633"},
+	0xFEB2:  {true, "\u0633"},
+	0xFEB3:  {true, "\u0633"},
+	0xFEB4:  {true, "\u0633"},
+	0xFEB5:  {true, "\u0634"},
+	0xFEB6:  {true, "\u0634"},
+	0xFEB7:  {true, "\u0634"},
+	0xFEB8:  {true, "\u0634"},
+	0xFEB9:  {true, "\u0635"},
+	0xFEBA:  {true, "\u0635"},
+	0xFEBB:  {true, "\u0635"},
+	0xFEBC:  {true, "\u0635"},
+	0xFEBD:  {true, "\u0636"},
+	0xFEBE:  {true, "\u0636"},
+	0xFEBF:  {true, "\u0636"},
+	0xFEC0:  {true, "\u0636"},
+	0xFEC1:  {true, "\u0637"},
+	0xFEC2:  {true, "\u0637"},
+	0xFEC3:  {true, "\u0637"},
+	0xFEC4:  {true, "\u0637"},
+	0xFEC5:  {true, "\u0638"},
+	0xFEC6:  {true, "\u0638"},
+	0xFEC7:  {true, "\u0638"},
+	0xFEC8:  {true, "\u0638"},
+	0xFEC9:  {true, "\u0639"},
+	0xFECA:  {true, "\u0639"},
+	0xFECB:  {true, "\u0639"},
+	0xFECC:  {true, "\u0639"},
+	0xFECD:  {true, "\u063a"},
+	0xFECE:  {true, "\u063a"},
+	0xFECF:  {true, "\u063a"},
+	0xFED0:  {true, "\u063a"},
+	0xFED1:  {true, "\u0641"},
+	0xFED2:  {true, "\u0641"},
+	0xFED3:  {true, "\u0641"},
+	0xFED4:  {true, "\u0641"},
+	0xFED5:  {true, "\u0642"},
+	0xFED6:  {true, "\u0642"},
+	0xFED7:  {true, "\u0642"},
+	0xFED8:  {true, "\u0642"},
+	0xFED9:  {true, "\u0643"},
+	0xFEDA:  {true, "\u0643"},
+	0xFEDB:  {true, "\u0643"},
+	0xFEDC:  {true, "\u0643"},
+	0xFEDD:  {true, "\u0644"},
+	0xFEDE:  {true, "\u0644"},
+	0xFEDF:  {true, "\u0644"},
+	0xFEE0:  {true, "\u0644"},
+	0xFEE1:  {true, "\u0645"},
+	0xFEE2:  {true, "\u0645"},
+	0xFEE3:  {true, "\u0645"},
+	0xFEE4:  {true, "\u0645"},
+	0xFEE5:  {true, "\u0646"},
+	0xFEE6:  {true, "\u0646"},
+	0xFEE7:  {true, "\u0646"},
+	0xFEE8:  {true, "\u0646"},
+	0xFEE9:  {true, "\u0647"},
+	0xFEEA:  {true, "\u0647"},
+	0xFEEB:  {true, "\u0647"},
+	0xFEEC:  {true, "\u0647"},
+	0xFEED:  {true, "\u0648"},
+	0xFEEE:  {true, "\u0648"},
+	0xFEEF:  {true, "\u0649"},
+	0xFEF0:  {true, "\u0649"},
+	0xFEF1:  {true, "\u064a"},
+	0xFEF2:  {true, "\u064a"},
+	0xFEF3:  {true, "\u064a"},
+	0xFEF4:  {true, "\u064a"},
+	0xFEF5:  {true, "\u0644\u0622"},
+	0xFEF6:  {true, "\u0644\u0622"},
+	0xFEF7:  {true, "\u0644\u0623"},
+	0xFEF8:  {true, "\u0644\u0623"},
+	0xFEF9:  {true, "\u0644\u0625"},
+	0xFEFA:  {true, "\u0644\u0625"},
+	0xFEFB:  {true, "\u0644\u0627"},
+	0xFEFC:  {true, "\u0644\u0627"},
+	0xFF01:  {true, "!"},
+	0xFF02:  {true, "\""},
+	0xFF03:  {true, "#"},
+	0xFF04:  {true, "$"},
+	0xFF05:  {true, "%"},
+	0xFF06:  {true, "&"},
+	0xFF07:  {true, "'"},
+	0xFF08:  {true, "("},
+	0xFF09:  {true, ")"},
+	0xFF0A:  {true, "*"},
+	0xFF0B:  {true, "+"},
+	0xFF0C:  {true, ","},
+	0xFF0D:  {true, "-"},
+	0xFF0E:  {true, "."},
+	0xFF0F:  {true, "/"},
+	0xFF10:  {true, "0"},
+	0xFF11:  {true, "1"},
+	0xFF12:  {true, "2"},
+	0xFF13:  {true, "3"},
+	0xFF14:  {true, "4"},
+	0xFF15:  {true, "5"},
+	0xFF16:  {true, "6"},
+	0xFF17:  {true, "7"},
+	0xFF18:  {true, "8"},
+	0xFF19:  {true, "9"},
+	0xFF1A:  {true, ":"},
+	0xFF1B:  {true, ";"},
+	0xFF1C:  {true, "<"},
+	0xFF1D:  {true, "="},
+	0xFF1E:  {true, ">"},
+	0xFF1F:  {true, "?"},
+	0xFF20:  {true, "@"},
+	0xFF21:  {true, "A"},
+	0xFF22:  {true, "B"},
+	0xFF23:  {true, "C"},
+	0xFF24:  {true, "D"},
+	0xFF25:  {true, "E"},
+	0xFF26:  {true, "F"},
+	0xFF27:  {true, "G"},
+	0xFF28:  {true, "H"},
+	0xFF29:  {true, "I"},
+	0xFF2A:  {true, "J"},
+	0xFF2B:  {true, "K"},
+	0xFF2C:  {true, "L"},
+	0xFF2D:  {true, "M"},
+	0xFF2E:  {true, "N"},
+	0xFF2F:  {true, "O"},
+	0xFF30:  {true, "P"},
+	0xFF31:  {true, "Q"},
+	0xFF32:  {true, "R"},
+	0xFF33:  {true, "S"},
+	0xFF34:  {true, "T"},
+	0xFF35:  {true, "U"},
+	0xFF36:  {true, "V"},
+	0xFF37:  {true, "W"},
+	0xFF38:  {true, "X"},
+	0xFF39:  {true, "Y"},
+	0xFF3A:  {true, "Z"},
+	0xFF3B:  {true, "["},
+	0xFF3C:  {true, "\\"},
+	0xFF3D:  {true, "]"},
+	0xFF3E:  {true, "^"},
+	0xFF3F:  {true, "_"},
+	0xFF40:  {true, "`"},
+	0xFF41:  {true, "a"},
+	0xFF42:  {true, "b"},
+	0xFF43:  {true, "c"},
+	0xFF44:  {true, "d"},
+	0xFF45:  {true, "e"},
+	0xFF46:  {true, "f"},
+	0xFF47:  {true, "g"},
+	0xFF48:  {true, "h"},
+	0xFF49:  {true, "i"},
+	0xFF4A:  {true, "j"},
+	0xFF4B:  {true, "k"},
+	0xFF4C:  {true, "l"},
+	0xFF4D:  {true, "m"},
+	0xFF4E:  {true, "n"},
+	0xFF4F:  {true, "o"},
+	0xFF50:  {true, "p"},
+	0xFF51:  {true, "q"},
+	0xFF52:  {true, "r"},
+	0xFF53:  {true, "s"},
+	0xFF54:  {true, "t"},
+	0xFF55:  {true, "u"},
+	0xFF56:  {true, "v"},
+	0xFF57:  {true, "w"},
+	0xFF58:  {true, "x"},
+	0xFF59:  {true, "y"},
+	0xFF5A:  {true, "z"},
+	0xFF5B:  {true, "{"},
+	0xFF5C:  {true, "|"},
+	0xFF5D:  {true, "}"},
+	0xFF5E:  {true, "~"},
+	0xFF5F:  {true, "\u2985"},
+	0xFF60:  {true, "\u2986"},
+	0xFF61:  {true, "\u3002"},
+	0xFF62:  {true, "\u300c"},
+	0xFF63:  {true, "\u300d"},
+	0xFF64:  {true, "\u3001"},
+	0xFF65:  {true, "\u30fb"},
+	0xFF66:  {true, "\u30f2"},
+	0xFF67:  {true, "\u30a1"},
+	0xFF68:  {true, "\u30a3"},
+	0xFF69:  {true, "\u30a5"},
+	0xFF6A:  {true, "\u30a7"},
+	0xFF6B:  {true, "\u30a9"},
+	0xFF6C:  {true, "\u30e3"},
+	0xFF6D:  {true, "\u30e5"},
+	0xFF6E:  {true, "\u30e7"},
+	0xFF6F:  {true, "\u30c3"},
+	0xFF70:  {true, "\u30fc"},
+	0xFF71:  {true, "\u30a2"},
+	0xFF72:  {true, "\u30a4"},
+	0xFF73:  {true, "\u30a6"},
+	0xFF74:  {true, "\u30a8"},
+	0xFF75:  {true, "\u30aa"},
+	0xFF76:  {true, "\u30ab"},
+	0xFF77:  {true, "\u30ad"},
+	0xFF78:  {true, "\u30af"},
+	0xFF79:  {true, "\u30b1"},
+	0xFF7A:  {true, "\u30b3"},
+	0xFF7B:  {true, "\u30b5"},
+	0xFF7C:  {true, "\u30b7"},
+	0xFF7D:  {true, "\u30b9"},
+	0xFF7E:  {true, "\u30bb"},
+	0xFF7F:  {true, "\u30bd"},
+	0xFF80:  {true, "\u30bf"},
+	0xFF81:  {true, "\u30c1"},
+	0xFF82:  {true, "\u30c4"},
+	0xFF83:  {true, "\u30c6"},
+	0xFF84:  {true, "\u30c8"},
+	0xFF85:  {true, "\u30ca"},
+	0xFF86:  {true, "\u30cb"},
+	0xFF87:  {true, "\u30cc"},
+	0xFF88:  {true, "\u30cd"},
+	0xFF89:  {true, "\u30ce"},
+	0xFF8A:  {true, "\u30cf"},
+	0xFF8B:  {true, "\u30d2"},
+	0xFF8C:  {true, "\u30d5"},
+	0xFF8D:  {true, "\u30d8"},
+	0xFF8E:  {true, "\u30db"},
+	0xFF8F:  {true, "\u30de"},
+	0xFF90:  {true, "\u30df"},
+	0xFF91:  {true, "\u30e0"},
+	0xFF92:  {true, "\u30e1"},
+	0xFF93:  {true, "\u30e2"},
+	0xFF94:  {true, "\u30e4"},
+	0xFF95:  {true, "\u30e6"},
+	0xFF96:  {true, "\u30e8"},
+	0xFF97:  {true, "\u30e9"},
+	0xFF98:  {true, "\u30ea"},
+	0xFF99:  {true, "\u30eb"},
+	0xFF9A:  {true, "\u30ec"},
+	0xFF9B:  {true, "\u30ed"},
+	0xFF9C:  {true, "\u30ef"},
+	0xFF9D:  {true, "\u30f3"},
+	0xFF9E:  {true, "\u3099"},
+	0xFF9F:  {true, "\u309a"},
+	0xFFA0:  {true, "\u3164"},
+	0xFFA1:  {true, "\u3131"},
+	0xFFA2:  {true, "\u3132"},
+	0xFFA3:  {true, "\u3133"},
+	0xFFA4:  {true, "\u3134"},
+	0xFFA5:  {true, "\u3135"},
+	0xFFA6:  {true, "\u3136"},
+	0xFFA7:  {true, "\u3137"},
+	0xFFA8:  {true, "\u3138"},
+	0xFFA9:  {true, "\u3139"},
+	0xFFAA:  {true, "\u313a"},
+	0xFFAB:  {true, "\u313b"},
+	0xFFAC:  {true, "\u313c"},
+	0xFFAD:  {true, "\u313d"},
+	0xFFAE:  {true, "\u313e"},
+	0xFFAF:  {true, "\u313f"},
+	0xFFB0:  {true, "\u3140"},
+	0xFFB1:  {true, "\u3141"},
+	0xFFB2:  {true, "\u3142"},
+	0xFFB3:  {true, "\u3143"},
+	0xFFB4:  {true, "\u3144"},
+	0xFFB5:  {true, "\u3145"},
+	0xFFB6:  {true, "\u3146"},
+	0xFFB7:  {true, "\u3147"},
+	0xFFB8:  {true, "\u3148"},
+	0xFFB9:  {true, "\u3149"},
+	0xFFBA:  {true, "\u314a"},
+	0xFFBB:  {true, "\u314b"},
+	0xFFBC:  {true, "\u314c"},
+	0xFFBD:  {true, "\u314d"},
+	0xFFBE:  {true, "\u314e"},
+	0xFFC2:  {true, "\u314f"},
+	0xFFC3:  {true, "\u3150"},
+	0xFFC4:  {true, "\u3151"},
+	0xFFC5:  {true, "\u3152"},
+	0xFFC6:  {true, "\u3153"},
+	0xFFC7:  {true, "\u3154"},
+	0xFFCA:  {true, "\u3155"},
+	0xFFCB:  {true, "\u3156"},
+	0xFFCC:  {true, "\u3157"},
+	0xFFCD:  {true, "\u3158"},
+	0xFFCE:  {true, "\u3159"},
+	0xFFCF:  {true, "\u315a"},
+	0xFFD2:  {true, "\u315b"},
+	0xFFD3:  {true, "\u315c"},
+	0xFFD4:  {true, "\u315d"},
+	0xFFD5:  {true, "\u315e"},
+	0xFFD6:  {true, "\u315f"},
+	0xFFD7:  {true, "\u3160"},
+	0xFFDA:  {true, "\u3161"},
+	0xFFDB:  {true, "\u3162"},
+	0xFFDC:  {true, "\u3163"},
+	0xFFE0:  {true, "\u00a2"},
+	0xFFE1:  {true, "\u00a3"},
+	0xFFE2:  {true, "\u00ac"},
+	0xFFE3:  {true, "\u00af"},
+	0xFFE4:  {true, "\u00a6"},
+	0xFFE5:  {true, "\u00a5"},
+	0xFFE6:  {true, "\u20a9"},
+	0xFFE8:  {true, "\u2502"},
+	0xFFE9:  {true, "\u2190"},
+	0xFFEA:  {true, "\u2191"},
+	0xFFEB:  {true, "\u2192"},
+	0xFFEC:  {true, "\u2193"},
+	0xFFED:  {true, "\u25a0"},
+	0xFFEE:  {true, "\u25cb"},
+	0x10781: {true, "\u02d0"},
+	0x10782: {true, "\u02d1"},
+	0x10783: {true, "\u00e6"},
+	0x10784: {true, "\u0299"},
+	0x10785: {true, "\u0253"},
+	0x10787: {true, "\u02a3"},
+	0x10788: {true, "\uab66"},
+	0x10789: {true, "\u02a5"},
+	0x1078A: {true, "\u02a4"},
+	0x1078B: {true, "\u0256"},
+	0x1078C: {true, "\u0257"},
+	0x1078D: {true, "\u1d91"},
+	0x1078E: {true, "\u0258"},
+	0x1078F: {true, "\u025e"},
+	0x10790: {true, "\u02a9"},
+	0x10791: {true, "\u0264"},
+	0x10792: {true, "\u0262"},
+	0x10793: {true, "\u0260"},
+	0x10794: {true, "\u029b"},
+	0x10795: {true, "\u0127"},
+	0x10796: {true, "\u029c"},
+	0x10797: {true, "\u0267"},
+	0x10798: {true, "\u0284"},
+	0x10799: {true, "\u02aa"},
+	0x1079A: {true, "\u02ab"},
+	0x1079B: {true, "\u026c"},
+	0x1079C: {true, "\U0001df04"},
+	0x1079D: {true, "\ua78e"},
+	0x1079E: {true, "\u026e"},
+	0x1079F: {true, "\U0001df05"},
+	0x107A0: {true, "\u028e"},
+	0x107A1: {true, "\U0001df06"},
+	0x107A2: {true, "\u00f8"},
+	0x107A3: {true, "\u0276"},
+	0x107A4: {true, "\u0277"},
+	0x107A5: {true, "q"},
+	0x107A6: {true, "\u027a"},
+	0x107A7: {true, "\U0001df08"},
+	0x107A8: {true, "\u027d"},
+	0x107A9: {true, "\u027e"},
+	0x107AA: {true, "\u0280"},
+	0x107AB: {true, "\u02a8"},
+	0x107AC: {true, "\u02a6"},
+	0x107AD: {true, "\uab67"},
+	0x107AE: {true, "\u02a7"},
+	0x107AF: {true, "\u0288"},
+	0x107B0: {true, "\u2c71"},
+	0x107B2: {true, "\u028f"},
+	0x107B3: {true, "\u02a1"},
+	0x107B4: {true, "\u02a2"},
+	0x107B5: {true, "\u0298"},
+	0x107B6: {true, "\u01c0"},
+	0x107B7: {true, "\u01c1"},
+	0x107B8: {true, "\u01c2"},
+	0x107B9: {true, "\U0001df0a"},
+	0x107BA: {true, "\U0001df1e"},
+	0x1109A: {false, "\U00011099\U000110ba"},
+	0x1109C: {false, "\U0001109b\U000110ba"},
+	0x110AB: {false, "\U000110a5\U000110ba"},
+	0x1112E: {false, "\U00011131\U00011127"},
+	0x1112F: {false, "\U00011132\U00011127"},
+	0x1134B: {false, "\U00011347\U0001133e"},
+	0x1134C: {false, "\U00011347\U00011357"},
+	0x114BB: {false, "\U000114b9\U000114ba"},
+	0x114BC: {false, "\U000114b9\U000114b0"},
+	0x114BE: {false, "\U000114b9\U000114bd"},
+	0x115BA: {false, "\U000115b8\U000115af"},
+	0x115BB: {false, "\U000115b9\U000115af"},
+	0x11938: {false, "\U00011935\U00011930"},
+	0x1D15E: {false, "\U0001d157\U0001d165"},
+	0x1D15F: {false, "\U0001d158\U0001d165"},
+	0x1D160: {false, "\U0001d15f\U0001d16e"},
+	0x1D161: {false, "\U0001d15f\U0001d16f"},
+	0x1D162: {false, "\U0001d15f\U0001d170"},
+	0x1D163: {false, "\U0001d15f\U0001d171"},
+	0x1D164: {false, "\U0001d15f\U0001d172"},
+	0x1D1BB: {false, "\U0001d1b9\U0001d165"},
+	0x1D1BC: {false, "\U0001d1ba\U0001d165"},
+	0x1D1BD: {false, "\U0001d1bb\U0001d16e"},
+	0x1D1BE: {false, "\U0001d1bc\U0001d16e"},
+	0x1D1BF: {false, "\U0001d1bb\U0001d16f"},
+	0x1D1C0: {false, "\U0001d1bc\U0001d16f"},
+	0x1D400: {true, "A"},
+	0x1D401: {true, "B"},
+	0x1D402: {true, "C"},
+	0x1D403: {true, "D"},
+	0x1D404: {true, "E"},
+	0x1D405: {true, "F"},
+	0x1D406: {true, "G"},
+	0x1D407: {true, "H"},
+	0x1D408: {true, "I"},
+	0x1D409: {true, "J"},
+	0x1D40A: {true, "K"},
+	0x1D40B: {true, "L"},
+	0x1D40C: {true, "M"},
+	0x1D40D: {true, "N"},
+	0x1D40E: {true, "O"},
+	0x1D40F: {true, "P"},
+	0x1D410: {true, "Q"},
+	0x1D411: {true, "R"},
+	0x1D412: {true, "S"},
+	0x1D413: {true, "T"},
+	0x1D414: {true, "U"},
+	0x1D415: {true, "V"},
+	0x1D416: {true, "W"},
+	0x1D417: {true, "X"},
+	0x1D418: {true, "Y"},
+	0x1D419: {true, "Z"},
+	0x1D41A: {true, "a"},
+	0x1D41B: {true, "b"},
+	0x1D41C: {true, "c"},
+	0x1D41D: {true, "d"},
+	0x1D41E: {true, "e"},
+	0x1D41F: {true, "f"},
+	0x1D420: {true, "g"},
+	0x1D421: {true, "h"},
+	0x1D422: {true, "i"},
+	0x1D423: {true, "j"},
+	0x1D424: {true, "k"},
+	0x1D425: {true, "l"},
+	0x1D426: {true, "m"},
+	0x1D427: {true, "n"},
+	0x1D428: {true, "o"},
+	0x1D429: {true, "p"},
+	0x1D42A: {true, "q"},
+	0x1D42B: {true, "r"},
+	0x1D42C: {true, "s"},
+	0x1D42D: {true, "t"},
+	0x1D42E: {true, "u"},
+	0x1D42F: {true, "v"},
+	0x1D430: {true, "w"},
+	0x1D431: {true, "x"},
+	0x1D432: {true, "y"},
+	0x1D433: {true, "z"},
+	0x1D434: {true, "A"},
+	0x1D435: {true, "B"},
+	0x1D436: {true, "C"},
+	0x1D437: {true, "D"},
+	0x1D438: {true, "E"},
+	0x1D439: {true, "F"},
+	0x1D43A: {true, "G"},
+	0x1D43B: {true, "H"},
+	0x1D43C: {true, "I"},
+	0x1D43D: {true, "J"},
+	0x1D43E: {true, "K"},
+	0x1D43F: {true, "L"},
+	0x1D440: {true, "M"},
+	0x1D441: {true, "N"},
+	0x1D442: {true, "O"},
+	0x1D443: {true, "P"},
+	0x1D444: {true, "Q"},
+	0x1D445: {true, "R"},
+	0x1D446: {true, "S"},
+	0x1D447: {true, "T"},
+	0x1D448: {true, "U"},
+	0x1D449: {true, "V"},
+	0x1D44A: {true, "W"},
+	0x1D44B: {true, "X"},
+	0x1D44C: {true, "Y"},
+	0x1D44D: {true, "Z"},
+	0x1D44E: {true, "a"},
+	0x1D44F: {true, "b"},
+	0x1D450: {true, "c"},
+	0x1D451: {true, "d"},
+	0x1D452: {true, "e"},
+	0x1D453: {true, "f"},
+	0x1D454: {true, "g"},
+	0x1D456: {true, "i"},
+	0x1D457: {true, "j"},
+	0x1D458: {true, "k"},
+	0x1D459: {true, "l"},
+	0x1D45A: {true, "m"},
+	0x1D45B: {true, "n"},
+	0x1D45C: {true, "o"},
+	0x1D45D: {true, "p"},
+	0x1D45E: {true, "q"},
+	0x1D45F: {true, "r"},
+	0x1D460: {true, "s"},
+	0x1D461: {true, "t"},
+	0x1D462: {true, "u"},
+	0x1D463: {true, "v"},
+	0x1D464: {true, "w"},
+	0x1D465: {true, "x"},
+	0x1D466: {true, "y"},
+	0x1D467: {true, "z"},
+	0x1D468: {true, "A"},
+	0x1D469: {true, "B"},
+	0x1D46A: {true, "C"},
+	0x1D46B: {true, "D"},
+	0x1D46C: {true, "E"},
+	0x1D46D: {true, "F"},
+	0x1D46E: {true, "G"},
+	0x1D46F: {true, "H"},
+	0x1D470: {true, "I"},
+	0x1D471: {true, "J"},
+	0x1D472: {true, "K"},
+	0x1D473: {true, "L"},
+	0x1D474: {true, "M"},
+	0x1D475: {true, "N"},
+	0x1D476: {true, "O"},
+	0x1D477: {true, "P"},
+	0x1D478: {true, "Q"},
+	0x1D479: {true, "R"},
+	0x1D47A: {true, "S"},
+	0x1D47B: {true, "T"},
+	0x1D47C: {true, "U"},
+	0x1D47D: {true, "V"},
+	0x1D47E: {true, "W"},
+	0x1D47F: {true, "X"},
+	0x1D480: {true, "Y"},
+	0x1D481: {true, "Z"},
+	0x1D482: {true, "a"},
+	0x1D483: {true, "b"},
+	0x1D484: {true, "c"},
+	0x1D485: {true, "d"},
+	0x1D486: {true, "e"},
+	0x1D487: {true, "f"},
+	0x1D488: {true, "g"},
+	0x1D489: {true, "h"},
+	0x1D48A: {true, "i"},
+	0x1D48B: {true, "j"},
+	0x1D48C: {true, "k"},
+	0x1D48D: {true, "l"},
+	0x1D48E: {true, "m"},
+	0x1D48F: {true, "n"},
+	0x1D490: {true, "o"},
+	0x1D491: {true, "p"},
+	0x1D492: {true, "q"},
+	0x1D493: {true, "r"},
+	0x1D494: {true, "s"},
+	0x1D495: {true, "t"},
+	0x1D496: {true, "u"},
+	0x1D497: {true, "v"},
+	0x1D498: {true, "w"},
+	0x1D499: {true, "x"},
+	0x1D49A: {true, "y"},
+	0x1D49B: {true, "z"},
+	0x1D49C: {true, "A"},
+	0x1D49E: {true, "C"},
+	0x1D49F: {true, "D"},
+	0x1D4A2: {true, "G"},
+	0x1D4A5: {true, "J"},
+	0x1D4A6: {true, "K"},
+	0x1D4A9: {true, "N"},
+	0x1D4AA: {true, "O"},
+	0x1D4AB: {true, "P"},
+	0x1D4AC: {true, "Q"},
+	0x1D4AE: {true, "S"},
+	0x1D4AF: {true, "T"},
+	0x1D4B0: {true, "U"},
+	0x1D4B1: {true, "V"},
+	0x1D4B2: {true, "W"},
+	0x1D4B3: {true, "X"},
+	0x1D4B4: {true, "Y"},
+	0x1D4B5: {true, "Z"},
+	0x1D4B6: {true, "a"},
+	0x1D4B7: {true, "b"},
+	0x1D4B8: {true, "c"},
+	0x1D4B9: {true, "d"},
+	0x1D4BB: {true, "f"},
+	0x1D4BD: {true, "h"},
+	0x1D4BE: {true, "i"},
+	0x1D4BF: {true, "j"},
+	0x1D4C0: {true, "k"},
+	0x1D4C1: {true, "l"},
+	0x1D4C2: {true, "m"},
+	0x1D4C3: {true, "n"},
+	0x1D4C5: {true, "p"},
+	0x1D4C6: {true, "q"},
+	0x1D4C7: {true, "r"},
+	0x1D4C8: {true, "s"},
+	0x1D4C9: {true, "t"},
+	0x1D4CA: {true, "u"},
+	0x1D4CB: {true, "v"},
+	0x1D4CC: {true, "w"},
+	0x1D4CD: {true, "x"},
+	0x1D4CE: {true, "y"},
+	0x1D4CF: {true, "z"},
+	0x1D4D0: {true, "A"},
+	0x1D4D1: {true, "B"},
+	0x1D4D2: {true, "C"},
+	0x1D4D3: {true, "D"},
+	0x1D4D4: {true, "E"},
+	0x1D4D5: {true, "F"},
+	0x1D4D6: {true, "G"},
+	0x1D4D7: {true, "H"},
+	0x1D4D8: {true, "I"},
+	0x1D4D9: {true, "J"},
+	0x1D4DA: {true, "K"},
+	0x1D4DB: {true, "L"},
+	0x1D4DC: {true, "M"},
+	0x1D4DD: {true, "N"},
+	0x1D4DE: {true, "O"},
+	0x1D4DF: {true, "P"},
+	0x1D4E0: {true, "Q"},
+	0x1D4E1: {true, "R"},
+	0x1D4E2: {true, "S"},
+	0x1D4E3: {true, "T"},
+	0x1D4E4: {true, "U"},
+	0x1D4E5: {true, "V"},
+	0x1D4E6: {true, "W"},
+	0x1D4E7: {true, "X"},
+	0x1D4E8: {true, "Y"},
+	0x1D4E9: {true, "Z"},
+	0x1D4EA: {true, "a"},
+	0x1D4EB: {true, "b"},
+	0x1D4EC: {true, "c"},
+	0x1D4ED: {true, "d"},
+	0x1D4EE: {true, "e"},
+	0x1D4EF: {true, "f"},
+	0x1D4F0: {true, "g"},
+	0x1D4F1: {true, "h"},
+	0x1D4F2: {true, "i"},
+	0x1D4F3: {true, "j"},
+	0x1D4F4: {true, "k"},
+	0x1D4F5: {true, "l"},
+	0x1D4F6: {true, "m"},
+	0x1D4F7: {true, "n"},
+	0x1D4F8: {true, "o"},
+	0x1D4F9: {true, "p"},
+	0x1D4FA: {true, "q"},
+	0x1D4FB: {true, "r"},
+	0x1D4FC: {true, "s"},
+	0x1D4FD: {true, "t"},
+	0x1D4FE: {true, "u"},
+	0x1D4FF: {true, "v"},
+	0x1D500: {true, "w"},
+	0x1D501: {true, "x"},
+	0x1D502: {true, "y"},
+	0x1D503: {true, "z"},
+	0x1D504: {true, "A"},
+	0x1D505: {true, "B"},
+	0x1D507: {true, "D"},
+	0x1D508: {true, "E"},
+	0x1D509: {true, "F"},
+	0x1D50A: {true, "G"},
+	0x1D50D: {true, "J"},
+	0x1D50E: {true, "K"},
+	0x1D50F: {true, "L"},
+	0x1D510: {true, "M"},
+	0x1D511: {true, "N"},
+	0x1D512: {true, "O"},
+	0x1D513: {true, "P"},
+	0x1D514: {true, "Q"},
+	0x1D516: {true, "S"},
+	0x1D517: {true, "T"},
+	0x1D518: {true, "U"},
+	0x1D519: {true, "V"},
+	0x1D51A: {true, "W"},
+	0x1D51B: {true, "X"},
+	0x1D51C: {true, "Y"},
+	0x1D51E: {true, "a"},
+	0x1D51F: {true, "b"},
+	0x1D520: {true, "c"},
+	0x1D521: {true, "d"},
+	0x1D522: {true, "e"},
+	0x1D523: {true, "f"},
+	0x1D524: {true, "g"},
+	0x1D525: {true, "h"},
+	0x1D526: {true, "i"},
+	0x1D527: {true, "j"},
+	0x1D528: {true, "k"},
+	0x1D529: {true, "l"},
+	0x1D52A: {true, "m"},
+	0x1D52B: {true, "n"},
+	0x1D52C: {true, "o"},
+	0x1D52D: {true, "p"},
+	0x1D52E: {true, "q"},
+	0x1D52F: {true, "r"},
+	0x1D530: {true, "s"},
+	0x1D531: {true, "t"},
+	0x1D532: {true, "u"},
+	0x1D533: {true, "v"},
+	0x1D534: {true, "w"},
+	0x1D535: {true, "x"},
+	0x1D536: {true, "y"},
+	0x1D537: {true, "z"},
+	0x1D538: {true, "A"},
+	0x1D539: {true, "B"},
+	0x1D53B: {true, "D"},
+	0x1D53C: {true, "E"},
+	0x1D53D: {true, "F"},
+	0x1D53E: {true, "G"},
+	0x1D540: {true, "I"},
+	0x1D541: {true, "J"},
+	0x1D542: {true, "K"},
+	0x1D543: {true, "L"},
+	0x1D544: {true, "M"},
+	0x1D546: {true, "O"},
+	0x1D54A: {true, "S"},
+	0x1D54B: {true, "T"},
+	0x1D54C: {true, "U"},
+	0x1D54D: {true, "V"},
+	0x1D54E: {true, "W"},
+	0x1D54F: {true, "X"},
+	0x1D550: {true, "Y"},
+	0x1D552: {true, "a"},
+	0x1D553: {true, "b"},
+	0x1D554: {true, "c"},
+	0x1D555: {true, "d"},
+	0x1D556: {true, "e"},
+	0x1D557: {true, "f"},
+	0x1D558: {true, "g"},
+	0x1D559: {true, "h"},
+	0x1D55A: {true, "i"},
+	0x1D55B: {true, "j"},
+	0x1D55C: {true, "k"},
+	0x1D55D: {true, "l"},
+	0x1D55E: {true, "m"},
+	0x1D55F: {true, "n"},
+	0x1D560: {true, "o"},
+	0x1D561: {true, "p"},
+	0x1D562: {true, "q"},
+	0x1D563: {true, "r"},
+	0x1D564: {true, "s"},
+	0x1D565: {true, "t"},
+	0x1D566: {true, "u"},
+	0x1D567: {true, "v"},
+	0x1D568: {true, "w"},
+	0x1D569: {true, "x"},
+	0x1D56A: {true, "y"},
+	0x1D56B: {true, "z"},
+	0x1D56C: {true, "A"},
+	0x1D56D: {true, "B"},
+	0x1D56E: {true, "C"},
+	0x1D56F: {true, "D"},
+	0x1D570: {true, "E"},
+	0x1D571: {true, "F"},
+	0x1D572: {true, "G"},
+	0x1D573: {true, "H"},
+	0x1D574: {true, "I"},
+	0x1D575: {true, "J"},
+	0x1D576: {true, "K"},
+	0x1D577: {true, "L"},
+	0x1D578: {true, "M"},
+	0x1D579: {true, "N"},
+	0x1D57A: {true, "O"},
+	0x1D57B: {true, "P"},
+	0x1D57C: {true, "Q"},
+	0x1D57D: {true, "R"},
+	0x1D57E: {true, "S"},
+	0x1D57F: {true, "T"},
+	0x1D580: {true, "U"},
+	0x1D581: {true, "V"},
+	0x1D582: {true, "W"},
+	0x1D583: {true, "X"},
+	0x1D584: {true, "Y"},
+	0x1D585: {true, "Z"},
+	0x1D586: {true, "a"},
+	0x1D587: {true, "b"},
+	0x1D588: {true, "c"},
+	0x1D589: {true, "d"},
+	0x1D58A: {true, "e"},
+	0x1D58B: {true, "f"},
+	0x1D58C: {true, "g"},
+	0x1D58D: {true, "h"},
+	0x1D58E: {true, "i"},
+	0x1D58F: {true, "j"},
+	0x1D590: {true, "k"},
+	0x1D591: {true, "l"},
+	0x1D592: {true, "m"},
+	0x1D593: {true, "n"},
+	0x1D594: {true, "o"},
+	0x1D595: {true, "p"},
+	0x1D596: {true, "q"},
+	0x1D597: {true, "r"},
+	0x1D598: {true, "s"},
+	0x1D599: {true, "t"},
+	0x1D59A: {true, "u"},
+	0x1D59B: {true, "v"},
+	0x1D59C: {true, "w"},
+	0x1D59D: {true, "x"},
+	0x1D59E: {true, "y"},
+	0x1D59F: {true, "z"},
+	0x1D5A0: {true, "A"},
+	0x1D5A1: {true, "B"},
+	0x1D5A2: {true, "C"},
+	0x1D5A3: {true, "D"},
+	0x1D5A4: {true, "E"},
+	0x1D5A5: {true, "F"},
+	0x1D5A6: {true, "G"},
+	0x1D5A7: {true, "H"},
+	0x1D5A8: {true, "I"},
+	0x1D5A9: {true, "J"},
+	0x1D5AA: {true, "K"},
+	0x1D5AB: {true, "L"},
+	0x1D5AC: {true, "M"},
+	0x1D5AD: {true, "N"},
+	0x1D5AE: {true, "O"},
+	0x1D5AF: {true, "P"},
+	0x1D5B0: {true, "Q"},
+	0x1D5B1: {true, "R"},
+	0x1D5B2: {true, "S"},
+	0x1D5B3: {true, "T"},
+	0x1D5B4: {true, "U"},
+	0x1D5B5: {true, "V"},
+	0x1D5B6: {true, "W"},
+	0x1D5B7: {true, "X"},
+	0x1D5B8: {true, "Y"},
+	0x1D5B9: {true, "Z"},
+	0x1D5BA: {true, "a"},
+	0x1D5BB: {true, "b"},
+	0x1D5BC: {true, "c"},
+	0x1D5BD: {true, "d"},
+	0x1D5BE: {true, "e"},
+	0x1D5BF: {true, "f"},
+	0x1D5C0: {true, "g"},
+	0x1D5C1: {true, "h"},
+	0x1D5C2: {true, "i"},
+	0x1D5C3: {true, "j"},
+	0x1D5C4: {true, "k"},
+	0x1D5C5: {true, "l"},
+	0x1D5C6: {true, "m"},
+	0x1D5C7: {true, "n"},
+	0x1D5C8: {true, "o"},
+	0x1D5C9: {true, "p"},
+	0x1D5CA: {true, "q"},
+	0x1D5CB: {true, "r"},
+	0x1D5CC: {true, "s"},
+	0x1D5CD: {true, "t"},
+	0x1D5CE: {true, "u"},
+	0x1D5CF: {true, "v"},
+	0x1D5D0: {true, "w"},
+	0x1D5D1: {true, "x"},
+	0x1D5D2: {true, "y"},
+	0x1D5D3: {true, "z"},
+	0x1D5D4: {true, "A"},
+	0x1D5D5: {true, "B"},
+	0x1D5D6: {true, "C"},
+	0x1D5D7: {true, "D"},
+	0x1D5D8: {true, "E"},
+	0x1D5D9: {true, "F"},
+	0x1D5DA: {true, "G"},
+	0x1D5DB: {true, "H"},
+	0x1D5DC: {true, "I"},
+	0x1D5DD: {true, "J"},
+	0x1D5DE: {true, "K"},
+	0x1D5DF: {true, "L"},
+	0x1D5E0: {true, "M"},
+	0x1D5E1: {true, "N"},
+	0x1D5E2: {true, "O"},
+	0x1D5E3: {true, "P"},
+	0x1D5E4: {true, "Q"},
+	0x1D5E5: {true, "R"},
+	0x1D5E6: {true, "S"},
+	0x1D5E7: {true, "T"},
+	0x1D5E8: {true, "U"},
+	0x1D5E9: {true, "V"},
+	0x1D5EA: {true, "W"},
+	0x1D5EB: {true, "X"},
+	0x1D5EC: {true, "Y"},
+	0x1D5ED: {true, "Z"},
+	0x1D5EE: {true, "a"},
+	0x1D5EF: {true, "b"},
+	0x1D5F0: {true, "c"},
+	0x1D5F1: {true, "d"},
+	0x1D5F2: {true, "e"},
+	0x1D5F3: {true, "f"},
+	0x1D5F4: {true, "g"},
+	0x1D5F5: {true, "h"},
+	0x1D5F6: {true, "i"},
+	0x1D5F7: {true, "j"},
+	0x1D5F8: {true, "k"},
+	0x1D5F9: {true, "l"},
+	0x1D5FA: {true, "m"},
+	0x1D5FB: {true, "n"},
+	0x1D5FC: {true, "o"},
+	0x1D5FD: {true, "p"},
+	0x1D5FE: {true, "q"},
+	0x1D5FF: {true, "r"},
+	0x1D600: {true, "s"},
+	0x1D601: {true, "t"},
+	0x1D602: {true, "u"},
+	0x1D603: {true, "v"},
+	0x1D604: {true, "w"},
+	0x1D605: {true, "x"},
+	0x1D606: {true, "y"},
+	0x1D607: {true, "z"},
+	0x1D608: {true, "A"},
+	0x1D609: {true, "B"},
+	0x1D60A: {true, "C"},
+	0x1D60B: {true, "D"},
+	0x1D60C: {true, "E"},
+	0x1D60D: {true, "F"},
+	0x1D60E: {true, "G"},
+	0x1D60F: {true, "H"},
+	0x1D610: {true, "I"},
+	0x1D611: {true, "J"},
+	0x1D612: {true, "K"},
+	0x1D613: {true, "L"},
+	0x1D614: {true, "M"},
+	0x1D615: {true, "N"},
+	0x1D616: {true, "O"},
+	0x1D617: {true, "P"},
+	0x1D618: {true, "Q"},
+	0x1D619: {true, "R"},
+	0x1D61A: {true, "S"},
+	0x1D61B: {true, "T"},
+	0x1D61C: {true, "U"},
+	0x1D61D: {true, "V"},
+	0x1D61E: {true, "W"},
+	0x1D61F: {true, "X"},
+	0x1D620: {true, "Y"},
+	0x1D621: {true, "Z"},
+	0x1D622: {true, "a"},
+	0x1D623: {true, "b"},
+	0x1D624: {true, "c"},
+	0x1D625: {true, "d"},
+	0x1D626: {true, "e"},
+	0x1D627: {true, "f"},
+	0x1D628: {true, "g"},
+	0x1D629: {true, "h"},
+	0x1D62A: {true, "i"},
+	0x1D62B: {true, "j"},
+	0x1D62C: {true, "k"},
+	0x1D62D: {true, "l"},
+	0x1D62E: {true, "m"},
+	0x1D62F: {true, "n"},
+	0x1D630: {true, "o"},
+	0x1D631: {true, "p"},
+	0x1D632: {true, "q"},
+	0x1D633: {true, "r"},
+	0x1D634: {true, "s"},
+	0x1D635: {true, "t"},
+	0x1D636: {true, "u"},
+	0x1D637: {true, "v"},
+	0x1D638: {true, "w"},
+	0x1D639: {true, "x"},
+	0x1D63A: {true, "y"},
+	0x1D63B: {true, "z"},
+	0x1D63C: {true, "A"},
+	0x1D63D: {true, "B"},
+	0x1D63E: {true, "C"},
+	0x1D63F: {true, "D"},
+	0x1D640: {true, "E"},
+	0x1D641: {true, "F"},
+	0x1D642: {true, "G"},
+	0x1D643: {true, "H"},
+	0x1D644: {true, "I"},
+	0x1D645: {true, "J"},
+	0x1D646: {true, "K"},
+	0x1D647: {true, "L"},
+	0x1D648: {true, "M"},
+	0x1D649: {true, "N"},
+	0x1D64A: {true, "O"},
+	0x1D64B: {true, "P"},
+	0x1D64C: {true, "Q"},
+	0x1D64D: {true, "R"},
+	0x1D64E: {true, "S"},
+	0x1D64F: {true, "T"},
+	0x1D650: {true, "U"},
+	0x1D651: {true, "V"},
+	0x1D652: {true, "W"},
+	0x1D653: {true, "X"},
+	0x1D654: {true, "Y"},
+	0x1D655: {true, "Z"},
+	0x1D656: {true, "a"},
+	0x1D657: {true, "b"},
+	0x1D658: {true, "c"},
+	0x1D659: {true, "d"},
+	0x1D65A: {true, "e"},
+	0x1D65B: {true, "f"},
+	0x1D65C: {true, "g"},
+	0x1D65D: {true, "h"},
+	0x1D65E: {true, "i"},
+	0x1D65F: {true, "j"},
+	0x1D660: {true, "k"},
+	0x1D661: {true, "l"},
+	0x1D662: {true, "m"},
+	0x1D663: {true, "n"},
+	0x1D664: {true, "o"},
+	0x1D665: {true, "p"},
+	0x1D666: {true, "q"},
+	0x1D667: {true, "r"},
+	0x1D668: {true, "s"},
+	0x1D669: {true, "t"},
+	0x1D66A: {true, "u"},
+	0x1D66B: {true, "v"},
+	0x1D66C: {true, "w"},
+	0x1D66D: {true, "x"},
+	0x1D66E: {true, "y"},
+	0x1D66F: {true, "z"},
+	0x1D670: {true, "A"},
+	0x1D671: {true, "B"},
+	0x1D672: {true, "C"},
+	0x1D673: {true, "D"},
+	0x1D674: {true, "E"},
+	0x1D675: {true, "F"},
+	0x1D676: {true, "G"},
+	0x1D677: {true, "H"},
+	0x1D678: {true, "I"},
+	0x1D679: {true, "J"},
+	0x1D67A: {true, "K"},
+	0x1D67B: {true, "L"},
+	0x1D67C: {true, "M"},
+	0x1D67D: {true, "N"},
+	0x1D67E: {true, "O"},
+	0x1D67F: {true, "P"},
+	0x1D680: {true, "Q"},
+	0x1D681: {true, "R"},
+	0x1D682: {true, "S"},
+	0x1D683: {true, "T"},
+	0x1D684: {true, "U"},
+	0x1D685: {true, "V"},
+	0x1D686: {true, "W"},
+	0x1D687: {true, "X"},
+	0x1D688: {true, "Y"},
+	0x1D689: {true, "Z"},
+	0x1D68A: {true, "a"},
+	0x1D68B: {true, "b"},
+	0x1D68C: {true, "c"},
+	0x1D68D: {true, "d"},
+	0x1D68E: {true, "e"},
+	0x1D68F: {true, "f"},
+	0x1D690: {true, "g"},
+	0x1D691: {true, "h"},
+	0x1D692: {true, "i"},
+	0x1D693: {true, "j"},
+	0x1D694: {true, "k"},
+	0x1D695: {true, "l"},
+	0x1D696: {true, "m"},
+	0x1D697: {true, "n"},
+	0x1D698: {true, "o"},
+	0x1D699: {true, "p"},
+	0x1D69A: {true, "q"},
+	0x1D69B: {true, "r"},
+	0x1D69C: {true, "s"},
+	0x1D69D: {true, "t"},
+	0x1D69E: {true, "u"},
+	0x1D69F: {true, "v"},
+	0x1D6A0: {true, "w"},
+	0x1D6A1: {true, "x"},
+	0x1D6A2: {true, "y"},
+	0x1D6A3: {true, "z"},
+	0x1D6A4: {true, "\u0131"},
+	0x1D6A5: {true, "\u0237"},
+	0x1D6A8: {true, "\u0391"},
+	0x1D6A9: {true, "\u0392"},
+	0x1D6AA: {true, "\u0393"},
+	0x1D6AB: {true, "\u0394"},
+	0x1D6AC: {true, "\u0395"},
+	0x1D6AD: {true, "\u0396"},
+	0x1D6AE: {true, "\u0397"},
+	0x1D6AF: {true, "\u0398"},
+	0x1D6B0: {true, "\u0399"},
+	0x1D6B1: {true, "\u039a"},
+	0x1D6B2: {true, "\u039b"},
+	0x1D6B3: {true, "\u039c"},
+	0x1D6B4: {true, "\u039d"},
+	0x1D6B5: {true, "\u039e"},
+	0x1D6B6: {true, "\u039f"},
+	0x1D6B7: {true, "\u03a0"},
+	0x1D6B8: {true, "\u03a1"},
+	0x1D6B9: {true, "\u03f4"},
+	0x1D6BA: {true, "\u03a3"},
+	0x1D6BB: {true, "\u03a4"},
+	0x1D6BC: {true, "\u03a5"},
+	0x1D6BD: {true, "\u03a6"},
+	0x1D6BE: {true, "\u03a7"},
+	0x1D6BF: {true, "\u03a8"},
+	0x1D6C0: {true, "\u03a9"},
+	0x1D6C1: {true, "\u2207"},
+	0x1D6C2: {true, "\u03b1"},
+	0x1D6C3: {true, "\u03b2"},
+	0x1D6C4: {true, "\u03b3"},
+	0x1D6C5: {true, "\u03b4"},
+	0x1D6C6: {true, "\u03b5"},
+	0x1D6C7: {true, "\u03b6"},
+	0x1D6C8: {true, "\u03b7"},
+	0x1D6C9: {true, "\u03b8"},
+	0x1D6CA: {true, "\u03b9"},
+	0x1D6CB: {true, "\u03ba"},
+	0x1D6CC: {true, "\u03bb"},
+	0x1D6CD: {true, "\u03bc"},
+	0x1D6CE: {true, "\u03bd"},
+	0x1D6CF: {true, "\u03be"},
+	0x1D6D0: {true, "\u03bf"},
+	0x1D6D1: {true, "\u03c0"},
+	0x1D6D2: {true, "\u03c1"},
+	0x1D6D3: {true, "\u03c2"},
+	0x1D6D4: {true, "\u03c3"},
+	0x1D6D5: {true, "\u03c4"},
+	0x1D6D6: {true, "\u03c5"},
+	0x1D6D7: {true, "\u03c6"},
+	0x1D6D8: {true, "\u03c7"},
+	0x1D6D9: {true, "\u03c8"},
+	0x1D6DA: {true, "\u03c9"},
+	0x1D6DB: {true, "\u2202"},
+	0x1D6DC: {true, "\u03f5"},
+	0x1D6DD: {true, "\u03d1"},
+	0x1D6DE: {true, "\u03f0"},
+	0x1D6DF: {true, "\u03d5"},
+	0x1D6E0: {true, "\u03f1"},
+	0x1D6E1: {true, "\u03d6"},
+	0x1D6E2: {true, "\u0391"},
+	0x1D6E3: {true, "\u0392"},
+	0x1D6E4: {true, "\u0393"},
+	0x1D6E5: {true, "\u0394"},
+	0x1D6E6: {true, "\u0395"},
+	0x1D6E7: {true, "\u0396"},
+	0x1D6E8: {true, "\u0397"},
+	0x1D6E9: {true, "\u0398"},
+	0x1D6EA: {true, "\u0399"},
+	0x1D6EB: {true, "\u039a"},
+	0x1D6EC: {true, "\u039b"},
+	0x1D6ED: {true, "\u039c"},
+	0x1D6EE: {true, "\u039d"},
+	0x1D6EF: {true, "\u039e"},
+	0x1D6F0: {true, "\u039f"},
+	0x1D6F1: {true, "\u03a0"},
+	0x1D6F2: {true, "\u03a1"},
+	0x1D6F3: {true, "\u03f4"},
+	0x1D6F4: {true, "\u03a3"},
+	0x1D6F5: {true, "\u03a4"},
+	0x1D6F6: {true, "\u03a5"},
+	0x1D6F7: {true, "\u03a6"},
+	0x1D6F8: {true, "\u03a7"},
+	0x1D6F9: {true, "\u03a8"},
+	0x1D6FA: {true, "\u03a9"},
+	0x1D6FB: {true, "\u2207"},
+	0x1D6FC: {true, "\u03b1"},
+	0x1D6FD: {true, "\u03b2"},
+	0x1D6FE: {true, "\u03b3"},
+	0x1D6FF: {true, "\u03b4"},
+	0x1D700: {true, "\u03b5"},
+	0x1D701: {true, "\u03b6"},
+	0x1D702: {true, "\u03b7"},
+	0x1D703: {true, "\u03b8"},
+	0x1D704: {true, "\u03b9"},
+	0x1D705: {true, "\u03ba"},
+	0x1D706: {true, "\u03bb"},
+	0x1D707: {true, "\u03bc"},
+	0x1D708: {true, "\u03bd"},
+	0x1D709: {true, "\u03be"},
+	0x1D70A: {true, "\u03bf"},
+	0x1D70B: {true, "\u03c0"},
+	0x1D70C: {true, "\u03c1"},
+	0x1D70D: {true, "\u03c2"},
+	0x1D70E: {true, "\u03c3"},
+	0x1D70F: {true, "\u03c4"},
+	0x1D710: {true, "\u03c5"},
+	0x1D711: {true, "\u03c6"},
+	0x1D712: {true, "\u03c7"},
+	0x1D713: {true, "\u03c8"},
+	0x1D714: {true, "\u03c9"},
+	0x1D715: {true, "\u2202"},
+	0x1D716: {true, "\u03f5"},
+	0x1D717: {true, "\u03d1"},
+	0x1D718: {true, "\u03f0"},
+	0x1D719: {true, "\u03d5"},
+	0x1D71A: {true, "\u03f1"},
+	0x1D71B: {true, "\u03d6"},
+	0x1D71C: {true, "\u0391"},
+	0x1D71D: {true, "\u0392"},
+	0x1D71E: {true, "\u0393"},
+	0x1D71F: {true, "\u0394"},
+	0x1D720: {true, "\u0395"},
+	0x1D721: {true, "\u0396"},
+	0x1D722: {true, "\u0397"},
+	0x1D723: {true, "\u0398"},
+	0x1D724: {true, "\u0399"},
+	0x1D725: {true, "\u039a"},
+	0x1D726: {true, "\u039b"},
+	0x1D727: {true, "\u039c"},
+	0x1D728: {true, "\u039d"},
+	0x1D729: {true, "\u039e"},
+	0x1D72A: {true, "\u039f"},
+	0x1D72B: {true, "\u03a0"},
+	0x1D72C: {true, "\u03a1"},
+	0x1D72D: {true, "\u03f4"},
+	0x1D72E: {true, "\u03a3"},
+	0x1D72F: {true, "\u03a4"},
+	0x1D730: {true, "\u03a5"},
+	0x1D731: {true, "\u03a6"},
+	0x1D732: {true, "\u03a7"},
+	0x1D733: {true, "\u03a8"},
+	0x1D734: {true, "\u03a9"},
+	0x1D735: {true, "\u2207"},
+	0x1D736: {true, "\u03b1"},
+	0x1D737: {true, "\u03b2"},
+	0x1D738: {true, "\u03b3"},
+	0x1D739: {true, "\u03b4"},
+	0x1D73A: {true, "\u03b5"},
+	0x1D73B: {true, "\u03b6"},
+	0x1D73C: {true, "\u03b7"},
+	0x1D73D: {true, "\u03b8"},
+	0x1D73E: {true, "\u03b9"},
+	0x1D73F: {true, "\u03ba"},
+	0x1D740: {true, "\u03bb"},
+	0x1D741: {true, "\u03bc"},
+	0x1D742: {true, "\u03bd"},
+	0x1D743: {true, "\u03be"},
+	0x1D744: {true, "\u03bf"},
+	0x1D745: {true, "\u03c0"},
+	0x1D746: {true, "\u03c1"},
+	0x1D747: {true, "\u03c2"},
+	0x1D748: {true, "\u03c3"},
+	0x1D749: {true, "\u03c4"},
+	0x1D74A: {true, "\u03c5"},
+	0x1D74B: {true, "\u03c6"},
+	0x1D74C: {true, "\u03c7"},
+	0x1D74D: {true, "\u03c8"},
+	0x1D74E: {true, "\u03c9"},
+	0x1D74F: {true, "\u2202"},
+	0x1D750: {true, "\u03f5"},
+	0x1D751: {true, "\u03d1"},
+	0x1D752: {true, "\u03f0"},
+	0x1D753: {true, "\u03d5"},
+	0x1D754: {true, "\u03f1"},
+	0x1D755: {true, "\u03d6"},
+	0x1D756: {true, "\u0391"},
+	0x1D757: {true, "\u0392"},
+	0x1D758: {true, "\u0393"},
+	0x1D759: {true, "\u0394"},
+	0x1D75A: {true, "\u0395"},
+	0x1D75B: {true, "\u0396"},
+	0x1D75C: {true, "\u0397"},
+	0x1D75D: {true, "\u0398"},
+	0x1D75E: {true, "\u0399"},
+	0x1D75F: {true, "\u039a"},
+	0x1D760: {true, "\u039b"},
+	0x1D761: {true, "\u039c"},
+	0x1D762: {true, "\u039d"},
+	0x1D763: {true, "\u039e"},
+	0x1D764: {true, "\u039f"},
+	0x1D765: {true, "\u03a0"},
+	0x1D766: {true, "\u03a1"},
+	0x1D767: {true, "\u03f4"},
+	0x1D768: {true, "\u03a3"},
+	0x1D769: {true, "\u03a4"},
+	0x1D76A: {true, "\u03a5"},
+	0x1D76B: {true, "\u03a6"},
+	0x1D76C: {true, "\u03a7"},
+	0x1D76D: {true, "\u03a8"},
+	0x1D76E: {true, "\u03a9"},
+	0x1D76F: {true, "\u2207"},
+	0x1D770: {true, "\u03b1"},
+	0x1D771: {true, "\u03b2"},
+	0x1D772: {true, "\u03b3"},
+	0x1D773: {true, "\u03b4"},
+	0x1D774: {true, "\u03b5"},
+	0x1D775: {true, "\u03b6"},
+	0x1D776: {true, "\u03b7"},
+	0x1D777: {true, "\u03b8"},
+	0x1D778: {true, "\u03b9"},
+	0x1D779: {true, "\u03ba"},
+	0x1D77A: {true, "\u03bb"},
+	0x1D77B: {true, "\u03bc"},
+	0x1D77C: {true, "\u03bd"},
+	0x1D77D: {true, "\u03be"},
+	0x1D77E: {true, "\u03bf"},
+	0x1D77F: {true, "\u03c0"},
+	0x1D780: {true, "\u03c1"},
+	0x1D781: {true, "\u03c2"},
+	0x1D782: {true, "\u03c3"},
+	0x1D783: {true, "\u03c4"},
+	0x1D784: {true, "\u03c5"},
+	0x1D785: {true, "\u03c6"},
+	0x1D786: {true, "\u03c7"},
+	0x1D787: {true, "\u03c8"},
+	0x1D788: {true, "\u03c9"},
+	0x1D789: {true, "\u2202"},
+	0x1D78A: {true, "\u03f5"},
+	0x1D78B: {true, "\u03d1"},
+	0x1D78C: {true, "\u03f0"},
+	0x1D78D: {true, "\u03d5"},
+	0x1D78E: {true, "\u03f1"},
+	0x1D78F: {true, "\u03d6"},
+	0x1D790: {true, "\u0391"},
+	0x1D791: {true, "\u0392"},
+	0x1D792: {true, "\u0393"},
+	0x1D793: {true, "\u0394"},
+	0x1D794: {true, "\u0395"},
+	0x1D795: {true, "\u0396"},
+	0x1D796: {true, "\u0397"},
+	0x1D797: {true, "\u0398"},
+	0x1D798: {true, "\u0399"},
+	0x1D799: {true, "\u039a"},
+	0x1D79A: {true, "\u039b"},
+	0x1D79B: {true, "\u039c"},
+	0x1D79C: {true, "\u039d"},
+	0x1D79D: {true, "\u039e"},
+	0x1D79E: {true, "\u039f"},
+	0x1D79F: {true, "\u03a0"},
+	0x1D7A0: {true, "\u03a1"},
+	0x1D7A1: {true, "\u03f4"},
+	0x1D7A2: {true, "\u03a3"},
+	0x1D7A3: {true, "\u03a4"},
+	0x1D7A4: {true, "\u03a5"},
+	0x1D7A5: {true, "\u03a6"},
+	0x1D7A6: {true, "\u03a7"},
+	0x1D7A7: {true, "\u03a8"},
+	0x1D7A8: {true, "\u03a9"},
+	0x1D7A9: {true, "\u2207"},
+	0x1D7AA: {true, "\u03b1"},
+	0x1D7AB: {true, "\u03b2"},
+	0x1D7AC: {true, "\u03b3"},
+	0x1D7AD: {true, "\u03b4"},
+	0x1D7AE: {true, "\u03b5"},
+	0x1D7AF: {true, "\u03b6"},
+	0x1D7B0: {true, "\u03b7"},
+	0x1D7B1: {true, "\u03b8"},
+	0x1D7B2: {true, "\u03b9"},
+	0x1D7B3: {true, "\u03ba"},
+	0x1D7B4: {true, "\u03bb"},
+	0x1D7B5: {true, "\u03bc"},
+	0x1D7B6: {true, "\u03bd"},
+	0x1D7B7: {true, "\u03be"},
+	0x1D7B8: {true, "\u03bf"},
+	0x1D7B9: {true, "\u03c0"},
+	0x1D7BA: {true, "\u03c1"},
+	0x1D7BB: {true, "\u03c2"},
+	0x1D7BC: {true, "\u03c3"},
+	0x1D7BD: {true, "\u03c4"},
+	0x1D7BE: {true, "\u03c5"},
+	0x1D7BF: {true, "\u03c6"},
+	0x1D7C0: {true, "\u03c7"},
+	0x1D7C1: {true, "\u03c8"},
+	0x1D7C2: {true, "\u03c9"},
+	0x1D7C3: {true, "\u2202"},
+	0x1D7C4: {true, "\u03f5"},
+	0x1D7C5: {true, "\u03d1"},
+	0x1D7C6: {true, "\u03f0"},
+	0x1D7C7: {true, "\u03d5"},
+	0x1D7C8: {true, "\u03f1"},
+	0x1D7C9: {true, "\u03d6"},
+	0x1D7CA: {true, "\u03dc"},
+	0x1D7CB: {true, "\u03dd"},
+	0x1D7CE: {true, "0"},
+	0x1D7CF: {true, "1"},
+	0x1D7D0: {true, "2"},
+	0x1D7D1: {true, "3"},
+	0x1D7D2: {true, "4"},
+	0x1D7D3: {true, "5"},
+	0x1D7D4: {true, "6"},
+	0x1D7D5: {true, "7"},
+	0x1D7D6: {true, "8"},
+	0x1D7D7: {true, "9"},
+	0x1D7D8: {true, "0"},
+	0x1D7D9: {true, "1"},
+	0x1D7DA: {true, "2"},
+	0x1D7DB: {true, "3"},
+	0x1D7DC: {true, "4"},
+	0x1D7DD: {true, "5"},
+	0x1D7DE: {true, "6"},
+	0x1D7DF: {true, "7"},
+	0x1D7E0: {true, "8"},
+	0x1D7E1: {true, "9"},
+	0x1D7E2: {true, "0"},
+	0x1D7E3: {true, "1"},
+	0x1D7E4: {true, "2"},
+	0x1D7E5: {true, "3"},
+	0x1D7E6: {true, "4"},
+	0x1D7E7: {true, "5"},
+	0x1D7E8: {true, "6"},
+	0x1D7E9: {true, "7"},
+	0x1D7EA: {true, "8"},
+	0x1D7EB: {true, "9"},
+	0x1D7EC: {true, "0"},
+	0x1D7ED: {true, "1"},
+	0x1D7EE: {true, "2"},
+	0x1D7EF: {true, "3"},
+	0x1D7F0: {true, "4"},
+	0x1D7F1: {true, "5"},
+	0x1D7F2: {true, "6"},
+	0x1D7F3: {true, "7"},
+	0x1D7F4: {true, "8"},
+	0x1D7F5: {true, "9"},
+	0x1D7F6: {true, "0"},
+	0x1D7F7: {true, "1"},
+	0x1D7F8: {true, "2"},
+	0x1D7F9: {true, "3"},
+	0x1D7FA: {true, "4"},
+	0x1D7FB: {true, "5"},
+	0x1D7FC: {true, "6"},
+	0x1D7FD: {true, "7"},
+	0x1D7FE: {true, "8"},
+	0x1D7FF: {true, "9"},
+	0x1EE00: {true, "\u0627"},
+	0x1EE01: {true, "\u0628"},
+	0x1EE02: {true, "\u062c"},
+	0x1EE03: {true, "\u062f"},
+	0x1EE05: {true, "\u0648"},
+	0x1EE06: {true, "\u0632"},
+	0x1EE07: {true, "\u062d"},
+	0x1EE08: {true, "\u0637"},
+	0x1EE09: {true, "\u064a"},
+	0x1EE0A: {true, "\u0643"},
+	0x1EE0B: {true, "\u0644"},
+	0x1EE0C: {true, "\u0645"},
+	0x1EE0D: {true, "\u0646"},
+	0x1EE0E: {true, "\u0633"},
+	0x1EE0F: {true, "\u0639"},
+	0x1EE10: {true, "\u0641"},
+	0x1EE11: {true, "\u0635"},
+	0x1EE12: {true, "\u0642"},
+	0x1EE13: {true, "\u0631"},
+	0x1EE14: {true, "\u0634"},
+	0x1EE15: {true, "\u062a"},
+	0x1EE16: {true, "\u062b"},
+	0x1EE17: {true, "\u062e"},
+	0x1EE18: {true, "\u0630"},
+	0x1EE19: {true, "\u0636"},
+	0x1EE1A: {true, "\u0638"},
+	0x1EE1B: {true, "\u063a"},
+	0x1EE1C: {true, "\u066e"},
+	0x1EE1D: {true, "\u06ba"},
+	0x1EE1E: {true, "\u06a1"},
+	0x1EE1F: {true, "\u066f"},
+	0x1EE21: {true, "\u0628"},
+	0x1EE22: {true, "\u062c"},
+	0x1EE24: {true, "\u0647"},
+	0x1EE27: {true, "\u062d"},
+	0x1EE29: {true, "\u064a"},
+	0x1EE2A: {true, "\u0643"},
+	0x1EE2B: {true, "\u0644"},
+	0x1EE2C: {true, "\u0645"},
+	0x1EE2D: {true, "\u0646"},
+	0x1EE2E: {true, "\u0633"},
+	0x1EE2F: {true, "\u0639"},
+	0x1EE30: {true, "\u0641"},
+	0x1EE31: {true, "\u0635"},
+	0x1EE32: {true, "\u0642"},
+	0x1EE34: {true, "\u0634"},
+	0x1EE35: {true, "\u062a"},
+	0x1EE36: {true, "\u062b"},
+	0x1EE37: {true, "\u062e"},
+	0x1EE39: {true, "\u0636"},
+	0x1EE3B: {true, "\u063a"},
+	0x1EE42: {true, "\u062c"},
+	0x1EE47: {true, "\u062d"},
+	0x1EE49: {true, "\u064a"},
+	0x1EE4B: {true, "\u0644"},
+	0x1EE4D: {true, "\u0646"},
+	0x1EE4E: {true, "\u0633"},
+	0x1EE4F: {true, "\u0639"},
+	0x1EE51: {true, "\u0635"},
+	0x1EE52: {true, "\u0642"},
+	0x1EE54: {true, "\u0634"},
+	0x1EE57: {true, "\u062e"},
+	0x1EE59: {true, "\u0636"},
+	0x1EE5B: {true, "\u063a"},
+	0x1EE5D: {true, "\u06ba"},
+	0x1EE5F: {true, "\u066f"},
+	0x1EE61: {true, "\u0628"},
+	0x1EE62: {true, "\u062c"},
+	0x1EE64: {true, "\u0647"},
+	0x1EE67: {true, "\u062d"},
+	0x1EE68: {true, "\u0637"},
+	0x1EE69: {true, "\u064a"},
+	0x1EE6A: {true, "\u0643"},
+	0x1EE6C: {true, "\u0645"},
+	0x1EE6D: {true, "\u0646"},
+	0x1EE6E: {true, "\u0633"},
+	0x1EE6F: {true, "\u0639"},
+	0x1EE70: {true, "\u0641"},
+	0x1EE71: {true, "\u0635"},
+	0x1EE72: {true, "\u0642"},
+	0x1EE74: {true, "\u0634"},
+	0x1EE75: {true, "\u062a"},
+	0x1EE76: {true, "\u062b"},
+	0x1EE77: {true, "\u062e"},
+	0x1EE79: {true, "\u0636"},
+	0x1EE7A: {true, "\u0638"},
+	0x1EE7B: {true, "\u063a"},
+	0x1EE7C: {true, "\u066e"},
+	0x1EE7E: {true, "\u06a1"},
+	0x1EE80: {true, "\u0627"},
+	0x1EE81: {true, "\u0628"},
+	0x1EE82: {true, "\u062c"},
+	0x1EE83: {true, "\u062f"},
+	0x1EE84: {true, "\u0647"},
+	0x1EE85: {true, "\u0648"},
+	0x1EE86: {true, "\u0632"},
+	0x1EE87: {true, "\u062d"},
+	0x1EE88: {true, "\u0637"},
+	0x1EE89: {true, "\u064a"},
+	0x1EE8B: {true, "\u0644"},
+	0x1EE8C: {true, "\u0645"},
+	0x1EE8D: {true, "\u0646"},
+	0x1EE8E: {true, "\u0633"},
+	0x1EE8F: {true, "\u0639"},
+	0x1EE90: {true, "\u0641"},
+	0x1EE91: {true, "\u0635"},
+	0x1EE92: {true, "\u0642"},
+	0x1EE93: {true, "\u0631"},
+	0x1EE94: {true, "\u0634"},
+	0x1EE95: {true, "\u062a"},
+	0x1EE96: {true, "\u062b"},
+	0x1EE97: {true, "\u062e"},
+	0x1EE98: {true, "\u0630"},
+	0x1EE99: {true, "\u0636"},
+	0x1EE9A: {true, "\u0638"},
+	0x1EE9B: {true, "\u063a"},
+	0x1EEA1: {true, "\u0628"},
+	0x1EEA2: {true, "\u062c"},
+	0x1EEA3: {true, "\u062f"},
+	0x1EEA5: {true, "\u0648"},
+	0x1EEA6: {true, "\u0632"},
+	0x1EEA7: {true, "\u062d"},
+	0x1EEA8: {true, "\u0637"},
+	0x1EEA9: {true, "\u064a"},
+	0x1EEAB: {true, "\u0644"},
+	0x1EEAC: {true, "\u0645"},
+	0x1EEAD: {true, "\u0646"},
+	0x1EEAE: {true, "\u0633"},
+	0x1EEAF: {true, "\u0639"},
+	0x1EEB0: {true, "\u0641"},
+	0x1EEB1: {true, "\u0635"},
+	0x1EEB2: {true, "\u0642"},
+	0x1EEB3: {true, "\u0631"},
+	0x1EEB4: {true, "\u0634"},
+	0x1EEB5: {true, "\u062a"},
+	0x1EEB6: {true, "\u062b"},
+	0x1EEB7: {true, "\u062e"},
+	0x1EEB8: {true, "\u0630"},
+	0x1EEB9: {true, "\u0636"},
+	0x1EEBA: {true, "\u0638"},
+	0x1EEBB: {true, "\u063a"},
+	0x1F100: {true, "0."},
+	0x1F101: {true, "0,"},
+	0x1F102: {true, "1,"},
+	0x1F103: {true, "2,"},
+	0x1F104: {true, "3,"},
+	0x1F105: {true, "4,"},
+	0x1F106: {true, "5,"},
+	0x1F107: {true, "6,"},
+	0x1F108: {true, "7,"},
+	0x1F109: {true, "8,"},
+	0x1F10A: {true, "9,"},
+	0x1F110: {true, "(A)"},
+	0x1F111: {true, "(B)"},
+	0x1F112: {true, "(C)"},
+	0x1F113: {true, "(D)"},
+	0x1F114: {true, "(E)"},
+	0x1F115: {true, "(F)"},
+	0x1F116: {true, "(G)"},
+	0x1F117: {true, "(H)"},
+	0x1F118: {true, "(I)"},
+	0x1F119: {true, "(J)"},
+	0x1F11A: {true, "(K)"},
+	0x1F11B: {true, "(L)"},
+	0x1F11C: {true, "(M)"},
+	0x1F11D: {true, "(N)"},
+	0x1F11E: {true, "(O)"},
+	0x1F11F: {true, "(P)"},
+	0x1F120: {true, "(Q)"},
+	0x1F121: {true, "(R)"},
+	0x1F122: {true, "(S)"},
+	0x1F123: {true, "(T)"},
+	0x1F124: {true, "(U)"},
+	0x1F125: {true, "(V)"},
+	0x1F126: {true, "(W)"},
+	0x1F127: {true, "(X)"},
+	0x1F128: {true, "(Y)"},
+	0x1F129: {true, "(Z)"},
+	0x1F12A: {true, "\u3014S\u3015"},
+	0x1F12B: {true, "C"},
+	0x1F12C: {true, "R"},
+	0x1F12D: {true, "CD"},
+	0x1F12E: {true, "WZ"},
+	0x1F130: {true, "A"},
+	0x1F131: {true, "B"},
+	0x1F132: {true, "C"},
+	0x1F133: {true, "D"},
+	0x1F134: {true, "E"},
+	0x1F135: {true, "F"},
+	0x1F136: {true, "G"},
+	0x1F137: {true, "H"},
+	0x1F138: {true, "I"},
+	0x1F139: {true, "J"},
+	0x1F13A: {true, "K"},
+	0x1F13B: {true, "L"},
+	0x1F13C: {true, "M"},
+	0x1F13D: {true, "N"},
+	0x1F13E: {true, "O"},
+	0x1F13F: {true, "P"},
+	0x1F140: {true, "Q"},
+	0x1F141: {true, "R"},
+	0x1F142: {true, "S"},
+	0x1F143: {true, "T"},
+	0x1F144: {true, "U"},
+	0x1F145: {true, "V"},
+	0x1F146: {true, "W"},
+	0x1F147: {true, "X"},
+	0x1F148: {true, "Y"},
+	0x1F149: {true, "Z"},
+	0x1F14A: {true, "HV"},
+	0x1F14B: {true, "MV"},
+	0x1F14C: {true, "SD"},
+	0x1F14D: {true, "SS"},
+	0x1F14E: {true, "PPV"},
+	0x1F14F: {true, "WC"},
+	0x1F16A: {true, "MC"},
+	0x1F16B: {true, "MD"},
+	0x1F16C: {true, "MR"},
+	0x1F190: {true, "DJ"},
+	0x1F200: {true, "\u307b\u304b"},
+	0x1F201: {true, "\u30b3\u30b3"},
+	0x1F202: {true, "\u30b5"},
+	0x1F210: {true, "\u624b"},
+	0x1F211: {true, "\u5b57"},
+	0x1F212: {true, "\u53cc"},
+	0x1F213: {true, "\u30c7"},
+	0x1F214: {true, "\u4e8c"},
+	0x1F215: {true, "\u591a"},
+	0x1F216: {true, "\u89e3"},
+	0x1F217: {true, "\u5929"},
+	0x1F218: {true, "\u4ea4"},
+	0x1F219: {true, "\u6620"},
+	0x1F21A: {true, "\u7121"},
+	0x1F21B: {true, "\u6599"},
+	0x1F21C: {true, "\u524d"},
+	0x1F21D: {true, "\u5f8c"},
+	0x1F21E: {true, "\u518d"},
+	0x1F21F: {true, "\u65b0"},
+	0x1F220: {true, "\u521d"},
+	0x1F221: {true, "\u7d42"},
+	0x1F222: {true, "\u751f"},
+	0x1F223: {true, "\u8ca9"},
+	0x1F224: {true, "\u58f0"},
+	0x1F225: {true, "\u5439"},
+	0x1F226: {true, "\u6f14"},
+	0x1F227: {true, "\u6295"},
+	0x1F228: {true, "\u6355"},
+	0x1F229: {true, "\u4e00"},
+	0x1F22A: {true, "\u4e09"},
+	0x1F22B: {true, "\u904a"},
+	0x1F22C: {true, "\u5de6"},
+	0x1F22D: {true, "\u4e2d"},
+	0x1F22E: {true, "\u53f3"},
+	0x1F22F: {true, "\u6307"},
+	0x1F230: {true, "\u8d70"},
+	0x1F231: {true, "\u6253"},
+	0x1F232: {true, "\u7981"},
+	0x1F233: {true, "\u7a7a"},
+	0x1F234: {true, "\u5408"},
+	0x1F235: {true, "\u6e80"},
+	0x1F236: {true, "\u6709"},
+	0x1F237: {true, "\u6708"},
+	0x1F238: {true, "\u7533"},
+	0x1F239: {true, "\u5272"},
+	0x1F23A: {true, "\u55b6"},
+	0x1F23B: {true, "\u914d"},
+	0x1F240: {true, "\u3014\u672c\u3015"},
+	0x1F241: {true, "\u3014\u4e09\u3015"},
+	0x1F242: {true, "\u3014\u4e8c\u3015"},
+	0x1F243: {true, "\u3014\u5b89\u3015"},
+	0x1F244: {true, "\u3014\u70b9\u3015"},
+	0x1F245: {true, "\u3014\u6253\u3015"},
+	0x1F246: {true, "\u3014\u76d7\u3015"},
+	0x1F247: {true, "\u3014\u52dd\u3015"},
+	0x1F248: {true, "\u3014\u6557\u3015"},
+	0x1F250: {true, "\u5f97"},
+	0x1F251: {true, "\u53ef"},
+	0x1FBF0: {true, "0"},
+	0x1FBF1: {true, "1"},
+	0x1FBF2: {true, "2"},
+	0x1FBF3: {true, "3"},
+	0x1FBF4: {true, "4"},
+	0x1FBF5: {true, "5"},
+	0x1FBF6: {true, "6"},
+	0x1FBF7: {true, "7"},
+	0x1FBF8: {true, "8"},
+	0x1FBF9: {true, "9"},
+	0x2F800: {false, "\u4e3d"},
+	0x2F801: {false, "\u4e38"},
+	0x2F802: {false, "\u4e41"},
+	0x2F803: {false, "\U00020122"},
+	0x2F804: {false, "\u4f60"},
+	0x2F805: {false, "\u4fae"},
+	0x2F806: {false, "\u4fbb"},
+	0x2F807: {false, "\u5002"},
+	0x2F808: {false, "\u507a"},
+	0x2F809: {false, "\u5099"},
+	0x2F80A: {false, "\u50e7"},
+	0x2F80B: {false, "\u50cf"},
+	0x2F80C: {false, "\u349e"},
+	0x2F80D: {false, "\U0002063a"},
+	0x2F80E: {false, "\u514d"},
+	0x2F80F: {false, "\u5154"},
+	0x2F810: {false, "\u5164"},
+	0x2F811: {false, "\u5177"},
+	0x2F812: {false, "\U0002051c"},
+	0x2F813: {false, "\u34b9"},
+	0x2F814: {false, "\u5167"},
+	0x2F815: {false, "\u518d"},
+	0x2F816: {false, "\U0002054b"},
+	0x2F817: {false, "\u5197"},
+	0x2F818: {false, "\u51a4"},
+	0x2F819: {false, "\u4ecc"},
+	0x2F81A: {false, "\u51ac"},
+	0x2F81B: {false, "\u51b5"},
+	0x2F81C: {false, "\U000291df"},
+	0x2F81D: {false, "\u51f5"},
+	0x2F81E: {false, "\u5203"},
+	0x2F81F: {false, "\u34df"},
+	0x2F820: {false, "\u523b"},
+	0x2F821: {false, "\u5246"},
+	0x2F822: {false, "\u5272"},
+	0x2F823: {false, "\u5277"},
+	0x2F824: {false, "\u3515"},
+	0x2F825: {false, "\u52c7"},
+	0x2F826: {false, "\u52c9"},
+	0x2F827: {false, "\u52e4"},
+	0x2F828: {false, "\u52fa"},
+	0x2F829: {false, "\u5305"},
+	0x2F82A: {false, "\u5306"},
+	0x2F82B: {false, "\u5317"},
+	0x2F82C: {false, "\u5349"},
+	0x2F82D: {false, "\u5351"},
+	0x2F82E: {false, "\u535a"},
+	0x2F82F: {false, "\u5373"},
+	0x2F830: {false, "\u537d"},
+	0x2F831: {false, "\u537f"},
+	0x2F832: {false, "\u537f"},
+	0x2F833: {false, "\u537f"},
+	0x2F834: {false, "\U00020a2c"},
+	0x2F835: {false, "\u7070"},
+	0x2F836: {false, "\u53ca"},
+	0x2F837: {false, "\u53df"},
+	0x2F838: {false, "\U00020b63"},
+	0x2F839: {false, "\u53eb"},
+	0x2F83A: {false, "\u53f1"},
+	0x2F83B: {false, "\u5406"},
+	0x2F83C: {false, "\u549e"},
+	0x2F83D: {false, "\u5438"},
+	0x2F83E: {false, "\u5448"},
+	0x2F83F: {false, "\u5468"},
+	0x2F840: {false, "\u54a2"},
+	0x2F841: {false, "\u54f6"},
+	0x2F842: {false, "\u5510"},
+	0x2F843: {false, "\u5553"},
+	0x2F844: {false, "\u5563"},
+	0x2F845: {false, "\u5584"},
+	0x2F846: {false, "\u5584"},
+	0x2F847: {false, "\u5599"},
+	0x2F848: {false, "\u55ab"},
+	0x2F849: {false, "\u55b3"},
+	0x2F84A: {false, "\u55c2"},
+	0x2F84B: {false, "\u5716"},
+	0x2F84C: {false, "\u5606"},
+	0x2F84D: {false, "\u5717"},
+	0x2F84E: {false, "\u5651"},
+	0x2F84F: {false, "\u5674"},
+	0x2F850: {false, "\u5207"},
+	0x2F851: {false, "\u58ee"},
+	0x2F852: {false, "\u57ce"},
+	0x2F853: {false, "\u57f4"},
+	0x2F854: {false, "\u580d"},
+	0x2F855: {false, "\u578b"},
+	0x2F856: {false, "\u5832"},
+	0x2F857: {false, "\u5831"},
+	0x2F858: {false, "\u58ac"},
+	0x2F859: {false, "\U000214e4"},
+	0x2F85A: {false, "\u58f2"},
+	0x2F85B: {false, "\u58f7"},
+	0x2F85C: {false, "\u5906"},
+	0x2F85D: {false, "\u591a"},
+	0x2F85E: {false, "\u5922"},
+	0x2F85F: {false, "\u5962"},
+	0x2F860: {false, "\U000216a8"},
+	0x2F861: {false, "\U000216ea"},
+	0x2F862: {false, "\u59ec"},
+	0x2F863: {false, "\u5a1b"},
+	0x2F864: {false, "\u5a27"},
+	0x2F865: {false, "\u59d8"},
+	0x2F866: {false, "\u5a66"},
+	0x2F867: {false, "\u36ee"},
+	0x2F868: {false, "\u36fc"},
+	0x2F869: {false, "\u5b08"},
+	0x2F86A: {false, "\u5b3e"},
+	0x2F86B: {false, "\u5b3e"},
+	0x2F86C: {false, "\U000219c8"},
+	0x2F86D: {false, "\u5bc3"},
+	0x2F86E: {false, "\u5bd8"},
+	0x2F86F: {false, "\u5be7"},
+	0x2F870: {false, "\u5bf3"},
+	0x2F871: {false, "\U00021b18"},
+	0x2F872: {false, "\u5bff"},
+	0x2F873: {false, "\u5c06"},
+	0x2F874: {false, "\u5f53"},
+	0x2F875: {false, "\u5c22"},
+	0x2F876: {false, "\u3781"},
+	0x2F877: {false, "\u5c60"},
+	0x2F878: {false, "\u5c6e"},
+	0x2F879: {false, "\u5cc0"},
+	0x2F87A: {false, "\u5c8d"},
+	0x2F87B: {false, "\U00021de4"},
+	0x2F87C: {false, "\u5d43"},
+	0x2F87D: {false, "\U00021de6"},
+	0x2F87E: {false, "\u5d6e"},
+	0x2F87F: {false, "\u5d6b"},
+	0x2F880: {false, "\u5d7c"},
+	0x2F881: {false, "\u5de1"},
+	0x2F882: {false, "\u5de2"},
+	0x2F883: {false, "\u382f"},
+	0x2F884: {false, "\u5dfd"},
+	0x2F885: {false, "\u5e28"},
+	0x2F886: {false, "\u5e3d"},
+	0x2F887: {false, "\u5e69"},
+	0x2F888: {false, "\u3862"},
+	0x2F889: {false, "\U00022183"},
+	0x2F88A: {false, "\u387c"},
+	0x2F88B: {false, "\u5eb0"},
+	0x2F88C: {false, "\u5eb3"},
+	0x2F88D: {false, "\u5eb6"},
+	0x2F88E: {false, "\u5eca"},
+	0x2F88F: {false, "\U0002a392"},
+	0x2F890: {false, "\u5efe"},
+	0x2F891: {false, "\U00022331"},
+	0x2F892: {false, "\U00022331"},
+	0x2F893: {false, "\u8201"},
+	0x2F894: {false, "\u5f22"},
+	0x2F895: {false, "\u5f22"},
+	0x2F896: {false, "\u38c7"},
+	0x2F897: {false, "\U000232b8"},
+	0x2F898: {false, "\U000261da"},
+	0x2F899: {false, "\u5f62"},
+	0x2F89A: {false, "\u5f6b"},
+	0x2F89B: {false, "\u38e3"},
+	0x2F89C: {false, "\u5f9a"},
+	0x2F89D: {false, "\u5fcd"},
+	0x2F89E: {false, "\u5fd7"},
+	0x2F89F: {false, "\u5ff9"},
+	0x2F8A0: {false, "\u6081"},
+	0x2F8A1: {false, "\u393a"},
+	0x2F8A2: {false, "\u391c"},
+	0x2F8A3: {false, "\u6094"},
+	0x2F8A4: {false, "\U000226d4"},
+	0x2F8A5: {false, "\u60c7"},
+	0x2F8A6: {false, "\u6148"},
+	0x2F8A7: {false, "\u614c"},
+	0x2F8A8: {false, "\u614e"},
+	0x2F8A9: {false, "\u614c"},
+	0x2F8AA: {false, "\u617a"},
+	0x2F8AB: {false, "\u618e"},
+	0x2F8AC: {false, "\u61b2"},
+	0x2F8AD: {false, "\u61a4"},
+	0x2F8AE: {false, "\u61af"},
+	0x2F8AF: {false, "\u61de"},
+	0x2F8B0: {false, "\u61f2"},
+	0x2F8B1: {false, "\u61f6"},
+	0x2F8B2: {false, "\u6210"},
+	0x2F8B3: {false, "\u621b"},
+	0x2F8B4: {false, "\u625d"},
+	0x2F8B5: {false, "\u62b1"},
+	0x2F8B6: {false, "\u62d4"},
+	0x2F8B7: {false, "\u6350"},
+	0x2F8B8: {false, "\U00022b0c"},
+	0x2F8B9: {false, "\u633d"},
+	0x2F8BA: {false, "\u62fc"},
+	0x2F8BB: {false, "\u6368"},
+	0x2F8BC: {false, "\u6383"},
+	0x2F8BD: {false, "\u63e4"},
+	0x2F8BE: {false, "\U00022bf1"},
+	0x2F8BF: {false, "\u6422"},
+	0x2F8C0: {false, "\u63c5"},
+	0x2F8C1: {false, "\u63a9"},
+	0x2F8C2: {false, "\u3a2e"},
+	0x2F8C3: {false, "\u6469"},
+	0x2F8C4: {false, "\u647e"},
+	0x2F8C5: {false, "\u649d"},
+	0x2F8C6: {false, "\u6477"},
+	0x2F8C7: {false, "\u3a6c"},
+	0x2F8C8: {false, "\u654f"},
+	0x2F8C9: {false, "\u656c"},
+	0x2F8CA: {false, "\U0002300a"},
+	0x2F8CB: {false, "\u65e3"},
+	0x2F8CC: {false, "\u66f8"},
+	0x2F8CD: {false, "\u6649"},
+	0x2F8CE: {false, "\u3b19"},
+	0x2F8CF: {false, "\u6691"},
+	0x2F8D0: {false, "\u3b08"},
+	0x2F8D1: {false, "\u3ae4"},
+	0x2F8D2: {false, "\u5192"},
+	0x2F8D3: {false, "\u5195"},
+	0x2F8D4: {false, "\u6700"},
+	0x2F8D5: {false, "\u669c"},
+	0x2F8D6: {false, "\u80ad"},
+	0x2F8D7: {false, "\u43d9"},
+	0x2F8D8: {false, "\u6717"},
+	0x2F8D9: {false, "\u671b"},
+	0x2F8DA: {false, "\u6721"},
+	0x2F8DB: {false, "\u675e"},
+	0x2F8DC: {false, "\u6753"},
+	0x2F8DD: {false, "\U000233c3"},
+	0x2F8DE: {false, "\u3b49"},
+	0x2F8DF: {false, "\u67fa"},
+	0x2F8E0: {false, "\u6785"},
+	0x2F8E1: {false, "\u6852"},
+	0x2F8E2: {false, "\u6885"},
+	0x2F8E3: {false, "\U0002346d"},
+	0x2F8E4: {false, "\u688e"},
+	0x2F8E5: {false, "\u681f"},
+	0x2F8E6: {false, "\u6914"},
+	0x2F8E7: {false, "\u3b9d"},
+	0x2F8E8: {false, "\u6942"},
+	0x2F8E9: {false, "\u69a3"},
+	0x2F8EA: {false, "\u69ea"},
+	0x2F8EB: {false, "\u6aa8"},
+	0x2F8EC: {false, "\U000236a3"},
+	0x2F8ED: {false, "\u6adb"},
+	0x2F8EE: {false, "\u3c18"},
+	0x2F8EF: {false, "\u6b21"},
+	0x2F8F0: {false, "\U000238a7"},
+	0x2F8F1: {false, "\u6b54"},
+	0x2F8F2: {false, "\u3c4e"},
+	0x2F8F3: {false, "\u6b72"},
+	0x2F8F4: {false, "\u6b9f"},
+	0x2F8F5: {false, "\u6bba"},
+	0x2F8F6: {false, "\u6bbb"},
+	0x2F8F7: {false, "\U00023a8d"},
+	0x2F8F8: {false, "\U00021d0b"},
+	0x2F8F9: {false, "\U00023afa"},
+	0x2F8FA: {false, "\u6c4e"},
+	0x2F8FB: {false, "\U00023cbc"},
+	0x2F8FC: {false, "\u6cbf"},
+	0x2F8FD: {false, "\u6ccd"},
+	0x2F8FE: {false, "\u6c67"},
+	0x2F8FF: {false, "\u6d16"},
+	0x2F900: {false, "\u6d3e"},
+	0x2F901: {false, "\u6d77"},
+	0x2F902: {false, "\u6d41"},
+	0x2F903: {false, "\u6d69"},
+	0x2F904: {false, "\u6d78"},
+	0x2F905: {false, "\u6d85"},
+	0x2F906: {false, "\U00023d1e"},
+	0x2F907: {false, "\u6d34"},
+	0x2F908: {false, "\u6e2f"},
+	0x2F909: {false, "\u6e6e"},
+	0x2F90A: {false, "\u3d33"},
+	0x2F90B: {false, "\u6ecb"},
+	0x2F90C: {false, "\u6ec7"},
+	0x2F90D: {false, "\U00023ed1"},
+	0x2F90E: {false, "\u6df9"},
+	0x2F90F: {false, "\u6f6e"},
+	0x2F910: {false, "\U00023f5e"},
+	0x2F911: {false, "\U00023f8e"},
+	0x2F912: {false, "\u6fc6"},
+	0x2F913: {false, "\u7039"},
+	0x2F914: {false, "\u701e"},
+	0x2F915: {false, "\u701b"},
+	0x2F916: {false, "\u3d96"},
+	0x2F917: {false, "\u704a"},
+	0x2F918: {false, "\u707d"},
+	0x2F919: {false, "\u7077"},
+	0x2F91A: {false, "\u70ad"},
+	0x2F91B: {false, "\U00020525"},
+	0x2F91C: {false, "\u7145"},
+	0x2F91D: {false, "\U00024263"},
+	0x2F91E: {false, "\u719c"},
+	0x2F91F: {false, "\U000243ab"},
+	0x2F920: {false, "\u7228"},
+	0x2F921: {false, "\u7235"},
+	0x2F922: {false, "\u7250"},
+	0x2F923: {false, "\U00024608"},
+	0x2F924: {false, "\u7280"},
+	0x2F925: {false, "\u7295"},
+	0x2F926: {false, "\U00024735"},
+	0x2F927: {false, "\U00024814"},
+	0x2F928: {false, "\u737a"},
+	0x2F929: {false, "\u738b"},
+	0x2F92A: {false, "\u3eac"},
+	0x2F92B: {false, "\u73a5"},
+	0x2F92C: {false, "\u3eb8"},
+	0x2F92D: {false, "\u3eb8"},
+	0x2F92E: {false, "\u7447"},
+	0x2F92F: {false, "\u745c"},
+	0x2F930: {false, "\u7471"},
+	0x2F931: {false, "\u7485"},
+	0x2F932: {false, "\u74ca"},
+	0x2F933: {false, "\u3f1b"},
+	0x2F934: {false, "\u7524"},
+	0x2F935: {false, "\U00024c36"},
+	0x2F936: {false, "\u753e"},
+	0x2F937: {false, "\U00024c92"},
+	0x2F938: {false, "\u7570"},
+	0x2F939: {false, "\U0002219f"},
+	0x2F93A: {false, "\u7610"},
+	0x2F93B: {false, "\U00024fa1"},
+	0x2F93C: {false, "\U00024fb8"},
+	0x2F93D: {false, "\U00025044"},
+	0x2F93E: {false, "\u3ffc"},
+	0x2F93F: {false, "\u4008"},
+	0x2F940: {false, "\u76f4"},
+	0x2F941: {false, "\U000250f3"},
+	0x2F942: {false, "\U000250f2"},
+	0x2F943: {false, "\U00025119"},
+	0x2F944: {false, "\U00025133"},
+	0x2F945: {false, "\u771e"},
+	0x2F946: {false, "\u771f"},
+	0x2F947: {false, "\u771f"},
+	0x2F948: {false, "\u774a"},
+	0x2F949: {false, "\u4039"},
+	0x2F94A: {false, "\u778b"},
+	0x2F94B: {false, "\u4046"},
+	0x2F94C: {false, "\u4096"},
+	0x2F94D: {false, "\U0002541d"},
+	0x2F94E: {false, "\u784e"},
+	0x2F94F: {false, "\u788c"},
+	0x2F950: {false, "\u78cc"},
+	0x2F951: {false, "\u40e3"},
+	0x2F952: {false, "\U00025626"},
+	0x2F953: {false, "\u7956"},
+	0x2F954: {false, "\U0002569a"},
+	0x2F955: {false, "\U000256c5"},
+	0x2F956: {false, "\u798f"},
+	0x2F957: {false, "\u79eb"},
+	0x2F958: {false, "\u412f"},
+	0x2F959: {false, "\u7a40"},
+	0x2F95A: {false, "\u7a4a"},
+	0x2F95B: {false, "\u7a4f"},
+	0x2F95C: {false, "\U0002597c"},
+	0x2F95D: {false, "\U00025aa7"},
+	0x2F95E: {false, "\U00025aa7"},
+	0x2F95F: {false, "\u7aee"},
+	0x2F960: {false, "\u4202"},
+	0x2F961: {false, "\U00025bab"},
+	0x2F962: {false, "\u7bc6"},
+	0x2F963: {false, "\u7bc9"},
+	0x2F964: {false, "\u4227"},
+	0x2F965: {false, "\U00025c80"},
+	0x2F966: {false, "\u7cd2"},
+	0x2F967: {false, "\u42a0"},
+	0x2F968: {false, "\u7ce8"},
+	0x2F969: {false, "\u7ce3"},
+	0x2F96A: {false, "\u7d00"},
+	0x2F96B: {false, "\U00025f86"},
+	0x2F96C: {false, "\u7d63"},
+	0x2F96D: {false, "\u4301"},
+	0x2F96E: {false, "\u7dc7"},
+	0x2F96F: {false, "\u7e02"},
+	0x2F970: {false, "\u7e45"},
+	0x2F971: {false, "\u4334"},
+	0x2F972: {false, "\U00026228"},
+	0x2F973: {false, "\U00026247"},
+	0x2F974: {false, "\u4359"},
+	0x2F975: {false, "\U000262d9"},
+	0x2F976: {false, "\u7f7a"},
+	0x2F977: {false, "\U0002633e"},
+	0x2F978: {false, "\u7f95"},
+	0x2F979: {false, "\u7ffa"},
+	0x2F97A: {false, "\u8005"},
+	0x2F97B: {false, "\U000264da"},
+	0x2F97C: {false, "\U00026523"},
+	0x2F97D: {false, "\u8060"},
+	0x2F97E: {false, "\U000265a8"},
+	0x2F97F: {false, "\u8070"},
+	0x2F980: {false, "\U0002335f"},
+	0x2F981: {false, "\u43d5"},
+	0x2F982: {false, "\u80b2"},
+	0x2F983: {false, "\u8103"},
+	0x2F984: {false, "\u440b"},
+	0x2F985: {false, "\u813e"},
+	0x2F986: {false, "\u5ab5"},
+	0x2F987: {false, "\U000267a7"},
+	0x2F988: {false, "\U000267b5"},
+	0x2F989: {false, "\U00023393"},
+	0x2F98A: {false, "\U0002339c"},
+	0x2F98B: {false, "\u8201"},
+	0x2F98C: {false, "\u8204"},
+	0x2F98D: {false, "\u8f9e"},
+	0x2F98E: {false, "\u446b"},
+	0x2F98F: {false, "\u8291"},
+	0x2F990: {false, "\u828b"},
+	0x2F991: {false, "\u829d"},
+	0x2F992: {false, "\u52b3"},
+	0x2F993: {false, "\u82b1"},
+	0x2F994: {false, "\u82b3"},
+	0x2F995: {false, "\u82bd"},
+	0x2F996: {false, "\u82e6"},
+	0x2F997: {false, "\U00026b3c"},
+	0x2F998: {false, "\u82e5"},
+	0x2F999: {false, "\u831d"},
+	0x2F99A: {false, "\u8363"},
+	0x2F99B: {false, "\u83ad"},
+	0x2F99C: {false, "\u8323"},
+	0x2F99D: {false, "\u83bd"},
+	0x2F99E: {false, "\u83e7"},
+	0x2F99F: {false, "\u8457"},
+	0x2F9A0: {false, "\u8353"},
+	0x2F9A1: {false, "\u83ca"},
+	0x2F9A2: {false, "\u83cc"},
+	0x2F9A3: {false, "\u83dc"},
+	0x2F9A4: {false, "\U00026c36"},
+	0x2F9A5: {false, "\U00026d6b"},
+	0x2F9A6: {false, "\U00026cd5"},
+	0x2F9A7: {false, "\u452b"},
+	0x2F9A8: {false, "\u84f1"},
+	0x2F9A9: {false, "\u84f3"},
+	0x2F9AA: {false, "\u8516"},
+	0x2F9AB: {false, "\U000273ca"},
+	0x2F9AC: {false, "\u8564"},
+	0x2F9AD: {false, "\U00026f2c"},
+	0x2F9AE: {false, "\u455d"},
+	0x2F9AF: {false, "\u4561"},
+	0x2F9B0: {false, "\U00026fb1"},
+	0x2F9B1: {false, "\U000270d2"},
+	0x2F9B2: {false, "\u456b"},
+	0x2F9B3: {false, "\u8650"},
+	0x2F9B4: {false, "\u865c"},
+	0x2F9B5: {false, "\u8667"},
+	0x2F9B6: {false, "\u8669"},
+	0x2F9B7: {false, "\u86a9"},
+	0x2F9B8: {false, "\u8688"},
+	0x2F9B9: {false, "\u870e"},
+	0x2F9BA: {false, "\u86e2"},
+	0x2F9BB: {false, "\u8779"},
+	0x2F9BC: {false, "\u8728"},
+	0x2F9BD: {false, "\u876b"},
+	0x2F9BE: {false, "\u8786"},
+	0x2F9BF: {false, "\u45d7"},
+	0x2F9C0: {false, "\u87e1"},
+	0x2F9C1: {false, "\u8801"},
+	0x2F9C2: {false, "\u45f9"},
+	0x2F9C3: {false, "\u8860"},
+	0x2F9C4: {false, "\u8863"},
+	0x2F9C5: {false, "\U00027667"},
+	0x2F9C6: {false, "\u88d7"},
+	0x2F9C7: {false, "\u88de"},
+	0x2F9C8: {false, "\u4635"},
+	0x2F9C9: {false, "\u88fa"},
+	0x2F9CA: {false, "\u34bb"},
+	0x2F9CB: {false, "\U000278ae"},
+	0x2F9CC: {false, "\U00027966"},
+	0x2F9CD: {false, "\u46be"},
+	0x2F9CE: {false, "\u46c7"},
+	0x2F9CF: {false, "\u8aa0"},
+	0x2F9D0: {false, "\u8aed"},
+	0x2F9D1: {false, "\u8b8a"},
+	0x2F9D2: {false, "\u8c55"},
+	0x2F9D3: {false, "\U00027ca8"},
+	0x2F9D4: {false, "\u8cab"},
+	0x2F9D5: {false, "\u8cc1"},
+	0x2F9D6: {false, "\u8d1b"},
+	0x2F9D7: {false, "\u8d77"},
+	0x2F9D8: {false, "\U00027f2f"},
+	0x2F9D9: {false, "\U00020804"},
+	0x2F9DA: {false, "\u8dcb"},
+	0x2F9DB: {false, "\u8dbc"},
+	0x2F9DC: {false, "\u8df0"},
+	0x2F9DD: {false, "\U000208de"},
+	0x2F9DE: {false, "\u8ed4"},
+	0x2F9DF: {false, "\u8f38"},
+	0x2F9E0: {false, "\U000285d2"},
+	0x2F9E1: {false, "\U000285ed"},
+	0x2F9E2: {false, "\u9094"},
+	0x2F9E3: {false, "\u90f1"},
+	0x2F9E4: {false, "\u9111"},
+	0x2F9E5: {false, "\U0002872e"},
+	0x2F9E6: {false, "\u911b"},
+	0x2F9E7: {false, "\u9238"},
+	0x2F9E8: {false, "\u92d7"},
+	0x2F9E9: {false, "\u92d8"},
+	0x2F9EA: {false, "\u927c"},
+	0x2F9EB: {false, "\u93f9"},
+	0x2F9EC: {false, "\u9415"},
+	0x2F9ED: {false, "\U00028bfa"},
+	0x2F9EE: {false, "\u958b"},
+	0x2F9EF: {false, "\u4995"},
+	0x2F9F0: {false, "\u95b7"},
+	0x2F9F1: {false, "\U00028d77"},
+	0x2F9F2: {false, "\u49e6"},
+	0x2F9F3: {false, "\u96c3"},
+	0x2F9F4: {false, "\u5db2"},
+	0x2F9F5: {false, "\u9723"},
+	0x2F9F6: {false, "\U00029145"},
+	0x2F9F7: {false, "\U0002921a"},
+	0x2F9F8: {false, "\u4a6e"},
+	0x2F9F9: {false, "\u4a76"},
+	0x2F9FA: {false, "\u97e0"},
+	0x2F9FB: {false, "\U0002940a"},
+	0x2F9FC: {false, "\u4ab2"},
+	0x2F9FD: {false, "\U00029496"},
+	0x2F9FE: {false, "\u980b"},
+	0x2F9FF: {false, "\u980b"},
+	0x2FA00: {false, "\u9829"},
+	0x2FA01: {false, "\U000295b6"},
+	0x2FA02: {false, "\u98e2"},
+	0x2FA03: {false, "\u4b33"},
+	0x2FA04: {false, "\u9929"},
+	0x2FA05: {false, "\u99a7"},
+	0x2FA06: {false, "\u99c2"},
+	0x2FA07: {false, "\u99fe"},
+	0x2FA08: {false, "\u4bce"},
+	0x2FA09: {false, "\U00029b30"},
+	0x2FA0A: {false, "\u9b12"},
+	0x2FA0B: {false, "\u9c40"},
+	0x2FA0C: {false, "\u9cfd"},
+	0x2FA0D: {false, "\u4cce"},
+	0x2FA0E: {false, "\u4ced"},
+	0x2FA0F: {false, "\u9d67"},
+	0x2FA10: {false, "\U0002a0ce"},
+	0x2FA11: {false, "\u4cf8"},
+	0x2FA12: {false, "\U0002a105"},
+	0x2FA13: {false, "\U0002a20e"},
+	0x2FA14: {false, "\U0002a291"},
+	0x2FA15: {false, "\u9ebb"},
+	0x2FA16: {false, "\u4d56"},
+	0x2FA17: {false, "\u9ef9"},
+	0x2FA18: {false, "\u9efe"},
+	0x2FA19: {false, "\u9f05"},
+	0x2FA1A: {false, "\u9f0f"},
+	0x2FA1B: {false, "\u9f16"},
+	0x2FA1C: {false, "\u9f3b"},
+	0x2FA1D: {false, "\U0002a600"},
+}
@@ -0,0 +1,58 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// isTerminal reports whether f is a terminal. Character devices that are
+// not, such as /dev/null and /dev/urandom, are read as any other input.
+func isTerminal(f *os.File) bool {
+	return isTTY(f)
+}
+
+// terminalInput returns standard input, a terminal that freq would read
+// only because no input was named, once something has been typed. If
+// nothing is typed within -stdin-timeout, or there is no timeout, it
+// prints a hint and exits.
+func terminalInput() io.Reader {
+	if stdinTimeout > 0 {
+		type result struct {
+			data []byte
+			err  error
+		}
+		c := make(chan result, 1)
+		go func() {
+			buf := make([]byte, bufSize)
+			n, err := os.Stdin.Read(buf)
+			c <- result{buf[:n], err}
+		}()
+		select {
+		case r := <-c:
+			if r.err != nil {
+				return io.MultiReader(bytes.NewReader(r.data), errReader{r.err})
+			}
+			return io.MultiReader(bytes.NewReader(r.data), os.Stdin)
+		case <-time.After(stdinTimeout):
+		}
+	}
+	fmt.Fprintln(os.Stderr, "freq: no input named and standard input is a terminal; name a file, or - to read the terminal")
+	exit(2)
+	return nil
+}
+
+// errReader is a reader that returns only its error.
+type errReader struct {
+	err error
+}
+
+func (e errReader) Read([]byte) (int, error) {
+	return 0, e.err
+}
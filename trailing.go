@@ -0,0 +1,64 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"unicode"
+)
+
+// The state of -trailing-ws.
+var trailing struct {
+	run     int         // White space characters at the end of the line so far.
+	cr      bool        // The last character was a carriage return.
+	lengths map[int]int // Lines by the number of trailing white space characters.
+	lines   int         // Lines with trailing white space.
+}
+
+// checkTrailing follows the white space at the end of each line. A
+// carriage return before a newline is part of the line terminator.
+func checkTrailing(r rune) {
+	if r == '\n' {
+		endTrailing()
+		return
+	}
+	trailing.cr = r == '\r'
+	if unicode.IsSpace(r) {
+		trailing.run++
+	} else {
+		trailing.run = 0
+	}
+}
+
+// endTrailing records the line just ended, if any.
+func endTrailing() {
+	n := trailing.run
+	if trailing.cr {
+		n--
+	}
+	if n > 0 {
+		if trailing.lengths == nil {
+			trailing.lengths = make(map[int]int)
+		}
+		trailing.lengths[n]++
+		trailing.lines++
+	}
+	trailing.run, trailing.cr = 0, false
+}
+
+// printTrailing prints the number of lines with each amount of trailing
+// white space, and the total.
+func printTrailing() {
+	var ns []int
+	for n := range trailing.lengths {
+		ns = append(ns, n)
+	}
+	sort.Ints(ns)
+	for _, n := range ns {
+		fmt.Fprintf(stdout, "trailing %d\t%*d\n", n, pad, trailing.lengths[n])
+	}
+	fmt.Fprintf(stdout, "lines with trailing white space\t%*d\n", pad, trailing.lines)
+}
@@ -0,0 +1,36 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "fmt"
+
+// isBidiControl reports whether r is one of the explicit directional
+// embedding, override and isolate characters, which can make source
+// code display differently from how it is compiled. See
+// https://trojansource.codes.
+func isBidiControl(r rune) bool {
+	return 0x202A <= r && r <= 0x202E || 0x2066 <= r && r <= 0x2069
+}
+
+// bidiFound is the number of directional characters -trojan-source found.
+var bidiFound uint64
+
+// checkTrojan reports r if it is a directional character.
+func checkTrojan(r rune) {
+	if !isBidiControl(r) {
+		return
+	}
+	bidiFound++
+	fmt.Fprintf(stdout, "%s:%d: offset %d: U+%.4X %s\n", pos.file, pos.line, pos.offset, r, invisible[r])
+}
+
+// printTrojan prints the counts of the directional characters.
+func printTrojan(format string) {
+	for _, e := range entries() {
+		if isBidiControl(e.r) {
+			fmt.Fprintf(stdout, format, e.r, e.count, invisible[e.r])
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// unicodeData holds the contents of a UnicodeData.txt file, for
+// answering questions about a specific version of Unicode rather than
+// the one built into the unicode package.
+type unicodeData struct {
+	category map[rune]string // General category of each listed character.
+	ranges   []charRange     // Ranges listed as First/Last pairs.
+}
+
+// charRange is a range of characters that share their properties,
+// such as the CJK ideographs.
+type charRange struct {
+	lo, hi   rune
+	category string
+}
+
+// ucd is the loaded Unicode data, or nil if -unicode-data is not set.
+var ucd *unicodeData
+
+// loadUnicodeData reads the named UnicodeData.txt file.
+func loadUnicodeData(file string) (*unicodeData, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	u := &unicodeData{category: make(map[rune]string)}
+	scan := bufio.NewScanner(f)
+	for line := 1; scan.Scan(); line++ {
+		fields := strings.Split(scan.Text(), ";")
+		if len(fields) < 3 {
+			continue
+		}
+		r, err := strconv.ParseUint(fields[0], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: bad code point %q", file, line, fields[0])
+		}
+		name, category := fields[1], fields[2]
+		switch {
+		case strings.HasSuffix(name, ", First>"):
+			u.ranges = append(u.ranges, charRange{lo: rune(r), category: category})
+		case strings.HasSuffix(name, ", Last>"):
+			if len(u.ranges) == 0 {
+				return nil, fmt.Errorf("%s:%d: range end without start", file, line)
+			}
+			u.ranges[len(u.ranges)-1].hi = rune(r)
+		default:
+			u.category[rune(r)] = category
+		}
+	}
+	if err := scan.Err(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// Category returns the general category of r, or "Cn" if r is unassigned.
+func (u *unicodeData) Category(r rune) string {
+	if c, ok := u.category[r]; ok {
+		return c
+	}
+	for _, rng := range u.ranges {
+		if rng.lo <= r && r <= rng.hi {
+			return rng.category
+		}
+	}
+	return "Cn"
+}
+
+// IsPrint reports whether r is printable by the definition of
+// strconv.IsPrint: a letter, mark, number, punctuation, symbol or
+// the ASCII space.
+func (u *unicodeData) IsPrint(r rune) bool {
+	return r == ' ' || strings.ContainsRune("LMNPS", rune(u.Category(r)[0]))
+}
+
+// isPrint reports whether r is printable, according to the Unicode
+// data if it was loaded, otherwise the tables built into Go.
+func isPrint(r rune) bool {
+	if ucd != nil {
+		return ucd.IsPrint(r)
+	}
+	return strconv.IsPrint(r)
+}
+
+// isAssigned reports whether r is an assigned character, according to
+// the Unicode data if it was loaded, otherwise the tables built into
+// Go. Surrogates are not characters and do not count.
+func isAssigned(r rune) bool {
+	if ucd != nil {
+		c := ucd.Category(r)
+		return c != "Cn" && c != "Cs"
+	}
+	return unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Z, unicode.Cc, unicode.Cf, unicode.Co)
+}
+
+// isDecimalDigit reports whether r is a decimal digit (category Nd),
+// according to the Unicode data if it was loaded, otherwise the tables
+// built into Go.
+func isDecimalDigit(r rune) bool {
+	if ucd != nil {
+		return ucd.Category(r) == "Nd"
+	}
+	return unicode.IsDigit(r)
+}
+
+// asciiDigit returns the ASCII digit with the value of the decimal digit
+// r, or r itself if it is not a decimal digit. Unicode encodes each
+// set of decimal digits contiguously, from zero to nine, so the value is
+// the distance from the start of the run of digits containing r, modulo
+// ten; some runs hold several sets.
+func asciiDigit(r rune) rune {
+	if r < 0x80 || !isDecimalDigit(r) {
+		return r
+	}
+	start := r
+	for isDecimalDigit(start - 1) {
+		start--
+	}
+	return '0' + (r-start)%10
+}
+
+// simpleFold returns the character that r folds to by the simple case
+// folding of Unicode, the one-to-one mappings of CaseFolding.txt, which
+// mostly map to lower case: ς, σ and Σ all fold to σ, and ſ, s and S to
+// s. The unicode package gives the orbit of characters that fold
+// together, and the fold of the least of them, after case mapping, is
+// the one they share. A character such as İ that belongs to no orbit is
+// unchanged.
+func simpleFold(r rune) rune {
+	lo := r
+	for m := unicode.SimpleFold(r); m != r; m = unicode.SimpleFold(m) {
+		lo = min(lo, m)
+	}
+	if lo == r && unicode.SimpleFold(r) == r {
+		return r
+	}
+	f := unicode.ToLower(unicode.ToUpper(lo))
+	for m := unicode.SimpleFold(lo); m != lo; m = unicode.SimpleFold(m) {
+		if m == f {
+			return f
+		}
+	}
+	return lo
+}
+
+// majorCategory returns the first letter of the general category of r,
+// such as 'L' for letters, according to the Unicode data if it was
+// loaded, otherwise the tables built into Go.
+func majorCategory(r rune) byte {
+	if ucd != nil {
+		return ucd.Category(r)[0]
+	}
+	for _, c := range "LMNPSZ" {
+		if unicode.Is(unicode.Categories[string(c)], r) {
+			return byte(c)
+		}
+	}
+	return 'C'
+}
@@ -0,0 +1,47 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "testing"
+
+var simpleFoldTests = []struct {
+	in, out rune
+}{
+	{'A', 'a'},
+	{'a', 'a'},
+	{'Σ', 'σ'},
+	{'ς', 'σ'},
+	{'ſ', 's'},
+	{'K', 'k'}, // KELVIN SIGN
+	{'ẞ', 'ß'},
+	{'ϑ', 'θ'},
+	{'ǅ', 'ǆ'},
+	{'İ', 'İ'},
+	{'ı', 'ı'},
+	{'1', '1'},
+}
+
+func TestSimpleFold(t *testing.T) {
+	for _, test := range simpleFoldTests {
+		if got := simpleFold(test.in); got != test.out {
+			t.Errorf("simpleFold(%q) = %q; want %q", test.in, got, test.out)
+		}
+	}
+}
+
+func TestFoldWords(t *testing.T) {
+	defer func(fold, lines bool) { foldCase, lineMode = fold, lines }(foldCase, lineMode)
+	defer clear(wordCounts)
+	for _, lines := range []bool{false, true} {
+		foldCase, lineMode = true, lines
+		clear(wordCounts)
+		countWords("ΟΔΟΣ")
+		countWords("οδος")
+		countWords("Οδοσ")
+		if len(wordCounts) != 1 || wordCounts["οδοσ"] != 3 {
+			t.Errorf("-fold -lines=%t: counted %v", lines, wordCounts)
+		}
+	}
+}
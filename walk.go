@@ -0,0 +1,67 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// expandDirs returns the inputs with each directory replaced, for -r, by
+// the regular files beneath it, in lexical order, that -include and
+// -exclude admit. Other names are returned as they are. A directory that
+// cannot be read is reported as an input error and skipped.
+func expandDirs(names []string) []string {
+	var out []string
+	for _, name := range names {
+		info, err := os.Stat(name)
+		if name == "-" || err != nil || !info.IsDir() {
+			out = append(out, name)
+			continue
+		}
+		filepath.WalkDir(name, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				inputError(err)
+				return nil // WalkDir skips a directory it cannot read.
+			}
+			if path != name && excluded(d.Name()) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.Type().IsRegular() && included(d.Name()) {
+				out = append(out, path)
+			}
+			return nil
+		})
+	}
+	return out
+}
+
+// included reports whether the file name matches one of the -include
+// patterns, or there are none.
+func included(name string) bool {
+	if len(includes) == 0 {
+		return true
+	}
+	return matchAny(includes, name)
+}
+
+// excluded reports whether the file or directory name matches one of
+// the -exclude patterns.
+func excluded(name string) bool {
+	return matchAny(excludes, name)
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := filepath.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
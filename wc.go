@@ -0,0 +1,40 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "fmt"
+
+// wordCounter counts the lines, words and bytes written to it, as wc
+// does. Words are separated by ASCII white space.
+type wordCounter struct {
+	lines, words, bytes uint64
+	inWord              bool
+}
+
+var wc wordCounter
+
+func (w *wordCounter) Write(p []byte) (int, error) {
+	for _, b := range p {
+		switch b {
+		case '\n':
+			w.lines++
+			fallthrough
+		case ' ', '\t', '\v', '\f', '\r':
+			w.inWord = false
+		default:
+			if !w.inWord {
+				w.words++
+				w.inWord = true
+			}
+		}
+	}
+	w.bytes += uint64(len(p))
+	return len(p), nil
+}
+
+// printWC prints the counts in the order wc does.
+func printWC() {
+	fmt.Fprintf(stdout, "wc\t%d %d %d\n", wc.lines, wc.words, wc.bytes)
+}
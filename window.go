@@ -0,0 +1,47 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "fmt"
+
+// The sliding window of -window: the last N characters counted in the
+// current input and the number of times each appears among them.
+var window struct {
+	ring   []rune
+	next   int // Index in ring of the oldest character, once it is full.
+	n      int // Characters counted in this input.
+	counts map[rune]int
+}
+
+// addWindow adds r to the window, dropping the oldest character, and
+// prints a sample each time another windowSize characters have been seen.
+func addWindow(r rune) {
+	if window.counts == nil {
+		window.ring = make([]rune, 0, windowSize)
+		window.counts = make(map[rune]int)
+	}
+	if len(window.ring) < windowSize {
+		window.ring = append(window.ring, r)
+	} else {
+		old := window.ring[window.next]
+		if window.counts[old]--; window.counts[old] == 0 {
+			delete(window.counts, old)
+		}
+		window.ring[window.next] = r
+		window.next = (window.next + 1) % windowSize
+	}
+	window.counts[r]++
+	window.n++
+	if window.n%windowSize == 0 {
+		fmt.Fprintf(stdout, "window %s\t%d\n", position{pos.file, pos.offset}, len(window.counts))
+	}
+}
+
+// resetWindow empties the window at the end of an input.
+func resetWindow() {
+	window.ring = window.ring[:0]
+	window.next, window.n = 0, 0
+	clear(window.counts)
+}
@@ -0,0 +1,221 @@
+// Copyright 2012 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// wordCounts holds the counts of -words, or of -lines. Unlike the counts
+// of characters the keys are unbounded, so a map is used, and wordOther
+// counts the words not kept because of -max-memory.
+var (
+	wordCounts = make(map[string]uint64)
+	wordOther  uint64
+)
+
+// wordRE is the parsed -field-regexp, which separates words, or nil to
+// separate them by white space.
+var wordRE *regexp.Regexp
+
+// readWords counts the words, or lines, of f a line at a time.
+func readWords(file string, f io.Reader) {
+	buf := bufio.NewReaderSize(f, bufSize)
+	for !timedOut.Load() {
+		line, err := buf.ReadString('\n')
+		pos.offset += int64(len(line))
+		if line != "" {
+			countWords(strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"))
+			pos.line++
+		}
+		if err != nil {
+			if err == io.EOF {
+				return
+			}
+			inputError(fmt.Errorf("%s: %s", file, err))
+			return
+		}
+	}
+}
+
+// countWords counts the words of a line, or of its -field, or with
+// -lines the line or field itself.
+func countWords(text string) {
+	if field > 0 {
+		fields := strings.SplitN(text, delim, field+1)
+		if len(fields) < field {
+			return
+		}
+		text = fields[field-1]
+	}
+	if lineMode {
+		countWord(text)
+		return
+	}
+	var words []string
+	if wordRE != nil {
+		words = wordRE.Split(text, -1)
+	} else {
+		words = strings.FieldsFunc(text, unicode.IsSpace)
+	}
+	for _, w := range words {
+		if w != "" {
+			countWord(w)
+		}
+	}
+}
+
+// countWord counts w, folded by -fold.
+func countWord(w string) {
+	if foldCase {
+		w = strings.Map(simpleFold, w)
+	}
+	countKey(wordCounts, w, &wordOther)
+}
+
+// wordEntry is a counted word, or n-gram, and its count.
+type wordEntry struct {
+	word  string
+	count uint64
+}
+
+// wordEntries returns the words counted in m that pass the output
+// filters, at most -top of them, in the order requested by -sortby,
+// where char is the order of the words' bytes and bytes is the count
+// times the word's length, and their total count, including the other
+// words not kept in m.
+func wordEntries(m map[string]uint64, other uint64) ([]wordEntry, uint64) {
+	total := other
+	for _, n := range m {
+		total += n
+	}
+	var list []wordEntry
+	for w, n := range m {
+		if shown(n, total) {
+			list = append(list, wordEntry{w, n})
+		}
+	}
+	// Sorting by word first makes the stable sorts below break ties by word.
+	sort.Slice(list, func(i, j int) bool { return list[i].word < list[j].word })
+	if topN > 0 && len(list) > topN {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].count > list[j].count })
+		list = list[:topN]
+		sort.Slice(list, func(i, j int) bool { return list[i].word < list[j].word })
+	}
+	less := func(i, j int) bool { return list[i].word < list[j].word }
+	switch sortKey {
+	case "count", "percent":
+		less = func(i, j int) bool { return list[i].count < list[j].count }
+	case "bytes":
+		less = func(i, j int) bool {
+			return list[i].count*uint64(len(list[i].word)) < list[j].count*uint64(len(list[j].word))
+		}
+	}
+	if sortDesc {
+		asc := less
+		less = func(i, j int) bool { return asc(j, i) }
+	}
+	sort.SliceStable(list, less)
+	return list, total
+}
+
+// printWords prints the word, or line, counts in the form chosen by
+// -format.
+func printWords() {
+	list, total := wordEntries(wordCounts, wordOther)
+	switch outputFormat {
+	case "json", "csv":
+		kind := "word"
+		if lineMode {
+			kind = "line"
+		}
+		var records []record
+		for _, e := range list {
+			records = append(records, record{kind, "", e.word, e.count})
+		}
+		if wordOther > 0 {
+			records = append(records, record{"other", "", "", wordOther})
+		}
+		if outputFormat == "json" {
+			printJSON(records)
+		} else {
+			printCSV(records)
+		}
+		return
+	}
+	share := shares{total: total}
+	var bars bars
+	for _, e := range list {
+		bars.fit(e.count)
+	}
+	bars.fit(wordOther)
+	for _, e := range list {
+		word := e.word
+		switch {
+		case lineMode:
+			word = escapeLine(word)
+		case tsv:
+			word = escapeTSV(word)
+		}
+		if outputCharmap != nil {
+			word = outputCharmap.encodeString(word)
+		}
+		fmt.Fprintf(stdout, "%s\t%*s", word, pad, formatTally(e.count, total))
+		share.print(e.count)
+		if byBytes {
+			fmt.Fprintf(stdout, "\t%s", formatCount(e.count*uint64(len(e.word))))
+		}
+		bars.print(e.count)
+		fmt.Fprintln(stdout)
+	}
+	printOther("other", wordOther, &share, &bars)
+}
+
+// printOther prints the row, labeled label, of the count of the keys not
+// kept because of -max-memory, if there were any.
+func printOther(label string, other uint64, share *shares, bars *bars) {
+	if other == 0 {
+		return
+	}
+	fmt.Fprintf(stdout, "%s\t%*s", label, pad, formatCount(other))
+	share.print(other)
+	bars.print(other)
+	fmt.Fprintln(stdout)
+}
+
+// escapeTSV returns s with the characters in tsvEscapes escaped.
+func escapeTSV(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if e, ok := tsvEscapes[r]; ok {
+			b.WriteString(e)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// escapeLine returns s with the characters in tsvEscapes escaped, and the
+// other ASCII controls as \xNN.
+func escapeLine(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if e, ok := tsvEscapes[r]; ok {
+			b.WriteString(e)
+		} else if r < 0x20 || r == 0x7F {
+			fmt.Fprintf(&b, `\x%.2x`, r)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}